@@ -8,11 +8,21 @@ import (
 	"github.com/Kcrong/selenium/remote/command"
 )
 
+// Dispatcher sends action sequences to the browser over a transport other
+// than the WebDriver HTTP endpoints, such as WebDriver BiDi.
+type Dispatcher interface {
+	// PerformActions performs the actions stored on the devices
+	PerformActions(ctx context.Context, devices []InputDevice) error
+	// ReleaseActions releases all keys and pointer buttons that are held down
+	ReleaseActions(ctx context.Context) error
+}
+
 // ActionBuilder provides a way to create action sequences.
 type ActionBuilder struct {
-	driver   selenium.WebDriver
-	devices  []InputDevice
-	duration time.Duration
+	driver     selenium.WebDriver
+	dispatcher Dispatcher
+	devices    []InputDevice
+	duration   time.Duration
 }
 
 // NewActionBuilder creates a new ActionBuilder instance.
@@ -34,20 +44,36 @@ func NewActionBuilder(
 	devices = append(devices, mouse, keyboard, wheel)
 
 	return &ActionBuilder{
-		driver:   driver,
-		devices:  devices,
-		duration: duration,
+		driver:     driver,
+		dispatcher: nil,
+		devices:    devices,
+		duration:   duration,
 	}
 }
 
+// SetDispatcher sends the actions through dispatcher instead of the driver.
+//
+// The driver may be nil when a dispatcher is set.
+func (a *ActionBuilder) SetDispatcher(dispatcher Dispatcher) {
+	a.dispatcher = dispatcher
+}
+
 // ClearActions clears all actions that are already stored on the remote end.
 func (a *ActionBuilder) ClearActions(ctx context.Context) error {
+	if a.dispatcher != nil {
+		return a.dispatcher.ReleaseActions(ctx)
+	}
+
 	_, err := a.driver.Execute(ctx, command.W3CClearActions, nil)
 	return err
 }
 
 // Perform performs all stored actions.
 func (a *ActionBuilder) Perform(ctx context.Context) error {
+	if a.dispatcher != nil {
+		return a.dispatcher.PerformActions(ctx, a.devices)
+	}
+
 	params := make(map[string]interface{})
 	actions := make([]map[string]interface{}, 0)
 
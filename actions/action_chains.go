@@ -22,6 +22,12 @@ func NewActionChains(driver selenium.WebDriver, duration time.Duration) *ActionC
 	}
 }
 
+// SetDispatcher sends the actions through dispatcher instead of the driver.
+func (a *ActionChains) SetDispatcher(dispatcher Dispatcher) *ActionChains {
+	a.actions.SetDispatcher(dispatcher)
+	return a
+}
+
 // Perform performs all stored actions.
 func (a *ActionChains) Perform(ctx context.Context) error {
 	return a.actions.Perform(ctx)
@@ -41,6 +41,11 @@ func (p *PointerInput) GetType() string {
 	return "pointer"
 }
 
+// GetKind returns the pointer type: mouse, pen or touch.
+func (p *PointerInput) GetKind() string {
+	return p.kind
+}
+
 // ClearActions clears all stored actions.
 func (p *PointerInput) ClearActions() {
 	p.actions = make([]Action, 0)
@@ -0,0 +1,138 @@
+// Package biditest provides an in-memory WebDriver BiDi browser for tests.
+package biditest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/Kcrong/selenium/bidi"
+)
+
+// Handler answers a command; the returned error is sent as an error response.
+type Handler func(params json.RawMessage) (interface{}, error)
+
+// Browser answers BiDi commands in memory and implements bidi.WebSocket.
+//
+// Commands without a handler succeed with an empty result.
+type Browser struct {
+	handlers  map[string]Handler
+	received  map[string][]json.RawMessage
+	incoming  chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex
+}
+
+// NewBrowser creates an in-memory browser.
+func NewBrowser() *Browser {
+	return &Browser{
+		handlers:  make(map[string]Handler),
+		received:  make(map[string][]json.RawMessage),
+		incoming:  make(chan []byte, 1024),
+		closed:    make(chan struct{}),
+		closeOnce: sync.Once{},
+		mu:        sync.Mutex{},
+	}
+}
+
+// NewSession creates a BiDi session on an in-memory browser that is closed when the test ends.
+func NewSession(t testing.TB) (*bidi.Session, *Browser) {
+	t.Helper()
+
+	browser := NewBrowser()
+	session := bidi.NewSession(browser)
+	t.Cleanup(func() { _ = session.Close() })
+
+	return session, browser
+}
+
+// Handle sets the handler for a command.
+func (b *Browser) Handle(method string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[method] = handler
+}
+
+// Commands returns the params of every received command with the given method.
+func (b *Browser) Commands(method string) []json.RawMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]json.RawMessage(nil), b.received[method]...)
+}
+
+// Emit sends an event to the session.
+func (b *Browser) Emit(method string, params interface{}) {
+	b.push(map[string]interface{}{"type": "event", "method": method, "params": params})
+}
+
+// Send receives a command from the session and queues its response.
+func (b *Browser) Send(data []byte) error {
+	var cmd struct {
+		Params json.RawMessage `json:"params"`
+		Method string          `json:"method"`
+		ID     int64           `json:"id"`
+	}
+	if err := json.Unmarshal(data, &cmd); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.received[cmd.Method] = append(b.received[cmd.Method], cmd.Params)
+	handler := b.handlers[cmd.Method]
+	b.mu.Unlock()
+
+	var result interface{} = struct{}{}
+
+	if handler != nil {
+		var err error
+		if result, err = handler(cmd.Params); err != nil {
+			protocolErr := &bidi.ProtocolError{Code: "unknown error", Message: err.Error(), Stacktrace: ""}
+			errors.As(err, &protocolErr)
+
+			b.push(map[string]interface{}{
+				"type": "error", "id": cmd.ID, "error": protocolErr.Code, "message": protocolErr.Message,
+			})
+
+			return nil
+		}
+	}
+
+	b.push(map[string]interface{}{"type": "success", "id": cmd.ID, "result": result})
+
+	return nil
+}
+
+// Receive returns the next response or event.
+func (b *Browser) Receive() ([]byte, error) {
+	select {
+	case data := <-b.incoming:
+		return data, nil
+	case <-b.closed:
+		return nil, io.EOF
+	}
+}
+
+// Close disconnects the session.
+func (b *Browser) Close() error {
+	b.closeOnce.Do(func() { close(b.closed) })
+
+	return nil
+}
+
+// push queues a message for the session.
+func (b *Browser) push(message interface{}) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		panic(err)
+	}
+
+	select {
+	case b.incoming <- data:
+	case <-b.closed:
+	}
+}
@@ -0,0 +1,387 @@
+// Package browsingcontext implements the WebDriver BiDi browsingContext module.
+package browsingcontext
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/Kcrong/selenium/bidi"
+)
+
+// CreateType is the kind of browsing context to create.
+type CreateType string
+
+const (
+	// Tab opens a new tab.
+	Tab CreateType = "tab"
+	// Window opens a new window.
+	Window CreateType = "window"
+)
+
+// ReadinessState is the document state a navigation waits for.
+type ReadinessState string
+
+const (
+	// ReadinessNone returns as soon as the navigation starts.
+	ReadinessNone ReadinessState = "none"
+	// ReadinessInteractive waits for DOMContentLoaded.
+	ReadinessInteractive ReadinessState = "interactive"
+	// ReadinessComplete waits for the load event.
+	ReadinessComplete ReadinessState = "complete"
+)
+
+// Info describes a browsing context and its children.
+type Info struct {
+	Parent         *string `json:"parent,omitempty"`
+	OriginalOpener *string `json:"originalOpener,omitempty"`
+	Context        string  `json:"context"`
+	URL            string  `json:"url"`
+	UserContext    string  `json:"userContext"`
+	ClientWindow   string  `json:"clientWindow"`
+	Children       []Info  `json:"children"`
+}
+
+// NavigateResult is the result of a navigation.
+type NavigateResult struct {
+	Navigation *string `json:"navigation"`
+	URL        string  `json:"url"`
+}
+
+// GetTreeOptions restricts the returned tree.
+type GetTreeOptions struct {
+	// MaxDepth limits the depth of the tree; nil returns the whole tree.
+	MaxDepth *int `json:"maxDepth,omitempty"`
+	// Root returns the tree below a context instead of every top-level context.
+	Root string `json:"root,omitempty"`
+}
+
+// CreateOptions configures a new browsing context.
+type CreateOptions struct {
+	// ReferenceContext is the context the new tab or window is opened next to.
+	ReferenceContext string `json:"referenceContext,omitempty"`
+	// UserContext is the user context the new browsing context belongs to.
+	UserContext string `json:"userContext,omitempty"`
+	// Background leaves the new browsing context in the background.
+	Background bool `json:"background,omitempty"`
+}
+
+// BrowsingContext sends browsingContext commands over a BiDi session.
+type BrowsingContext struct {
+	session *bidi.Session
+}
+
+// New creates a browsingContext module on the session.
+func New(session *bidi.Session) *BrowsingContext {
+	return &BrowsingContext{
+		session: session,
+	}
+}
+
+// GetTree returns the browsing context tree.
+func (b *BrowsingContext) GetTree(ctx context.Context, opts GetTreeOptions) ([]Info, error) {
+	result, err := bidi.Run(ctx, b.session, bidi.NewBiDiCommand[struct {
+		Contexts []Info `json:"contexts"`
+	}]("browsingContext.getTree", opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get browsing context tree: %w", err)
+	}
+
+	return result.Contexts, nil
+}
+
+// Create opens a new tab or window and returns its context ID.
+func (b *BrowsingContext) Create(ctx context.Context, createType CreateType, opts CreateOptions) (string, error) {
+	params := struct {
+		CreateOptions
+		Type CreateType `json:"type"`
+	}{
+		CreateOptions: opts,
+		Type:          createType,
+	}
+
+	result, err := bidi.Run(ctx, b.session, bidi.NewBiDiCommand[struct {
+		Context string `json:"context"`
+	}]("browsingContext.create", params))
+	if err != nil {
+		return "", fmt.Errorf("failed to create browsing context: %w", err)
+	}
+
+	return result.Context, nil
+}
+
+// Navigate navigates the context to url and waits for the given readiness state.
+func (b *BrowsingContext) Navigate(
+	ctx context.Context, contextID, url string, wait ReadinessState,
+) (*NavigateResult, error) {
+	params := map[string]interface{}{"context": contextID, "url": url}
+	if wait != "" {
+		params["wait"] = wait
+	}
+
+	result, err := bidi.Run(ctx, b.session, bidi.NewBiDiCommand[NavigateResult]("browsingContext.navigate", params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to navigate to %s: %w", url, err)
+	}
+
+	return result, nil
+}
+
+// Reload reloads the context and waits for the given readiness state.
+func (b *BrowsingContext) Reload(
+	ctx context.Context, contextID string, ignoreCache bool, wait ReadinessState,
+) (*NavigateResult, error) {
+	params := map[string]interface{}{"context": contextID, "ignoreCache": ignoreCache}
+	if wait != "" {
+		params["wait"] = wait
+	}
+
+	result, err := bidi.Run(ctx, b.session, bidi.NewBiDiCommand[NavigateResult]("browsingContext.reload", params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload: %w", err)
+	}
+
+	return result, nil
+}
+
+// Close closes a top-level context; promptUnload runs beforeunload handlers first.
+func (b *BrowsingContext) Close(ctx context.Context, contextID string, promptUnload bool) error {
+	return b.run(ctx, "browsingContext.close", map[string]interface{}{
+		"context":      contextID,
+		"promptUnload": promptUnload,
+	})
+}
+
+// Activate brings a top-level context to the foreground.
+func (b *BrowsingContext) Activate(ctx context.Context, contextID string) error {
+	return b.run(ctx, "browsingContext.activate", map[string]interface{}{"context": contextID})
+}
+
+// HandleUserPrompt accepts or dismisses an open prompt; userText answers prompt dialogs.
+func (b *BrowsingContext) HandleUserPrompt(ctx context.Context, contextID string, accept bool, userText string) error {
+	params := map[string]interface{}{"context": contextID, "accept": accept}
+	if userText != "" {
+		params["userText"] = userText
+	}
+
+	return b.run(ctx, "browsingContext.handleUserPrompt", params)
+}
+
+// TraverseHistory moves delta entries through the session history; negative values go back.
+func (b *BrowsingContext) TraverseHistory(ctx context.Context, contextID string, delta int) error {
+	return b.run(ctx, "browsingContext.traverseHistory", map[string]interface{}{
+		"context": contextID,
+		"delta":   delta,
+	})
+}
+
+// Viewport is the size of a context viewport in CSS pixels.
+type Viewport struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// SetViewport resizes the viewport and sets the device pixel ratio.
+//
+// A nil viewport or devicePixelRatio resets it to the browser default.
+func (b *BrowsingContext) SetViewport(
+	ctx context.Context, contextID string, viewport *Viewport, devicePixelRatio *float64,
+) error {
+	return b.run(ctx, "browsingContext.setViewport", map[string]interface{}{
+		"context":          contextID,
+		"viewport":         viewport,
+		"devicePixelRatio": devicePixelRatio,
+	})
+}
+
+// ScreenshotOrigin is the coordinate space of a screenshot.
+type ScreenshotOrigin string
+
+const (
+	// OriginViewport captures the visible viewport.
+	OriginViewport ScreenshotOrigin = "viewport"
+	// OriginDocument captures the whole document.
+	OriginDocument ScreenshotOrigin = "document"
+)
+
+// ImageFormat is the encoding of a screenshot.
+type ImageFormat struct {
+	// Quality is the JPEG quality between 0 and 1.
+	Quality *float64 `json:"quality,omitempty"`
+	// Type is a MIME type such as image/png or image/jpeg.
+	Type string `json:"type"`
+}
+
+// Clip restricts a screenshot to a rectangle or an element.
+type Clip interface {
+	clip()
+}
+
+// BoxClip restricts a screenshot to a rectangle.
+type BoxClip struct {
+	Type   string  `json:"type"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// NewBoxClip creates a rectangle clip.
+func NewBoxClip(x, y, width, height float64) BoxClip {
+	return BoxClip{Type: "box", X: x, Y: y, Width: width, Height: height}
+}
+
+func (BoxClip) clip() {}
+
+// ElementClip restricts a screenshot to an element.
+type ElementClip struct {
+	Type    string               `json:"type"`
+	Element bidi.SharedReference `json:"element"`
+}
+
+// NewElementClip creates an element clip.
+func NewElementClip(element bidi.SharedReference) ElementClip {
+	return ElementClip{Type: "element", Element: element}
+}
+
+func (ElementClip) clip() {}
+
+// ScreenshotOptions configures CaptureScreenshot.
+type ScreenshotOptions struct {
+	Format *ImageFormat     `json:"format,omitempty"`
+	Clip   Clip             `json:"clip,omitempty"`
+	Origin ScreenshotOrigin `json:"origin,omitempty"`
+}
+
+// CaptureScreenshot captures the context and returns the decoded image.
+func (b *BrowsingContext) CaptureScreenshot(
+	ctx context.Context, contextID string, opts ScreenshotOptions,
+) ([]byte, error) {
+	params := struct {
+		ScreenshotOptions
+		Context string `json:"context"`
+	}{
+		ScreenshotOptions: opts,
+		Context:           contextID,
+	}
+
+	return b.runData(ctx, "browsingContext.captureScreenshot", params)
+}
+
+// PrintMargin is a page margin in centimeters.
+type PrintMargin struct {
+	Bottom *float64 `json:"bottom,omitempty"`
+	Left   *float64 `json:"left,omitempty"`
+	Right  *float64 `json:"right,omitempty"`
+	Top    *float64 `json:"top,omitempty"`
+}
+
+// PrintPage is a page size in centimeters.
+type PrintPage struct {
+	Height *float64 `json:"height,omitempty"`
+	Width  *float64 `json:"width,omitempty"`
+}
+
+// PrintOptions configures Print.
+type PrintOptions struct {
+	Margin      *PrintMargin  `json:"margin,omitempty"`
+	Page        *PrintPage    `json:"page,omitempty"`
+	Scale       *float64      `json:"scale,omitempty"`
+	ShrinkToFit *bool         `json:"shrinkToFit,omitempty"`
+	Orientation string        `json:"orientation,omitempty"`
+	PageRanges  []interface{} `json:"pageRanges,omitempty"`
+	Background  bool          `json:"background,omitempty"`
+}
+
+// Print renders the context as a PDF and returns the decoded document.
+func (b *BrowsingContext) Print(ctx context.Context, contextID string, opts PrintOptions) ([]byte, error) {
+	params := struct {
+		PrintOptions
+		Context string `json:"context"`
+	}{
+		PrintOptions: opts,
+		Context:      contextID,
+	}
+
+	return b.runData(ctx, "browsingContext.print", params)
+}
+
+// Locator finds nodes with LocateNodes.
+type Locator struct {
+	Value interface{} `json:"value"`
+	Type  string      `json:"type"`
+}
+
+// CSS locates nodes matching a CSS selector.
+func CSS(selector string) Locator {
+	return Locator{Value: selector, Type: "css"}
+}
+
+// XPath locates nodes matching an XPath expression.
+func XPath(expression string) Locator {
+	return Locator{Value: expression, Type: "xpath"}
+}
+
+// InnerText locates elements whose rendered text equals text.
+func InnerText(text string) Locator {
+	return Locator{Value: text, Type: "innerText"}
+}
+
+// Accessibility locates elements by accessible name and role; empty values are ignored.
+func Accessibility(name, role string) Locator {
+	value := map[string]string{}
+	if name != "" {
+		value["name"] = name
+	}
+
+	if role != "" {
+		value["role"] = role
+	}
+
+	return Locator{Value: value, Type: "accessibility"}
+}
+
+// LocateNodes finds up to maxNodeCount nodes in the context; zero returns every match.
+func (b *BrowsingContext) LocateNodes(
+	ctx context.Context, contextID string, locator Locator, maxNodeCount int,
+) ([]bidi.NodeRemoteValue, error) {
+	params := map[string]interface{}{"context": contextID, "locator": locator}
+	if maxNodeCount > 0 {
+		params["maxNodeCount"] = maxNodeCount
+	}
+
+	result, err := bidi.Run(ctx, b.session, bidi.NewBiDiCommand[struct {
+		Nodes []bidi.NodeRemoteValue `json:"nodes"`
+	}]("browsingContext.locateNodes", params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate nodes: %w", err)
+	}
+
+	return result.Nodes, nil
+}
+
+// run sends a command whose result is empty.
+func (b *BrowsingContext) run(ctx context.Context, method string, params interface{}) error {
+	if _, err := bidi.Run(ctx, b.session, bidi.NewBiDiCommand[struct{}](method, params)); err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	return nil
+}
+
+// runData sends a command returning base64 data and decodes it.
+func (b *BrowsingContext) runData(ctx context.Context, method string, params interface{}) ([]byte, error) {
+	result, err := bidi.Run(ctx, b.session, bidi.NewBiDiCommand[struct {
+		Data string `json:"data"`
+	}](method, params))
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(result.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s data: %w", method, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,84 @@
+package browsingcontext_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/biditest"
+	"github.com/Kcrong/selenium/bidi/browsingcontext"
+)
+
+func TestNavigateAndCapture(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	contexts := browsingcontext.New(session)
+
+	browser.Handle("browsingContext.create", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"context": "C2"}, nil
+	})
+	browser.Handle("browsingContext.navigate", func(params json.RawMessage) (interface{}, error) {
+		var p struct {
+			URL string `json:"url"`
+		}
+		_ = json.Unmarshal(params, &p)
+
+		return map[string]interface{}{"navigation": "N1", "url": p.URL}, nil
+	})
+	browser.Handle("browsingContext.captureScreenshot", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"data": base64.StdEncoding.EncodeToString([]byte("png"))}, nil
+	})
+
+	id, err := contexts.Create(ctx, browsingcontext.Tab, browsingcontext.CreateOptions{Background: true})
+	require.NoError(t, err)
+	assert.Equal(t, "C2", id)
+	assert.JSONEq(t, `{"type": "tab", "background": true}`, string(browser.Commands("browsingContext.create")[0]))
+
+	result, err := contexts.Navigate(ctx, id, "https://example.com", browsingcontext.ReadinessComplete)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com", result.URL)
+	assert.JSONEq(t, `{"context": "C2", "url": "https://example.com", "wait": "complete"}`,
+		string(browser.Commands("browsingContext.navigate")[0]))
+
+	image, err := contexts.CaptureScreenshot(ctx, id, browsingcontext.ScreenshotOptions{
+		Origin: browsingcontext.OriginDocument,
+		Clip:   browsingcontext.NewElementClip(bidi.SharedReference{SharedID: "E1", Handle: ""}),
+		Format: nil,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png"), image)
+	assert.JSONEq(t, `{"context": "C2", "origin": "document", "clip": {"type": "element", "element": {"sharedId": "E1"}}}`,
+		string(browser.Commands("browsingContext.captureScreenshot")[0]))
+
+	require.NoError(t, contexts.SetViewport(ctx, id, nil, nil))
+	assert.JSONEq(t, `{"context": "C2", "viewport": null, "devicePixelRatio": null}`,
+		string(browser.Commands("browsingContext.setViewport")[0]))
+}
+
+func TestOnLoad(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+	contexts := browsingcontext.New(session)
+
+	loaded := make(chan browsingcontext.NavigationInfo, 1)
+	require.NoError(t, contexts.OnLoad(context.Background(), func(info browsingcontext.NavigationInfo) {
+		loaded <- info
+	}))
+	assert.JSONEq(t, `{"events": ["browsingContext.load"]}`, string(browser.Commands("session.subscribe")[0]))
+
+	browser.Emit(browsingcontext.EventLoad, map[string]interface{}{
+		"context": "C1", "navigation": "N1", "timestamp": 1700000000000, "url": "https://example.com",
+	})
+
+	info := <-loaded
+	assert.Equal(t, "C1", info.Context)
+	assert.Equal(t, int64(1700000000000), info.Timestamp)
+}
@@ -0,0 +1,60 @@
+package browsingcontext
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+)
+
+// browsingContext events.
+const (
+	EventContextCreated    = "browsingContext.contextCreated"
+	EventNavigationStarted = "browsingContext.navigationStarted"
+	EventLoad              = "browsingContext.load"
+	EventDOMContentLoaded  = "browsingContext.domContentLoaded"
+	EventUserPromptOpened  = "browsingContext.userPromptOpened"
+)
+
+// NavigationInfo describes a navigation event.
+type NavigationInfo struct {
+	Navigation *string `json:"navigation"`
+	Context    string  `json:"context"`
+	URL        string  `json:"url"`
+	// Timestamp is the event time in milliseconds since the Unix epoch.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// UserPrompt describes an opened alert, confirm, prompt or beforeunload dialog.
+type UserPrompt struct {
+	DefaultValue *string `json:"defaultValue,omitempty"`
+	Context      string  `json:"context"`
+	// Handler is the configured prompt handler: accept, dismiss or ignore.
+	Handler string `json:"handler"`
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+// OnContextCreated calls handler for every new browsing context.
+func (b *BrowsingContext) OnContextCreated(ctx context.Context, handler func(Info)) error {
+	return bidi.On(ctx, b.session, EventContextCreated, handler)
+}
+
+// OnNavigationStarted calls handler when a navigation starts.
+func (b *BrowsingContext) OnNavigationStarted(ctx context.Context, handler func(NavigationInfo)) error {
+	return bidi.On(ctx, b.session, EventNavigationStarted, handler)
+}
+
+// OnLoad calls handler when a document fires its load event.
+func (b *BrowsingContext) OnLoad(ctx context.Context, handler func(NavigationInfo)) error {
+	return bidi.On(ctx, b.session, EventLoad, handler)
+}
+
+// OnDOMContentLoaded calls handler when a document fires DOMContentLoaded.
+func (b *BrowsingContext) OnDOMContentLoaded(ctx context.Context, handler func(NavigationInfo)) error {
+	return bidi.On(ctx, b.session, EventDOMContentLoaded, handler)
+}
+
+// OnUserPromptOpened calls handler when a user prompt opens.
+func (b *BrowsingContext) OnUserPromptOpened(ctx context.Context, handler func(UserPrompt)) error {
+	return bidi.On(ctx, b.session, EventUserPromptOpened, handler)
+}
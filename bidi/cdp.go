@@ -3,13 +3,11 @@ package bidi
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"sync"
-	"sync/atomic"
 )
 
-// BrowserError represents an error returned by the browser.
+// BrowserError represents a CDP error returned by the browser.
 type BrowserError struct {
 	Detail  interface{} `json:"data,omitempty"`
 	Message string      `json:"message"`
@@ -20,95 +18,318 @@ func (e *BrowserError) Error() string {
 	return fmt.Sprintf("BrowserError<code=%d message=%s> %v", e.Code, e.Message, e.Detail)
 }
 
-// CDPSessionImpl represents a CDP session implementation.
+// cdpCommand is the CDP command envelope.
+type cdpCommand struct {
+	Params    interface{} `json:"params,omitempty"`
+	Method    string      `json:"method"`
+	SessionID string      `json:"sessionId,omitempty"`
+	ID        int64       `json:"id"`
+}
+
+// cdpMessage is a CDP response or event.
+type cdpMessage struct {
+	ID        *int64          `json:"id"`
+	Error     *BrowserError   `json:"error"`
+	Method    string          `json:"method"`
+	SessionID string          `json:"sessionId"`
+	Params    json.RawMessage `json:"params"`
+	Result    json.RawMessage `json:"result"`
+}
+
+// decodeCDP classifies a CDP message.
+func decodeCDP(data []byte) (*int64, response, event, bool) {
+	var msg cdpMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, response{}, event{}, false
+	}
+
+	if msg.ID != nil {
+		resp := response{err: nil, result: msg.Result}
+		if msg.Error != nil {
+			resp.err = msg.Error
+		}
+
+		return msg.ID, resp, event{}, true
+	}
+
+	if msg.Method == "" {
+		return nil, response{}, event{}, false
+	}
+
+	return nil, response{}, event{sessionID: msg.SessionID, method: msg.Method, params: msg.Params}, true
+}
+
+// CDPConn is a browser level CDP connection.
+//
+// Targets are attached in flattened mode, so every target session shares the
+// connection and is addressed by the sessionId member of each message.
+// A CDPConn is safe for concurrent use.
+type CDPConn struct {
+	rpc      *rpc
+	browser  *CDPSessionImpl
+	sessions map[string]*CDPSessionImpl
+	mu       sync.RWMutex
+}
+
+// NewCDPConn creates a CDP connection on an open WebSocket.
+func NewCDPConn(ws WebSocket) *CDPConn {
+	c := newCDPConn()
+	c.start(ws)
+
+	return c
+}
+
+// newCDPConn creates a connection that is not reading yet.
+func newCDPConn() *CDPConn {
+	//nolint:exhaustruct // rpc is set by start and browser below.
+	c := &CDPConn{
+		sessions: make(map[string]*CDPSessionImpl),
+	}
+	c.browser = newCDPSessionImpl(c, "", "", false)
+
+	return c
+}
+
+// start begins reading from ws.
+func (c *CDPConn) start(ws WebSocket) {
+	c.rpc = newRPC(ws, decodeCDP, c.dispatch)
+}
+
+// DialCDP connects to a CDP WebSocket URL such as the webSocketDebuggerUrl of a browser.
+func DialCDP(ctx context.Context, webSocketURL string) (*CDPConn, error) {
+	ws, err := DialWebSocket(ctx, webSocketURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCDPConn(ws), nil
+}
+
+// DialCDPTarget connects to a CDP WebSocket URL and attaches to a target.
+//
+// The session owns the connection, so closing it closes the connection.
+func DialCDPTarget(ctx context.Context, webSocketURL, targetID string) (*CDPSessionImpl, error) {
+	conn, err := DialCDP(ctx, webSocketURL)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := conn.AttachToTarget(ctx, targetID)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	session.ownsConn = true
+
+	return session, nil
+}
+
+// Browser returns the browser level session, which has no session ID.
+func (c *CDPConn) Browser() *CDPSessionImpl {
+	return c.browser
+}
+
+// AttachToTarget attaches to a target in flattened mode and returns its session.
+func (c *CDPConn) AttachToTarget(ctx context.Context, targetID string) (*CDPSessionImpl, error) {
+	result, err := Run(ctx, c.browser, NewCDPCommand[struct {
+		SessionID string `json:"sessionId"`
+	}]("Target.attachToTarget", map[string]interface{}{"targetId": targetID, "flatten": true}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach to target %s: %w", targetID, err)
+	}
+
+	session := newCDPSessionImpl(c, result.SessionID, targetID, false)
+
+	c.mu.Lock()
+	c.sessions[result.SessionID] = session
+	c.mu.Unlock()
+
+	return session, nil
+}
+
+// Done returns a channel that is closed once the connection stops receiving messages.
+func (c *CDPConn) Done() <-chan struct{} {
+	return c.rpc.done
+}
+
+// Close closes the connection and every attached session.
+func (c *CDPConn) Close() error {
+	return c.rpc.close()
+}
+
+// execute sends a command to the given session.
+func (c *CDPConn) execute(
+	ctx context.Context, sessionID, method string, params interface{},
+) (json.RawMessage, error) {
+	return c.rpc.call(ctx, func(id int64) interface{} {
+		return cdpCommand{Params: params, Method: method, SessionID: sessionID, ID: id}
+	})
+}
+
+// dispatch routes an event to the session it belongs to.
+func (c *CDPConn) dispatch(ev event) {
+	if ev.method == "Target.detachedFromTarget" {
+		var params struct {
+			SessionID string `json:"sessionId"`
+		}
+		if err := json.Unmarshal(ev.params, &params); err == nil {
+			c.forget(params.SessionID)
+		}
+	}
+
+	session := c.browser
+	if ev.sessionID != "" {
+		c.mu.RLock()
+		session = c.sessions[ev.sessionID]
+		c.mu.RUnlock()
+	}
+
+	if session != nil {
+		session.HandleEvent(ev.method, ev.params)
+	}
+}
+
+// forget removes a detached session.
+func (c *CDPConn) forget(sessionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.sessions, sessionID)
+}
+
+// CDPSessionImpl is a CDP session on a CDPConn.
+//
+// A CDPSessionImpl is safe for concurrent use. Commands from different
+// goroutines are matched to their responses by ID. Events are passed to
+// handlers in order on a dedicated goroutine, so handlers may execute commands.
 type CDPSessionImpl struct {
-	ws        WebSocket
-	inflight  map[int64]chan json.RawMessage
+	conn      *CDPConn
+	handlers  handlerSet
 	sessionID string
 	targetID  string
-	nextID    atomic.Int64
 	mu        sync.RWMutex
-	closed    bool
+	// ownsConn closes the connection when the session is closed.
+	ownsConn bool
 }
 
-// NewCDPSession creates a new CDP session.
-func NewCDPSession(ws WebSocket, sessionID, targetID string) CDPSession {
-	//nolint:exhaustruct // Initialize memory-sensitive fields only for better readability.
+// newCDPSessionImpl creates a session on conn.
+func newCDPSessionImpl(conn *CDPConn, sessionID, targetID string, ownsConn bool) *CDPSessionImpl {
 	return &CDPSessionImpl{
-		ws:        ws,
+		conn:      conn,
+		handlers:  newHandlerSet(),
 		sessionID: sessionID,
 		targetID:  targetID,
-		inflight:  make(map[int64]chan json.RawMessage),
+		mu:        sync.RWMutex{},
+		ownsConn:  ownsConn,
 	}
 }
 
-var ErrSessionClosed = errors.New("session is closed")
+// NewCDPSession creates a CDP session for an already attached target on its
+// own connection. Closing the session closes the connection.
+func NewCDPSession(ws WebSocket, sessionID, targetID string) CDPSession {
+	conn := newCDPConn()
+	session := newCDPSessionImpl(conn, sessionID, targetID, true)
 
-// Execute sends a command to the browser and waits for the response.
-func (s *CDPSessionImpl) Execute(ctx context.Context, method string, params interface{}) ([]byte, error) {
-	if s.closed {
-		return nil, ErrSessionClosed
+	if sessionID == "" {
+		conn.browser = session
+	} else {
+		conn.sessions[sessionID] = session
 	}
 
-	id := s.nextID.Add(1)
-	cmd := struct {
-		Params    interface{} `json:"params,omitempty"`
-		Method    string      `json:"method"`
-		SessionID string      `json:"sessionId,omitempty"`
-		ID        int64       `json:"id"`
-	}{
-		ID:        id,
-		Method:    method,
-		Params:    params,
-		SessionID: s.sessionID,
-	}
+	conn.start(ws)
 
-	data, err := json.Marshal(cmd)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal command: %w", err)
-	}
+	return session
+}
+
+// Execute sends a command to the browser and waits for the response.
+//
+// An error response is returned as a *BrowserError.
+func (s *CDPSessionImpl) Execute(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	return s.conn.execute(ctx, s.sessionID, method, params)
+}
+
+// Protocol returns ProtocolCDP.
+func (s *CDPSessionImpl) Protocol() Protocol {
+	return ProtocolCDP
+}
 
-	resultCh := make(chan json.RawMessage, 1)
+// SessionID returns the flattened session ID, empty for the browser session.
+func (s *CDPSessionImpl) SessionID() string {
+	return s.sessionID
+}
+
+// TargetID returns the ID of the attached target.
+func (s *CDPSessionImpl) TargetID() string {
+	return s.targetID
+}
 
+// Subscribe adds an event handler for a CDP event such as Page.loadEventFired
+// and returns a function that removes only this handler.
+func (s *CDPSessionImpl) Subscribe(method string, handler func(json.RawMessage)) func() {
 	s.mu.Lock()
-	s.inflight[id] = resultCh
-	s.mu.Unlock()
+	defer s.mu.Unlock()
+
+	id := s.handlers.add(method, handler)
 
-	if err := s.ws.Send(data); err != nil {
+	return func() {
 		s.mu.Lock()
-		delete(s.inflight, id)
-		s.mu.Unlock()
+		defer s.mu.Unlock()
 
-		return nil, fmt.Errorf("failed to send command: %w", err)
+		s.handlers.remove(method, id)
 	}
+}
 
-	select {
-	case result := <-resultCh:
-		return result, nil
-	case <-ctx.Done():
-		s.mu.Lock()
-		delete(s.inflight, id)
-		s.mu.Unlock()
+// Unsubscribe removes all handlers for a CDP event.
+//
+// Deprecated: Unsubscribe also removes handlers added by other packages.
+// Call the function returned by Subscribe instead.
+func (s *CDPSessionImpl) Unsubscribe(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-		return nil, ctx.Err()
+	delete(s.handlers.handlers, method)
+}
+
+// HandleEvent passes an event to its handlers.
+func (s *CDPSessionImpl) HandleEvent(method string, params json.RawMessage) {
+	s.mu.RLock()
+	handlers := s.handlers.get(method)
+	s.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler.handle(params)
 	}
 }
 
-// Close closes the CDP session.
+// Done returns a channel that is closed once the connection stops receiving messages.
+func (s *CDPSessionImpl) Done() <-chan struct{} {
+	return s.conn.Done()
+}
+
+// Err returns the reason the connection stopped, or nil while it is running or after Close.
+func (s *CDPSessionImpl) Err() error {
+	return s.conn.rpc.stopErr()
+}
+
+// Close detaches from the target, or closes the connection if the session owns it.
 func (s *CDPSessionImpl) Close() error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	if s.ownsConn {
+		return s.conn.Close()
+	}
 
-	if s.closed {
+	if s.sessionID == "" {
 		return nil
 	}
 
-	s.closed = true
-	for _, ch := range s.inflight {
-		close(ch)
-	}
+	s.conn.forget(s.sessionID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
 
-	s.inflight = nil
+	_, err := s.conn.execute(ctx, "", "Target.detachFromTarget",
+		map[string]interface{}{"sessionId": s.sessionID})
 
-	return s.ws.Close()
+	return err
 }
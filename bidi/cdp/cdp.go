@@ -0,0 +1,61 @@
+// Package cdp holds the types shared by the generated Chrome DevTools Protocol domains.
+//
+// Every domain is generated into its own package, such as cdp/page or
+// cdp/network, with typed params, results and events. Domains refer to each
+// other's types, for example Network uses Page.FrameId and Page uses
+// Network.LoaderId, so types used outside their domain are generated here with
+// the domain name as prefix and aliased in their domain package:
+// network.Request and cdp.NetworkRequest are the same type.
+//
+// Commands run over any CDP transport:
+//
+//	session, err := conn.AttachToTarget(ctx, targetID)
+//	...
+//	page.OnLoadEventFired(session, func(*page.LoadEventFiredEvent) { ... })
+//	err = page.Enable(ctx, session, page.EnableParams{})
+//	result, err := page.Navigate(ctx, session, page.NavigateParams{URL: "https://example.com"})
+//
+// The bindings are generated from the protocol JSON files in the protocol
+// directory, copied from version 0.0.1495869 of the devtools-protocol package.
+// To update them, replace those files with newer ones from the
+// ChromeDevTools/devtools-protocol repository and run:
+//
+//	go generate ./bidi/cdp
+package cdp
+
+import (
+	"encoding/json"
+)
+
+//go:generate go run ./cdpgen -protocol protocol -out .
+
+// EventSource delivers CDP events, such as a *bidi.CDPSessionImpl.
+type EventSource interface {
+	// Subscribe adds a handler for the raw params of an event and returns a
+	// function that removes it.
+	Subscribe(method string, handler func(json.RawMessage)) func()
+}
+
+// On subscribes to a CDP event and passes its decoded params to handler.
+//
+// Events whose params cannot be decoded into T are dropped. The domain must
+// be enabled, for example with page.Enable, for the browser to send events.
+// The returned function removes the handler.
+func On[T any](source EventSource, method string, handler func(*T)) func() {
+	return source.Subscribe(method, func(data json.RawMessage) {
+		params := new(T)
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, params); err != nil {
+				return
+			}
+		}
+
+		handler(params)
+	})
+}
+
+// Ptr returns a pointer to v, for optional booleans, numbers and structs
+// whose zero value must be sent, such as page.CaptureScreenshotParams.FromSurface.
+func Ptr[T any](v T) *T {
+	return &v
+}
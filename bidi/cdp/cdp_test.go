@@ -0,0 +1,126 @@
+package cdp_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/biditest"
+	"github.com/Kcrong/selenium/bidi/cdp"
+	"github.com/Kcrong/selenium/bidi/cdp/fetch"
+	"github.com/Kcrong/selenium/bidi/cdp/network"
+	"github.com/Kcrong/selenium/bidi/cdp/page"
+)
+
+var _ cdp.EventSource = (*bidi.CDPSessionImpl)(nil)
+
+// session records commands and delivers events like a CDP session.
+type session struct {
+	results  map[string]string
+	params   map[string]json.RawMessage
+	handlers map[string][]func(json.RawMessage)
+}
+
+func newSession() *session {
+	return &session{
+		results:  make(map[string]string),
+		params:   make(map[string]json.RawMessage),
+		handlers: make(map[string][]func(json.RawMessage)),
+	}
+}
+
+func (s *session) Execute(_ context.Context, method string, params interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	s.params[method] = data
+
+	return json.RawMessage(s.results[method]), nil
+}
+
+func (s *session) Protocol() bidi.Protocol {
+	return bidi.ProtocolCDP
+}
+
+func (s *session) Subscribe(method string, handler func(json.RawMessage)) func() {
+	s.handlers[method] = append(s.handlers[method], handler)
+
+	return func() {}
+}
+
+func (s *session) emit(method, params string) {
+	for _, handler := range s.handlers[method] {
+		handler(json.RawMessage(params))
+	}
+}
+
+func TestCommands(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := newSession()
+	s.results["Page.navigate"] = `{"frameId": "F1", "loaderId": "L1"}`
+
+	require.NoError(t, page.Enable(ctx, s, page.EnableParams{}))
+	assert.JSONEq(t, `{}`, string(s.params["Page.enable"]))
+
+	result, err := page.Navigate(ctx, s, page.NavigateParams{
+		URL:            "https://example.com",
+		TransitionType: page.TransitionTypeTyped,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, page.FrameID("F1"), result.FrameID)
+	assert.Equal(t, network.LoaderID("L1"), result.LoaderID)
+	assert.JSONEq(t, `{"url": "https://example.com", "transitionType": "typed"}`, string(s.params["Page.navigate"]))
+
+	require.NoError(t, network.SetExtraHTTPHeaders(ctx, s, network.SetExtraHTTPHeadersParams{
+		Headers: network.Headers{"X-Test": "1"},
+	}))
+	assert.JSONEq(t, `{"headers": {"X-Test": "1"}}`, string(s.params["Network.setExtraHTTPHeaders"]))
+}
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+
+	s := newSession()
+
+	var (
+		paused *fetch.RequestPausedEvent
+		loaded int
+	)
+
+	fetch.OnRequestPaused(s, func(event *fetch.RequestPausedEvent) { paused = event })
+	page.OnLoadEventFired(s, func(*page.LoadEventFiredEvent) { loaded++ })
+
+	s.emit(fetch.EventRequestPaused, `{
+		"requestId": "R1", "frameId": "F1", "resourceType": "Document",
+		"request": {"url": "https://example.com/", "method": "GET", "headers": {"Accept": "*/*"}}
+	}`)
+	s.emit(page.EventLoadEventFired, `{"timestamp": 1.5}`)
+	s.emit(page.EventLoadEventFired, `{"timestamp": "invalid"}`)
+
+	require.NotNil(t, paused)
+	assert.Equal(t, fetch.RequestID("R1"), paused.RequestID)
+	assert.Equal(t, network.ResourceTypeDocument, paused.ResourceType)
+
+	// Fetch refers to the Network types shared through package cdp.
+	var request network.Request = paused.Request
+	assert.Equal(t, "https://example.com/", request.URL)
+	assert.Equal(t, cdp.NetworkHeaders{"Accept": "*/*"}, request.Headers)
+	assert.Equal(t, 1, loaded)
+}
+
+func TestWrongProtocol(t *testing.T) {
+	t.Parallel()
+
+	session, _ := biditest.NewSession(t)
+
+	err := page.Enable(context.Background(), session, page.EnableParams{})
+	require.ErrorIs(t, err, bidi.ErrWrongProtocol)
+}
@@ -0,0 +1,783 @@
+// Command cdpgen generates typed Chrome DevTools Protocol domain packages.
+//
+// It reads browser_protocol.json and js_protocol.json from the -protocol
+// directory and writes one package per domain below -out, plus the shared
+// types.go of package cdp.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Domains are the generated domains.
+var Domains = []string{"DOM", "Emulation", "Fetch", "Network", "Page", "Performance", "Runtime", "Target"}
+
+// protocolFiles are read from the protocol directory.
+var protocolFiles = []string{"browser_protocol.json", "js_protocol.json"}
+
+const cdpImport = "github.com/Kcrong/selenium/bidi/cdp"
+
+var errDuplicate = errors.New("duplicate identifier")
+
+// spec is a type, property, parameter or return value.
+type spec struct {
+	Items        *spec    `json:"items"`
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Type         string   `json:"type"`
+	Ref          string   `json:"$ref"`
+	Enum         []string `json:"enum"`
+	Properties   []*spec  `json:"properties"`
+	Optional     bool     `json:"optional"`
+	Experimental bool     `json:"experimental"`
+	Deprecated   bool     `json:"deprecated"`
+}
+
+// command is a command or an event.
+type command struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	Parameters   []*spec `json:"parameters"`
+	Returns      []*spec `json:"returns"`
+	Experimental bool    `json:"experimental"`
+	Deprecated   bool    `json:"deprecated"`
+}
+
+type domain struct {
+	Domain       string     `json:"domain"`
+	Description  string     `json:"description"`
+	Types        []*spec    `json:"types"`
+	Commands     []*command `json:"commands"`
+	Events       []*command `json:"events"`
+	Experimental bool       `json:"experimental"`
+	Deprecated   bool       `json:"deprecated"`
+}
+
+type protocol struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*domain `json:"domains"`
+}
+
+func main() {
+	dir := flag.String("protocol", "", "directory containing browser_protocol.json and js_protocol.json")
+	out := flag.String("out", ".", "directory of the cdp package")
+	flag.Parse()
+
+	if *dir == "" {
+		log.Fatal("cdpgen: -protocol is required")
+	}
+
+	p, err := readProtocol(*dir)
+	if err != nil {
+		log.Fatalf("cdpgen: %v", err)
+	}
+
+	files, err := Generate(p)
+	if err != nil {
+		log.Fatalf("cdpgen: %v", err)
+	}
+
+	for name, data := range files {
+		path := filepath.Join(*out, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			log.Fatalf("cdpgen: %v", err)
+		}
+
+		if err := os.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // Generated sources are not secret.
+			log.Fatalf("cdpgen: %v", err)
+		}
+	}
+}
+
+// readProtocol reads and merges the protocol files.
+func readProtocol(dir string) (*protocol, error) {
+	var merged protocol
+
+	for _, name := range protocolFiles {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		var p protocol
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		merged.Version = p.Version
+		merged.Domains = append(merged.Domains, p.Domains...)
+	}
+
+	return &merged, nil
+}
+
+// generator holds the protocol index.
+type generator struct {
+	protocol *protocol
+	domains  map[string]*domain
+	types    map[string]*spec
+	// shared are the qualified names of the types generated in package cdp.
+	shared map[string]bool
+}
+
+// Generate returns the generated files by path relative to the cdp package.
+func Generate(p *protocol) (map[string][]byte, error) {
+	g := &generator{
+		protocol: p,
+		domains:  make(map[string]*domain),
+		types:    make(map[string]*spec),
+		shared:   make(map[string]bool),
+	}
+
+	for _, d := range p.Domains {
+		g.domains[d.Domain] = d
+		for _, t := range d.Types {
+			g.types[d.Domain+"."+t.ID] = t
+		}
+	}
+
+	for _, name := range Domains {
+		if g.domains[name] == nil {
+			return nil, fmt.Errorf("domain %s not found", name)
+		}
+	}
+
+	g.hoist()
+
+	files := make(map[string][]byte)
+
+	data, err := g.sharedFile()
+	if err != nil {
+		return nil, fmt.Errorf("types.go: %w", err)
+	}
+
+	files["types.go"] = data
+
+	for _, name := range Domains {
+		pkg := strings.ToLower(name)
+
+		data, err := g.domainFile(g.domains[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		files[filepath.Join(pkg, pkg+".go")] = data
+	}
+
+	return files, nil
+}
+
+// hoist moves every type used outside its domain, and the types it depends on, to package cdp.
+func (g *generator) hoist() {
+	var visit func(q string)
+	visit = func(q string) {
+		if g.shared[q] {
+			return
+		}
+
+		g.shared[q] = true
+
+		for _, ref := range refs(g.types[q]) {
+			visit(qualify(ref, domainOf(q)))
+		}
+	}
+
+	for _, name := range Domains {
+		d := g.domains[name]
+
+		var all []string
+		for _, t := range d.Types {
+			all = append(all, refs(t)...)
+		}
+
+		for _, c := range append(append([]*command{}, d.Commands...), d.Events...) {
+			for _, s := range append(append([]*spec{}, c.Parameters...), c.Returns...) {
+				all = append(all, refs(s)...)
+			}
+		}
+
+		for _, ref := range all {
+			if q := qualify(ref, name); domainOf(q) != name {
+				visit(q)
+			}
+		}
+	}
+}
+
+// refs returns the type references in s.
+func refs(s *spec) []string {
+	if s == nil {
+		return nil
+	}
+
+	var result []string
+	if s.Ref != "" {
+		result = append(result, s.Ref)
+	}
+
+	result = append(result, refs(s.Items)...)
+	for _, p := range s.Properties {
+		result = append(result, refs(p)...)
+	}
+
+	return result
+}
+
+// qualify prefixes a reference with its domain.
+func qualify(ref, domainName string) string {
+	if strings.Contains(ref, ".") {
+		return ref
+	}
+
+	return domainName + "." + ref
+}
+
+func domainOf(q string) string {
+	return q[:strings.Index(q, ".")]
+}
+
+func idOf(q string) string {
+	return q[strings.Index(q, ".")+1:]
+}
+
+// sharedName is the name of a type in package cdp.
+func sharedName(q string) string {
+	d, id := goName(domainOf(q)), goName(idOf(q))
+	if strings.HasPrefix(id, d) {
+		return id
+	}
+
+	return d + id
+}
+
+// file accumulates the declarations of one package.
+type file struct {
+	buf      bytes.Buffer
+	g        *generator
+	declared map[string]bool
+	domain   string
+	shared   bool
+}
+
+func (f *file) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&f.buf, format, args...)
+}
+
+// declare records an identifier and fails on duplicates.
+func (f *file) declare(name string) error {
+	if f.declared[name] {
+		return fmt.Errorf("%w: %s", errDuplicate, name)
+	}
+
+	f.declared[name] = true
+
+	return nil
+}
+
+func (g *generator) newFile(domainName string, shared bool) *file {
+	return &file{g: g, declared: make(map[string]bool), domain: domainName, shared: shared}
+}
+
+// header is the generated code marker.
+func (g *generator) header() string {
+	return fmt.Sprintf("// Code generated by cdpgen from protocol version %s.%s. DO NOT EDIT.\n\n",
+		g.protocol.Version.Major, g.protocol.Version.Minor)
+}
+
+func (g *generator) sharedFile() ([]byte, error) {
+	f := g.newFile("", true)
+
+	names := make([]string, 0, len(g.shared))
+	for q := range g.shared {
+		names = append(names, q)
+	}
+
+	sort.Strings(names)
+
+	for _, q := range names {
+		f.domain = domainOf(q)
+		if err := f.typeDecl(sharedName(q), g.types[q], q); err != nil {
+			return nil, err
+		}
+	}
+
+	return f.source("package cdp\n\n", "")
+}
+
+func (g *generator) domainFile(d *domain) ([]byte, error) {
+	f := g.newFile(d.Domain, false)
+	pkg := strings.ToLower(d.Domain)
+
+	for _, t := range d.Types {
+		q := d.Domain + "." + t.ID
+		if g.shared[q] {
+			if err := f.alias(goName(t.ID), t, q); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if err := f.typeDecl(goName(t.ID), t, q); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range d.Commands {
+		if err := f.command(c); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, e := range d.Events {
+		if err := f.event(e); err != nil {
+			return nil, err
+		}
+	}
+
+	doc := fmt.Sprintf("Package %s provides typed bindings for the CDP %s domain.", pkg, d.Domain)
+	if d.Description != "" {
+		doc += "\n\n" + sentence(d.Description)
+	}
+
+	return f.source(comment(doc)+"package "+pkg+"\n\n", pkg)
+}
+
+// source adds the header and imports and formats the file.
+func (f *file) source(pkgClause, pkg string) ([]byte, error) {
+	body := f.buf.String()
+
+	var imports []string
+	if strings.Contains(body, "context.Context") {
+		imports = append(imports, `"context"`)
+	}
+
+	if strings.Contains(body, "json.RawMessage") {
+		imports = append(imports, `"encoding/json"`)
+	}
+
+	var local []string
+	if strings.Contains(body, "bidi.") {
+		local = append(local, `"github.com/Kcrong/selenium/bidi"`)
+	}
+
+	if pkg != "" && strings.Contains(body, "cdp.") {
+		local = append(local, `"`+cdpImport+`"`)
+	}
+
+	var src strings.Builder
+
+	src.WriteString(f.g.header())
+	src.WriteString(pkgClause)
+
+	if len(imports)+len(local) > 0 {
+		src.WriteString("import (\n")
+
+		for _, imp := range imports {
+			src.WriteString("\t" + imp + "\n")
+		}
+
+		if len(imports) > 0 && len(local) > 0 {
+			src.WriteString("\n")
+		}
+
+		for _, imp := range local {
+			src.WriteString("\t" + imp + "\n")
+		}
+
+		src.WriteString(")\n\n")
+	}
+
+	src.WriteString(body)
+
+	formatted, err := format.Source([]byte(src.String()))
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, src.String())
+	}
+
+	return formatted, nil
+}
+
+// typeDecl declares a protocol type.
+func (f *file) typeDecl(name string, t *spec, q string) error {
+	if err := f.declare(name); err != nil {
+		return err
+	}
+
+	f.printf("%s", docComment(name, typeDescription(t, q), t.Deprecated, t.Experimental))
+
+	switch {
+	case t.Type == "object" && len(t.Properties) > 0:
+		f.printf("type %s struct {\n", name)
+		f.fields(t.Properties)
+		f.printf("}\n\n")
+	case len(t.Enum) > 0:
+		f.printf("type %s string\n\n", name)
+
+		return f.enum(name, t.Enum, func(value string) string { return fmt.Sprintf("%s = %q", name, value) })
+	default:
+		f.printf("type %s %s\n\n", name, f.goType(&spec{Type: t.Type, Items: t.Items}, domainOf(q)))
+	}
+
+	return nil
+}
+
+// typeDescription falls back to the protocol name for undocumented types.
+func typeDescription(t *spec, q string) string {
+	if t.Description == "" {
+		return "is the " + q + " type."
+	}
+
+	return t.Description
+}
+
+// alias declares a domain type generated in package cdp.
+func (f *file) alias(name string, t *spec, q string) error {
+	if err := f.declare(name); err != nil {
+		return err
+	}
+
+	shared := sharedName(q)
+
+	f.printf("%s", docComment(name, typeDescription(t, q), t.Deprecated, t.Experimental))
+	f.printf("type %s = cdp.%s\n\n", name, shared)
+
+	if len(t.Enum) == 0 {
+		return nil
+	}
+
+	return f.enum(name, t.Enum, func(value string) string { return "= cdp." + shared + goName(value) })
+}
+
+// enum declares the constants of an enum type.
+func (f *file) enum(name string, values []string, value func(string) string) error {
+	f.printf("const (\n")
+
+	for _, v := range values {
+		constant := name + goName(v)
+		if err := f.declare(constant); err != nil {
+			return err
+		}
+
+		f.printf("\t%s %s\n", constant, value(v))
+	}
+
+	f.printf(")\n\n")
+
+	return nil
+}
+
+// fields writes struct fields.
+func (f *file) fields(props []*spec) {
+	for _, p := range props {
+		desc := sentence(p.Description)
+		if len(p.Enum) > 0 {
+			desc = strings.TrimSpace(desc + "\n\nValues: " + strings.Join(p.Enum, ", ") + ".")
+		}
+
+		if p.Deprecated {
+			desc = strings.TrimSpace(desc + "\n\nDeprecated: deprecated in the protocol.")
+		}
+
+		f.printf("%s", comment(desc))
+
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+
+		f.printf("\t%s %s `json:%q`\n", goName(p.Name), f.fieldType(p), tag)
+	}
+}
+
+// fieldType is the type of a field; optional structs, booleans and numbers
+// are pointers so false and 0 can be sent.
+func (f *file) fieldType(p *spec) string {
+	t := f.goType(p, f.domain)
+	if p.Optional && (f.isScalar(p) || p.Ref != "" && f.isStruct(qualify(p.Ref, f.domain))) {
+		return "*" + t
+	}
+
+	return t
+}
+
+// isScalar reports whether a type is a boolean or a number, directly or by reference.
+func (f *file) isScalar(s *spec) bool {
+	if s.Ref != "" {
+		t := f.g.types[qualify(s.Ref, f.domain)]
+
+		return t != nil && t.Ref == "" && f.isScalar(t)
+	}
+
+	switch s.Type {
+	case "boolean", "integer", "number":
+		return true
+	default:
+		return false
+	}
+}
+
+func (f *file) isStruct(q string) bool {
+	t := f.g.types[q]
+
+	return t != nil && t.Type == "object" && len(t.Properties) > 0
+}
+
+// goType maps a protocol type to Go.
+func (f *file) goType(s *spec, domainName string) string {
+	if s.Ref != "" {
+		q := qualify(s.Ref, domainName)
+
+		switch {
+		case f.shared:
+			return sharedName(q)
+		case domainOf(q) != f.domain:
+			return "cdp." + sharedName(q)
+		default:
+			return goName(idOf(q))
+		}
+	}
+
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + f.goType(s.Items, domainName)
+	case "object":
+		return "map[string]interface{}"
+	default:
+		return "json.RawMessage"
+	}
+}
+
+// command writes the params, result and function of a command.
+func (f *file) command(c *command) error {
+	name := goName(c.Name)
+	method := f.domain + "." + c.Name
+
+	paramsName, resultName := name+"Params", name+"Result"
+	for _, n := range []string{name, paramsName, resultName} {
+		if err := f.declare(n); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Parameters) > 0 {
+		f.printf("// %s are the parameters of %s.\n", paramsName, name)
+		f.printf("type %s struct {\n", paramsName)
+		f.fields(c.Parameters)
+		f.printf("}\n\n")
+	}
+
+	if len(c.Returns) > 0 {
+		f.printf("// %s is the result of %s.\n", resultName, name)
+		f.printf("type %s struct {\n", resultName)
+		f.fields(c.Returns)
+		f.printf("}\n\n")
+	}
+
+	args, params := "ctx context.Context, session bidi.Transport", "nil"
+	if len(c.Parameters) > 0 {
+		args += ", params " + paramsName
+		params = "params"
+	}
+
+	description := c.Description
+	if description == "" {
+		description = "runs " + method
+	}
+
+	f.printf("%s", docComment(name, description, c.Deprecated, c.Experimental))
+
+	if len(c.Returns) > 0 {
+		f.printf("func %s(%s) (*%s, error) {\n", name, args, resultName)
+		f.printf("\treturn bidi.Run(ctx, session, bidi.NewCDPCommand[%s](%q, %s))\n}\n\n", resultName, method, params)
+
+		return nil
+	}
+
+	f.printf("func %s(%s) error {\n", name, args)
+	f.printf("\t_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}](%q, %s))\n\n", method, params)
+	f.printf("\treturn err\n}\n\n")
+
+	return nil
+}
+
+// event writes the params, method constant and subscription function of an event.
+func (f *file) event(e *command) error {
+	name := goName(e.Name)
+	eventName, constName, onName := name+"Event", "Event"+name, "On"+name
+
+	for _, n := range []string{eventName, constName, onName} {
+		if err := f.declare(n); err != nil {
+			return err
+		}
+	}
+
+	f.printf("%s", docComment(eventName, "is sent on "+f.domain+"."+e.Name+".\n\n"+e.Description,
+		e.Deprecated, e.Experimental))
+	f.printf("type %s struct {\n", eventName)
+	f.fields(e.Parameters)
+	f.printf("}\n\n")
+
+	f.printf("// %s is the method of %s.\n", constName, eventName)
+	f.printf("const %s = %q\n\n", constName, f.domain+"."+e.Name)
+
+	f.printf("// %s calls handler for every %s.\n//\n// The returned function removes the handler.\n", onName, eventName)
+	f.printf("func %s(source cdp.EventSource, handler func(*%s)) func() {\n", onName, eventName)
+	f.printf("\treturn cdp.On(source, %s, handler)\n}\n\n", constName)
+
+	return nil
+}
+
+// docComment documents a declaration.
+func docComment(name, description string, deprecated, experimental bool) string {
+	doc := name
+	if description = strings.TrimSpace(description); description != "" {
+		if strings.HasPrefix(description, "is ") {
+			doc += " " + sentence(description)
+		} else {
+			doc += " " + sentence(lowerFirst(description))
+		}
+	}
+
+	if experimental {
+		doc += "\n\nExperimental."
+	}
+
+	if deprecated {
+		doc += "\n\nDeprecated: deprecated in the protocol."
+	}
+
+	return comment(doc)
+}
+
+// comment formats text as a comment.
+func comment(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ""
+	}
+
+	var b strings.Builder
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			b.WriteString("//\n")
+			continue
+		}
+
+		b.WriteString("// " + line + "\n")
+	}
+
+	return b.String()
+}
+
+// sentence ends a description with a period unless it already ends a sentence.
+func sentence(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" || strings.ContainsAny(text[len(text)-1:], ".:!?") {
+		return text
+	}
+
+	return text + "."
+}
+
+// lowerFirst lowers the first letter unless it starts an acronym.
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	if len(runes) > 1 && unicode.IsUpper(runes[1]) {
+		return s
+	}
+
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// initialisms are written in upper case, as golint expects.
+var initialisms = map[string]bool{
+	"API": true, "ASCII": true, "CORS": true, "CPU": true, "CSP": true, "CSS": true, "DNS": true,
+	"DOM": true, "EOF": true, "GPU": true, "GUID": true, "HTML": true, "HTTP": true, "HTTPS": true,
+	"ID": true, "IP": true, "JS": true, "JSON": true, "RPC": true, "SQL": true, "SSL": true,
+	"TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true, "UID": true, "URI": true,
+	"URL": true, "UTF8": true, "UUID": true, "XML": true,
+}
+
+// goName converts a protocol name, such as frameId or same-origin, to an exported Go identifier.
+func goName(s string) string {
+	var b strings.Builder
+
+	for _, word := range splitWords(s) {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	return b.String()
+}
+
+// splitWords splits camelCase, PascalCase, acronyms and separated words.
+func splitWords(s string) []string {
+	var (
+		words []string
+		word  []rune
+	)
+
+	runes := []rune(s)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+
+		if i > 0 && unicode.IsUpper(r) && len(word) > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+
+		word = append(word, r)
+	}
+
+	flush()
+
+	return words
+}
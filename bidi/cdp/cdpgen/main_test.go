@@ -0,0 +1,99 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"frameId":                        "FrameID",
+		"navigate":                       "Navigate",
+		"XMLHttpRequest":                 "XMLHTTPRequest",
+		"same-origin":                    "SameOrigin",
+		"DOM":                            "DOM",
+		"getResponseBodyForInterception": "GetResponseBodyForInterception",
+		"requestURL":                     "RequestURL",
+		"webSocketDebuggerUrl":           "WebSocketDebuggerURL",
+		"h2":                             "H2",
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, goName(in), in)
+	}
+}
+
+// TestGenerated checks the checked-in bindings are up to date with the
+// checked-in protocol.
+func TestGenerated(t *testing.T) {
+	t.Parallel()
+
+	p, err := readProtocol(filepath.Join("..", "protocol"))
+	require.NoError(t, err)
+
+	files, err := Generate(p)
+	require.NoError(t, err)
+	require.Len(t, files, len(Domains)+1)
+
+	for name, data := range files {
+		current, err := os.ReadFile(filepath.Join("..", name))
+		require.NoError(t, err)
+		assert.Equal(t, string(current), string(data), "%s is out of date; run go generate", name)
+	}
+}
+
+func TestGenerateOptionalFields(t *testing.T) {
+	t.Parallel()
+
+	//nolint:exhaustruct // Only the fields used by the generator are set.
+	p := &protocol{}
+	for _, name := range Domains {
+		//nolint:exhaustruct // Empty domains.
+		p.Domains = append(p.Domains, &domain{Domain: name})
+	}
+
+	//nolint:exhaustruct // Only the fields used by the generator are set.
+	page := &domain{
+		Domain: "Page",
+		Types: []*spec{
+			{ID: "Scale", Type: "number"},
+			{ID: "Viewport", Type: "object", Properties: []*spec{{Name: "x", Type: "number"}}},
+		},
+		Commands: []*command{{Name: "captureScreenshot", Parameters: []*spec{
+			{Name: "format", Type: "string", Optional: true},
+			{Name: "quality", Type: "integer", Optional: true},
+			{Name: "fromSurface", Type: "boolean", Optional: true},
+			{Name: "scale", Ref: "Scale", Optional: true},
+			{Name: "clip", Ref: "Viewport", Optional: true},
+			{Name: "width", Type: "number"},
+		}}},
+	}
+
+	for i, d := range p.Domains {
+		if d.Domain == "Page" {
+			p.Domains[i] = page
+		}
+	}
+
+	files, err := Generate(p)
+	require.NoError(t, err)
+
+	// Optional booleans and numbers are pointers so false and 0 can be sent
+	src := string(files[filepath.Join("page", "page.go")])
+	for _, field := range []string{
+		`Format\s+string\s+` + "`" + `json:"format,omitempty"`,
+		`Quality\s+\*int64\s`,
+		`FromSurface\s+\*bool\s`,
+		`Scale\s+\*Scale\s`,
+		`Clip\s+\*Viewport\s`,
+		`Width\s+float64\s+` + "`" + `json:"width"`,
+	} {
+		assert.Regexp(t, field, src)
+	}
+}
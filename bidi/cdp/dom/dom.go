@@ -0,0 +1,1556 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package dom provides typed bindings for the CDP DOM domain.
+//
+// This domain exposes DOM read/write operations. Each DOM Node is represented with its mirror object
+// that has an `id`. This `id` can be used to get additional information on the Node, resolve it into
+// the JavaScript object wrapper, etc. It is important that client receives DOM events only for the
+// nodes that are known to the client. Backend keeps track of the nodes that were sent to the client
+// and never sends the same node twice. It is client's responsibility to collect information about
+// the nodes that were sent to the client. Note that `iframe` owner elements will return
+// corresponding document elements as their child nodes.
+package dom
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// NodeID unique DOM node identifier.
+type NodeID int64
+
+// BackendNodeID unique DOM node identifier used to reference a node that may not have been pushed to the
+// front-end.
+type BackendNodeID = cdp.DOMBackendNodeID
+
+// BackendNode backend node with a friendly name.
+type BackendNode struct {
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName      string        `json:"nodeName"`
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+}
+
+// PseudoType pseudo element type.
+type PseudoType string
+
+const (
+	PseudoTypeFirstLine                   PseudoType = "first-line"
+	PseudoTypeFirstLetter                 PseudoType = "first-letter"
+	PseudoTypeCheckmark                   PseudoType = "checkmark"
+	PseudoTypeBefore                      PseudoType = "before"
+	PseudoTypeAfter                       PseudoType = "after"
+	PseudoTypePickerIcon                  PseudoType = "picker-icon"
+	PseudoTypeMarker                      PseudoType = "marker"
+	PseudoTypeBackdrop                    PseudoType = "backdrop"
+	PseudoTypeColumn                      PseudoType = "column"
+	PseudoTypeSelection                   PseudoType = "selection"
+	PseudoTypeSearchText                  PseudoType = "search-text"
+	PseudoTypeTargetText                  PseudoType = "target-text"
+	PseudoTypeSpellingError               PseudoType = "spelling-error"
+	PseudoTypeGrammarError                PseudoType = "grammar-error"
+	PseudoTypeHighlight                   PseudoType = "highlight"
+	PseudoTypeFirstLineInherited          PseudoType = "first-line-inherited"
+	PseudoTypeScrollMarker                PseudoType = "scroll-marker"
+	PseudoTypeScrollMarkerGroup           PseudoType = "scroll-marker-group"
+	PseudoTypeScrollButton                PseudoType = "scroll-button"
+	PseudoTypeScrollbar                   PseudoType = "scrollbar"
+	PseudoTypeScrollbarThumb              PseudoType = "scrollbar-thumb"
+	PseudoTypeScrollbarButton             PseudoType = "scrollbar-button"
+	PseudoTypeScrollbarTrack              PseudoType = "scrollbar-track"
+	PseudoTypeScrollbarTrackPiece         PseudoType = "scrollbar-track-piece"
+	PseudoTypeScrollbarCorner             PseudoType = "scrollbar-corner"
+	PseudoTypeResizer                     PseudoType = "resizer"
+	PseudoTypeInputListButton             PseudoType = "input-list-button"
+	PseudoTypeViewTransition              PseudoType = "view-transition"
+	PseudoTypeViewTransitionGroup         PseudoType = "view-transition-group"
+	PseudoTypeViewTransitionImagePair     PseudoType = "view-transition-image-pair"
+	PseudoTypeViewTransitionGroupChildren PseudoType = "view-transition-group-children"
+	PseudoTypeViewTransitionOld           PseudoType = "view-transition-old"
+	PseudoTypeViewTransitionNew           PseudoType = "view-transition-new"
+	PseudoTypePlaceholder                 PseudoType = "placeholder"
+	PseudoTypeFileSelectorButton          PseudoType = "file-selector-button"
+	PseudoTypeDetailsContent              PseudoType = "details-content"
+	PseudoTypePicker                      PseudoType = "picker"
+	PseudoTypePermissionIcon              PseudoType = "permission-icon"
+)
+
+// ShadowRootType shadow root type.
+type ShadowRootType string
+
+const (
+	ShadowRootTypeUserAgent ShadowRootType = "user-agent"
+	ShadowRootTypeOpen      ShadowRootType = "open"
+	ShadowRootTypeClosed    ShadowRootType = "closed"
+)
+
+// CompatibilityMode document compatibility mode.
+type CompatibilityMode string
+
+const (
+	CompatibilityModeQuirksMode        CompatibilityMode = "QuirksMode"
+	CompatibilityModeLimitedQuirksMode CompatibilityMode = "LimitedQuirksMode"
+	CompatibilityModeNoQuirksMode      CompatibilityMode = "NoQuirksMode"
+)
+
+// PhysicalAxes containerSelector physical axes.
+type PhysicalAxes string
+
+const (
+	PhysicalAxesHorizontal PhysicalAxes = "Horizontal"
+	PhysicalAxesVertical   PhysicalAxes = "Vertical"
+	PhysicalAxesBoth       PhysicalAxes = "Both"
+)
+
+// LogicalAxes containerSelector logical axes.
+type LogicalAxes string
+
+const (
+	LogicalAxesInline LogicalAxes = "Inline"
+	LogicalAxesBlock  LogicalAxes = "Block"
+	LogicalAxesBoth   LogicalAxes = "Both"
+)
+
+// ScrollOrientation physical scroll orientation.
+type ScrollOrientation string
+
+const (
+	ScrollOrientationHorizontal ScrollOrientation = "horizontal"
+	ScrollOrientationVertical   ScrollOrientation = "vertical"
+)
+
+// Node DOM interaction is implemented in terms of mirror objects that represent the actual DOM nodes.
+// DOMNode is a base node mirror type.
+type Node struct {
+	// Node identifier that is passed into the rest of the DOM messages as the `nodeId`. Backend
+	// will only push node with given `id` once. It is aware of all requested nodes and will only
+	// fire DOM events for nodes known to the client.
+	NodeID NodeID `json:"nodeId"`
+	// The id of the parent node if any.
+	ParentID *NodeID `json:"parentId,omitempty"`
+	// The BackendNodeId for this node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// `Node`'s nodeType.
+	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeName.
+	NodeName string `json:"nodeName"`
+	// `Node`'s localName.
+	LocalName string `json:"localName"`
+	// `Node`'s nodeValue.
+	NodeValue string `json:"nodeValue"`
+	// Child count for `Container` nodes.
+	ChildNodeCount *int64 `json:"childNodeCount,omitempty"`
+	// Child nodes of this node when requested with children.
+	Children []Node `json:"children,omitempty"`
+	// Attributes of the `Element` node in the form of flat array `[name1, value1, name2, value2]`.
+	Attributes []string `json:"attributes,omitempty"`
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL string `json:"documentURL,omitempty"`
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL string `json:"baseURL,omitempty"`
+	// `DocumentType`'s publicId.
+	PublicID string `json:"publicId,omitempty"`
+	// `DocumentType`'s systemId.
+	SystemID string `json:"systemId,omitempty"`
+	// `DocumentType`'s internalSubset.
+	InternalSubset string `json:"internalSubset,omitempty"`
+	// `Document`'s XML version in case of XML documents.
+	XMLVersion string `json:"xmlVersion,omitempty"`
+	// `Attr`'s name.
+	Name string `json:"name,omitempty"`
+	// `Attr`'s value.
+	Value string `json:"value,omitempty"`
+	// Pseudo element type for this node.
+	PseudoType PseudoType `json:"pseudoType,omitempty"`
+	// Pseudo element identifier for this node. Only present if there is a
+	// valid pseudoType.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+	// Shadow root type.
+	ShadowRootType ShadowRootType `json:"shadowRootType,omitempty"`
+	// Frame ID for frame owner elements.
+	FrameID cdp.PageFrameID `json:"frameId,omitempty"`
+	// Content document for frame owner elements.
+	ContentDocument *Node `json:"contentDocument,omitempty"`
+	// Shadow root list for given element host.
+	ShadowRoots []Node `json:"shadowRoots,omitempty"`
+	// Content document fragment for template elements.
+	TemplateContent *Node `json:"templateContent,omitempty"`
+	// Pseudo elements associated with this node.
+	PseudoElements []Node `json:"pseudoElements,omitempty"`
+	// Deprecated, as the HTML Imports API has been removed (crbug.com/937746).
+	// This property used to return the imported document for the HTMLImport links.
+	// The property is always undefined now.
+	//
+	// Deprecated: deprecated in the protocol.
+	ImportedDocument *Node `json:"importedDocument,omitempty"`
+	// Distributed nodes for given insertion point.
+	DistributedNodes []BackendNode `json:"distributedNodes,omitempty"`
+	// Whether the node is SVG.
+	IsSVG             *bool             `json:"isSVG,omitempty"`
+	CompatibilityMode CompatibilityMode `json:"compatibilityMode,omitempty"`
+	AssignedSlot      *BackendNode      `json:"assignedSlot,omitempty"`
+	IsScrollable      *bool             `json:"isScrollable,omitempty"`
+}
+
+// DetachedElementInfo a structure to hold the top-level node of a detached tree and an array of its retained descendants.
+type DetachedElementInfo struct {
+	TreeNode        Node     `json:"treeNode"`
+	RetainedNodeIds []NodeID `json:"retainedNodeIds"`
+}
+
+// RGBA a structure holding an RGBA color.
+type RGBA = cdp.DOMRGBA
+
+// Quad an array of quad vertices, x immediately followed by y for each point, points clock-wise.
+type Quad []float64
+
+// BoxModel box model.
+type BoxModel struct {
+	// Content box.
+	Content Quad `json:"content"`
+	// Padding box.
+	Padding Quad `json:"padding"`
+	// Border box.
+	Border Quad `json:"border"`
+	// Margin box.
+	Margin Quad `json:"margin"`
+	// Node width.
+	Width int64 `json:"width"`
+	// Node height.
+	Height int64 `json:"height"`
+	// Shape outside coordinates.
+	ShapeOutside *ShapeOutsideInfo `json:"shapeOutside,omitempty"`
+}
+
+// ShapeOutsideInfo CSS Shape Outside details.
+type ShapeOutsideInfo struct {
+	// Shape bounds.
+	Bounds Quad `json:"bounds"`
+	// Shape coordinate details.
+	Shape []json.RawMessage `json:"shape"`
+	// Margin shape bounds.
+	MarginShape []json.RawMessage `json:"marginShape"`
+}
+
+// Rect rectangle.
+type Rect = cdp.DOMRect
+
+// CSSComputedStyleProperty is the DOM.CSSComputedStyleProperty type.
+type CSSComputedStyleProperty struct {
+	// Computed style property name.
+	Name string `json:"name"`
+	// Computed style property value.
+	Value string `json:"value"`
+}
+
+// CollectClassNamesFromSubtreeParams are the parameters of CollectClassNamesFromSubtree.
+type CollectClassNamesFromSubtreeParams struct {
+	// Id of the node to collect class names.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// CollectClassNamesFromSubtreeResult is the result of CollectClassNamesFromSubtree.
+type CollectClassNamesFromSubtreeResult struct {
+	// Class name list.
+	ClassNames []string `json:"classNames"`
+}
+
+// CollectClassNamesFromSubtree collects class names for the node with given id and all of it's child nodes.
+//
+// Experimental.
+func CollectClassNamesFromSubtree(ctx context.Context, session bidi.Transport, params CollectClassNamesFromSubtreeParams) (*CollectClassNamesFromSubtreeResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CollectClassNamesFromSubtreeResult]("DOM.collectClassNamesFromSubtree", params))
+}
+
+// CopyToParams are the parameters of CopyTo.
+type CopyToParams struct {
+	// Id of the node to copy.
+	NodeID NodeID `json:"nodeId"`
+	// Id of the element to drop the copy into.
+	TargetNodeID NodeID `json:"targetNodeId"`
+	// Drop the copy before this node (if absent, the copy becomes the last child of
+	// `targetNodeId`).
+	InsertBeforeNodeID *NodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// CopyToResult is the result of CopyTo.
+type CopyToResult struct {
+	// Id of the node clone.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// CopyTo creates a deep copy of the specified node and places it into the target container before the
+// given anchor.
+//
+// Experimental.
+func CopyTo(ctx context.Context, session bidi.Transport, params CopyToParams) (*CopyToResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CopyToResult]("DOM.copyTo", params))
+}
+
+// DescribeNodeParams are the parameters of DescribeNode.
+type DescribeNodeParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// DescribeNodeResult is the result of DescribeNode.
+type DescribeNodeResult struct {
+	// Node description.
+	Node Node `json:"node"`
+}
+
+// DescribeNode describes node given its id, does not require domain to be enabled. Does not start tracking any
+// objects, can be used for automation.
+func DescribeNode(ctx context.Context, session bidi.Transport, params DescribeNodeParams) (*DescribeNodeResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[DescribeNodeResult]("DOM.describeNode", params))
+}
+
+// ScrollIntoViewIfNeededParams are the parameters of ScrollIntoViewIfNeeded.
+type ScrollIntoViewIfNeededParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// The rect to be scrolled into view, relative to the node's border box, in CSS pixels.
+	// When omitted, center of the node will be used, similar to Element.scrollIntoView.
+	Rect *Rect `json:"rect,omitempty"`
+}
+
+// ScrollIntoViewIfNeeded scrolls the specified rect of the given node into view if not already visible.
+// Note: exactly one between nodeId, backendNodeId and objectId should be passed
+// to identify the node.
+func ScrollIntoViewIfNeeded(ctx context.Context, session bidi.Transport, params ScrollIntoViewIfNeededParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.scrollIntoViewIfNeeded", params))
+
+	return err
+}
+
+// Disable disables DOM agent for the given page.
+func Disable(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.disable", nil))
+
+	return err
+}
+
+// DiscardSearchResultsParams are the parameters of DiscardSearchResults.
+type DiscardSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+}
+
+// DiscardSearchResults discards search results from the session with the given id. `getSearchResults` should no longer
+// be called for that search.
+//
+// Experimental.
+func DiscardSearchResults(ctx context.Context, session bidi.Transport, params DiscardSearchResultsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.discardSearchResults", params))
+
+	return err
+}
+
+// EnableParams are the parameters of Enable.
+type EnableParams struct {
+	// Whether to include whitespaces in the children array of returned Nodes.
+	//
+	// Values: none, all.
+	IncludeWhitespace string `json:"includeWhitespace,omitempty"`
+}
+
+// Enable enables DOM agent for the given page.
+func Enable(ctx context.Context, session bidi.Transport, params EnableParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.enable", params))
+
+	return err
+}
+
+// FocusParams are the parameters of Focus.
+type FocusParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// Focus focuses the given element.
+func Focus(ctx context.Context, session bidi.Transport, params FocusParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.focus", params))
+
+	return err
+}
+
+// GetAttributesParams are the parameters of GetAttributes.
+type GetAttributesParams struct {
+	// Id of the node to retrieve attributes for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetAttributesResult is the result of GetAttributes.
+type GetAttributesResult struct {
+	// An interleaved array of node attribute names and values.
+	Attributes []string `json:"attributes"`
+}
+
+// GetAttributes returns attributes for the specified node.
+func GetAttributes(ctx context.Context, session bidi.Transport, params GetAttributesParams) (*GetAttributesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetAttributesResult]("DOM.getAttributes", params))
+}
+
+// GetBoxModelParams are the parameters of GetBoxModel.
+type GetBoxModelParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// GetBoxModelResult is the result of GetBoxModel.
+type GetBoxModelResult struct {
+	// Box model for the node.
+	Model BoxModel `json:"model"`
+}
+
+// GetBoxModel returns boxes for the given node.
+func GetBoxModel(ctx context.Context, session bidi.Transport, params GetBoxModelParams) (*GetBoxModelResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetBoxModelResult]("DOM.getBoxModel", params))
+}
+
+// GetContentQuadsParams are the parameters of GetContentQuads.
+type GetContentQuadsParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// GetContentQuadsResult is the result of GetContentQuads.
+type GetContentQuadsResult struct {
+	// Quads that describe node layout relative to viewport.
+	Quads []Quad `json:"quads"`
+}
+
+// GetContentQuads returns quads that describe node position on the page. This method
+// might return multiple quads for inline nodes.
+//
+// Experimental.
+func GetContentQuads(ctx context.Context, session bidi.Transport, params GetContentQuadsParams) (*GetContentQuadsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetContentQuadsResult]("DOM.getContentQuads", params))
+}
+
+// GetDocumentParams are the parameters of GetDocument.
+type GetDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// GetDocumentResult is the result of GetDocument.
+type GetDocumentResult struct {
+	// Resulting node.
+	Root Node `json:"root"`
+}
+
+// GetDocument returns the root DOM node (and optionally the subtree) to the caller.
+// Implicitly enables the DOM domain events for the current target.
+func GetDocument(ctx context.Context, session bidi.Transport, params GetDocumentParams) (*GetDocumentResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetDocumentResult]("DOM.getDocument", params))
+}
+
+// GetFlattenedDocumentParams are the parameters of GetFlattenedDocument.
+type GetFlattenedDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// GetFlattenedDocumentResult is the result of GetFlattenedDocument.
+type GetFlattenedDocumentResult struct {
+	// Resulting node.
+	Nodes []Node `json:"nodes"`
+}
+
+// GetFlattenedDocument returns the root DOM node (and optionally the subtree) to the caller.
+// Deprecated, as it is not designed to work well with the rest of the DOM agent.
+// Use DOMSnapshot.captureSnapshot instead.
+//
+// Deprecated: deprecated in the protocol.
+func GetFlattenedDocument(ctx context.Context, session bidi.Transport, params GetFlattenedDocumentParams) (*GetFlattenedDocumentResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetFlattenedDocumentResult]("DOM.getFlattenedDocument", params))
+}
+
+// GetNodesForSubtreeByStyleParams are the parameters of GetNodesForSubtreeByStyle.
+type GetNodesForSubtreeByStyleParams struct {
+	// Node ID pointing to the root of a subtree.
+	NodeID NodeID `json:"nodeId"`
+	// The style to filter nodes by (includes nodes if any of properties matches).
+	ComputedStyles []CSSComputedStyleProperty `json:"computedStyles"`
+	// Whether or not iframes and shadow roots in the same target should be traversed when returning the
+	// results (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// GetNodesForSubtreeByStyleResult is the result of GetNodesForSubtreeByStyle.
+type GetNodesForSubtreeByStyleResult struct {
+	// Resulting nodes.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// GetNodesForSubtreeByStyle finds nodes with a given computed style in a subtree.
+//
+// Experimental.
+func GetNodesForSubtreeByStyle(ctx context.Context, session bidi.Transport, params GetNodesForSubtreeByStyleParams) (*GetNodesForSubtreeByStyleResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetNodesForSubtreeByStyleResult]("DOM.getNodesForSubtreeByStyle", params))
+}
+
+// GetNodeForLocationParams are the parameters of GetNodeForLocation.
+type GetNodeForLocationParams struct {
+	// X coordinate.
+	X int64 `json:"x"`
+	// Y coordinate.
+	Y int64 `json:"y"`
+	// False to skip to the nearest non-UA shadow root ancestor (default: false).
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+	// Whether to ignore pointer-events: none on elements and hit test them.
+	IgnorePointerEventsNone *bool `json:"ignorePointerEventsNone,omitempty"`
+}
+
+// GetNodeForLocationResult is the result of GetNodeForLocation.
+type GetNodeForLocationResult struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// Frame this node belongs to.
+	FrameID cdp.PageFrameID `json:"frameId"`
+	// Id of the node at given coordinates, only when enabled and requested document.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+}
+
+// GetNodeForLocation returns node id at given location. Depending on whether DOM domain is enabled, nodeId is
+// either returned or not.
+func GetNodeForLocation(ctx context.Context, session bidi.Transport, params GetNodeForLocationParams) (*GetNodeForLocationResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetNodeForLocationResult]("DOM.getNodeForLocation", params))
+}
+
+// GetOuterHTMLParams are the parameters of GetOuterHTML.
+type GetOuterHTMLParams struct {
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Include all shadow roots. Equals to false if not specified.
+	IncludeShadowDOM *bool `json:"includeShadowDOM,omitempty"`
+}
+
+// GetOuterHTMLResult is the result of GetOuterHTML.
+type GetOuterHTMLResult struct {
+	// Outer HTML markup.
+	OuterHTML string `json:"outerHTML"`
+}
+
+// GetOuterHTML returns node's HTML markup.
+func GetOuterHTML(ctx context.Context, session bidi.Transport, params GetOuterHTMLParams) (*GetOuterHTMLResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetOuterHTMLResult]("DOM.getOuterHTML", params))
+}
+
+// GetRelayoutBoundaryParams are the parameters of GetRelayoutBoundary.
+type GetRelayoutBoundaryParams struct {
+	// Id of the node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetRelayoutBoundaryResult is the result of GetRelayoutBoundary.
+type GetRelayoutBoundaryResult struct {
+	// Relayout boundary node id for the given node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetRelayoutBoundary returns the id of the nearest ancestor that is a relayout boundary.
+//
+// Experimental.
+func GetRelayoutBoundary(ctx context.Context, session bidi.Transport, params GetRelayoutBoundaryParams) (*GetRelayoutBoundaryResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetRelayoutBoundaryResult]("DOM.getRelayoutBoundary", params))
+}
+
+// GetSearchResultsParams are the parameters of GetSearchResults.
+type GetSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+	// Start index of the search result to be returned.
+	FromIndex int64 `json:"fromIndex"`
+	// End index of the search result to be returned.
+	ToIndex int64 `json:"toIndex"`
+}
+
+// GetSearchResultsResult is the result of GetSearchResults.
+type GetSearchResultsResult struct {
+	// Ids of the search result nodes.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// GetSearchResults returns search results from given `fromIndex` to given `toIndex` from the search with the given
+// identifier.
+//
+// Experimental.
+func GetSearchResults(ctx context.Context, session bidi.Transport, params GetSearchResultsParams) (*GetSearchResultsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetSearchResultsResult]("DOM.getSearchResults", params))
+}
+
+// HideHighlight hides any highlight.
+func HideHighlight(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.hideHighlight", nil))
+
+	return err
+}
+
+// HighlightNode highlights DOM node.
+func HighlightNode(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.highlightNode", nil))
+
+	return err
+}
+
+// HighlightRect highlights given rectangle.
+func HighlightRect(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.highlightRect", nil))
+
+	return err
+}
+
+// MarkUndoableState marks last undoable state.
+//
+// Experimental.
+func MarkUndoableState(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.markUndoableState", nil))
+
+	return err
+}
+
+// MoveToParams are the parameters of MoveTo.
+type MoveToParams struct {
+	// Id of the node to move.
+	NodeID NodeID `json:"nodeId"`
+	// Id of the element to drop the moved node into.
+	TargetNodeID NodeID `json:"targetNodeId"`
+	// Drop node before this one (if absent, the moved node becomes the last child of
+	// `targetNodeId`).
+	InsertBeforeNodeID *NodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// MoveToResult is the result of MoveTo.
+type MoveToResult struct {
+	// New id of the moved node.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// MoveTo moves node into the new container, places it before the given anchor.
+func MoveTo(ctx context.Context, session bidi.Transport, params MoveToParams) (*MoveToResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[MoveToResult]("DOM.moveTo", params))
+}
+
+// PerformSearchParams are the parameters of PerformSearch.
+type PerformSearchParams struct {
+	// Plain text or query selector or XPath search query.
+	Query string `json:"query"`
+	// True to search in user agent shadow DOM.
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+}
+
+// PerformSearchResult is the result of PerformSearch.
+type PerformSearchResult struct {
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+	// Number of search results.
+	ResultCount int64 `json:"resultCount"`
+}
+
+// PerformSearch searches for a given string in the DOM tree. Use `getSearchResults` to access search results or
+// `cancelSearch` to end this search session.
+//
+// Experimental.
+func PerformSearch(ctx context.Context, session bidi.Transport, params PerformSearchParams) (*PerformSearchResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[PerformSearchResult]("DOM.performSearch", params))
+}
+
+// PushNodeByPathToFrontendParams are the parameters of PushNodeByPathToFrontend.
+type PushNodeByPathToFrontendParams struct {
+	// Path to node in the proprietary format.
+	Path string `json:"path"`
+}
+
+// PushNodeByPathToFrontendResult is the result of PushNodeByPathToFrontend.
+type PushNodeByPathToFrontendResult struct {
+	// Id of the node for given path.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// PushNodeByPathToFrontend requests that the node is sent to the caller given its path. // FIXME, use XPath.
+//
+// Experimental.
+func PushNodeByPathToFrontend(ctx context.Context, session bidi.Transport, params PushNodeByPathToFrontendParams) (*PushNodeByPathToFrontendResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[PushNodeByPathToFrontendResult]("DOM.pushNodeByPathToFrontend", params))
+}
+
+// PushNodesByBackendIdsToFrontendParams are the parameters of PushNodesByBackendIdsToFrontend.
+type PushNodesByBackendIdsToFrontendParams struct {
+	// The array of backend node ids.
+	BackendNodeIds []BackendNodeID `json:"backendNodeIds"`
+}
+
+// PushNodesByBackendIdsToFrontendResult is the result of PushNodesByBackendIdsToFrontend.
+type PushNodesByBackendIdsToFrontendResult struct {
+	// The array of ids of pushed nodes that correspond to the backend ids specified in
+	// backendNodeIds.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// PushNodesByBackendIdsToFrontend requests that a batch of nodes is sent to the caller given their backend node ids.
+//
+// Experimental.
+func PushNodesByBackendIdsToFrontend(ctx context.Context, session bidi.Transport, params PushNodesByBackendIdsToFrontendParams) (*PushNodesByBackendIdsToFrontendResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[PushNodesByBackendIdsToFrontendResult]("DOM.pushNodesByBackendIdsToFrontend", params))
+}
+
+// QuerySelectorParams are the parameters of QuerySelector.
+type QuerySelectorParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+// QuerySelectorResult is the result of QuerySelector.
+type QuerySelectorResult struct {
+	// Query selector result.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// QuerySelector executes `querySelector` on a given node.
+func QuerySelector(ctx context.Context, session bidi.Transport, params QuerySelectorParams) (*QuerySelectorResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[QuerySelectorResult]("DOM.querySelector", params))
+}
+
+// QuerySelectorAllParams are the parameters of QuerySelectorAll.
+type QuerySelectorAllParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+// QuerySelectorAllResult is the result of QuerySelectorAll.
+type QuerySelectorAllResult struct {
+	// Query selector result.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// QuerySelectorAll executes `querySelectorAll` on a given node.
+func QuerySelectorAll(ctx context.Context, session bidi.Transport, params QuerySelectorAllParams) (*QuerySelectorAllResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[QuerySelectorAllResult]("DOM.querySelectorAll", params))
+}
+
+// GetTopLayerElementsResult is the result of GetTopLayerElements.
+type GetTopLayerElementsResult struct {
+	// NodeIds of top layer elements.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// GetTopLayerElements returns NodeIds of current top layer elements.
+// Top layer is rendered closest to the user within a viewport, therefore its elements always
+// appear on top of all other content.
+//
+// Experimental.
+func GetTopLayerElements(ctx context.Context, session bidi.Transport) (*GetTopLayerElementsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetTopLayerElementsResult]("DOM.getTopLayerElements", nil))
+}
+
+// GetElementByRelationParams are the parameters of GetElementByRelation.
+type GetElementByRelationParams struct {
+	// Id of the node from which to query the relation.
+	NodeID NodeID `json:"nodeId"`
+	// Type of relation to get.
+	//
+	// Values: PopoverTarget, InterestTarget, CommandFor.
+	Relation string `json:"relation"`
+}
+
+// GetElementByRelationResult is the result of GetElementByRelation.
+type GetElementByRelationResult struct {
+	// NodeId of the element matching the queried relation.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetElementByRelation returns the NodeId of the matched element according to certain relations.
+//
+// Experimental.
+func GetElementByRelation(ctx context.Context, session bidi.Transport, params GetElementByRelationParams) (*GetElementByRelationResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetElementByRelationResult]("DOM.getElementByRelation", params))
+}
+
+// Redo re-does the last undone action.
+//
+// Experimental.
+func Redo(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.redo", nil))
+
+	return err
+}
+
+// RemoveAttributeParams are the parameters of RemoveAttribute.
+type RemoveAttributeParams struct {
+	// Id of the element to remove attribute from.
+	NodeID NodeID `json:"nodeId"`
+	// Name of the attribute to remove.
+	Name string `json:"name"`
+}
+
+// RemoveAttribute removes attribute with given name from an element with given id.
+func RemoveAttribute(ctx context.Context, session bidi.Transport, params RemoveAttributeParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.removeAttribute", params))
+
+	return err
+}
+
+// RemoveNodeParams are the parameters of RemoveNode.
+type RemoveNodeParams struct {
+	// Id of the node to remove.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// RemoveNode removes node with given id.
+func RemoveNode(ctx context.Context, session bidi.Transport, params RemoveNodeParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.removeNode", params))
+
+	return err
+}
+
+// RequestChildNodesParams are the parameters of RequestChildNodes.
+type RequestChildNodesParams struct {
+	// Id of the node to get children for.
+	NodeID NodeID `json:"nodeId"`
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	// entire subtree or provide an integer larger than 0.
+	Depth *int64 `json:"depth,omitempty"`
+	// Whether or not iframes and shadow roots should be traversed when returning the sub-tree
+	// (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// RequestChildNodes requests that children of the node with given id are returned to the caller in form of
+// `setChildNodes` events where not only immediate children are retrieved, but all children down to
+// the specified depth.
+func RequestChildNodes(ctx context.Context, session bidi.Transport, params RequestChildNodesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.requestChildNodes", params))
+
+	return err
+}
+
+// RequestNodeParams are the parameters of RequestNode.
+type RequestNodeParams struct {
+	// JavaScript object id to convert into node.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// RequestNodeResult is the result of RequestNode.
+type RequestNodeResult struct {
+	// Node id for given object.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// RequestNode requests that the node is sent to the caller given the JavaScript node object reference. All
+// nodes that form the path from the node to the root are also sent to the client as a series of
+// `setChildNodes` notifications.
+func RequestNode(ctx context.Context, session bidi.Transport, params RequestNodeParams) (*RequestNodeResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[RequestNodeResult]("DOM.requestNode", params))
+}
+
+// ResolveNodeParams are the parameters of ResolveNode.
+type ResolveNodeParams struct {
+	// Id of the node to resolve.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Backend identifier of the node to resolve.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Execution context in which to resolve the node.
+	ExecutionContextID *cdp.RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ResolveNodeResult is the result of ResolveNode.
+type ResolveNodeResult struct {
+	// JavaScript object wrapper for given node.
+	Object cdp.RuntimeRemoteObject `json:"object"`
+}
+
+// ResolveNode resolves the JavaScript node object for a given NodeId or BackendNodeId.
+func ResolveNode(ctx context.Context, session bidi.Transport, params ResolveNodeParams) (*ResolveNodeResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[ResolveNodeResult]("DOM.resolveNode", params))
+}
+
+// SetAttributeValueParams are the parameters of SetAttributeValue.
+type SetAttributeValueParams struct {
+	// Id of the element to set attribute for.
+	NodeID NodeID `json:"nodeId"`
+	// Attribute name.
+	Name string `json:"name"`
+	// Attribute value.
+	Value string `json:"value"`
+}
+
+// SetAttributeValue sets attribute for an element with given id.
+func SetAttributeValue(ctx context.Context, session bidi.Transport, params SetAttributeValueParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.setAttributeValue", params))
+
+	return err
+}
+
+// SetAttributesAsTextParams are the parameters of SetAttributesAsText.
+type SetAttributesAsTextParams struct {
+	// Id of the element to set attributes for.
+	NodeID NodeID `json:"nodeId"`
+	// Text with a number of attributes. Will parse this text using HTML parser.
+	Text string `json:"text"`
+	// Attribute name to replace with new attributes derived from text in case text parsed
+	// successfully.
+	Name string `json:"name,omitempty"`
+}
+
+// SetAttributesAsText sets attributes on element with given id. This method is useful when user edits some existing
+// attribute value and types in several attribute name/value pairs.
+func SetAttributesAsText(ctx context.Context, session bidi.Transport, params SetAttributesAsTextParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.setAttributesAsText", params))
+
+	return err
+}
+
+// SetFileInputFilesParams are the parameters of SetFileInputFiles.
+type SetFileInputFilesParams struct {
+	// Array of file paths to set.
+	Files []string `json:"files"`
+	// Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+	// Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// SetFileInputFiles sets files for the given file input element.
+func SetFileInputFiles(ctx context.Context, session bidi.Transport, params SetFileInputFilesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.setFileInputFiles", params))
+
+	return err
+}
+
+// SetNodeStackTracesEnabledParams are the parameters of SetNodeStackTracesEnabled.
+type SetNodeStackTracesEnabledParams struct {
+	// Enable or disable.
+	Enable bool `json:"enable"`
+}
+
+// SetNodeStackTracesEnabled sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.
+//
+// Experimental.
+func SetNodeStackTracesEnabled(ctx context.Context, session bidi.Transport, params SetNodeStackTracesEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.setNodeStackTracesEnabled", params))
+
+	return err
+}
+
+// GetNodeStackTracesParams are the parameters of GetNodeStackTraces.
+type GetNodeStackTracesParams struct {
+	// Id of the node to get stack traces for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetNodeStackTracesResult is the result of GetNodeStackTraces.
+type GetNodeStackTracesResult struct {
+	// Creation stack trace, if available.
+	Creation *cdp.RuntimeStackTrace `json:"creation,omitempty"`
+}
+
+// GetNodeStackTraces gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.
+//
+// Experimental.
+func GetNodeStackTraces(ctx context.Context, session bidi.Transport, params GetNodeStackTracesParams) (*GetNodeStackTracesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetNodeStackTracesResult]("DOM.getNodeStackTraces", params))
+}
+
+// GetFileInfoParams are the parameters of GetFileInfo.
+type GetFileInfoParams struct {
+	// JavaScript object id of the node wrapper.
+	ObjectID cdp.RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// GetFileInfoResult is the result of GetFileInfo.
+type GetFileInfoResult struct {
+	Path string `json:"path"`
+}
+
+// GetFileInfo returns file information for the given
+// File wrapper.
+//
+// Experimental.
+func GetFileInfo(ctx context.Context, session bidi.Transport, params GetFileInfoParams) (*GetFileInfoResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetFileInfoResult]("DOM.getFileInfo", params))
+}
+
+// GetDetachedDOMNodesResult is the result of GetDetachedDOMNodes.
+type GetDetachedDOMNodesResult struct {
+	// The list of detached nodes.
+	DetachedNodes []DetachedElementInfo `json:"detachedNodes"`
+}
+
+// GetDetachedDOMNodes returns list of detached nodes.
+//
+// Experimental.
+func GetDetachedDOMNodes(ctx context.Context, session bidi.Transport) (*GetDetachedDOMNodesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetDetachedDOMNodesResult]("DOM.getDetachedDomNodes", nil))
+}
+
+// SetInspectedNodeParams are the parameters of SetInspectedNode.
+type SetInspectedNodeParams struct {
+	// DOM node id to be accessible by means of $x command line API.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// SetInspectedNode enables console to refer to the node with given id via $x (see Command Line API for more details
+// $x functions).
+//
+// Experimental.
+func SetInspectedNode(ctx context.Context, session bidi.Transport, params SetInspectedNodeParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.setInspectedNode", params))
+
+	return err
+}
+
+// SetNodeNameParams are the parameters of SetNodeName.
+type SetNodeNameParams struct {
+	// Id of the node to set name for.
+	NodeID NodeID `json:"nodeId"`
+	// New node's name.
+	Name string `json:"name"`
+}
+
+// SetNodeNameResult is the result of SetNodeName.
+type SetNodeNameResult struct {
+	// New node's id.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// SetNodeName sets node name for a node with given id.
+func SetNodeName(ctx context.Context, session bidi.Transport, params SetNodeNameParams) (*SetNodeNameResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[SetNodeNameResult]("DOM.setNodeName", params))
+}
+
+// SetNodeValueParams are the parameters of SetNodeValue.
+type SetNodeValueParams struct {
+	// Id of the node to set value for.
+	NodeID NodeID `json:"nodeId"`
+	// New node's value.
+	Value string `json:"value"`
+}
+
+// SetNodeValue sets node value for a node with given id.
+func SetNodeValue(ctx context.Context, session bidi.Transport, params SetNodeValueParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.setNodeValue", params))
+
+	return err
+}
+
+// SetOuterHTMLParams are the parameters of SetOuterHTML.
+type SetOuterHTMLParams struct {
+	// Id of the node to set markup for.
+	NodeID NodeID `json:"nodeId"`
+	// Outer HTML markup to set.
+	OuterHTML string `json:"outerHTML"`
+}
+
+// SetOuterHTML sets node HTML markup, returns new node id.
+func SetOuterHTML(ctx context.Context, session bidi.Transport, params SetOuterHTMLParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.setOuterHTML", params))
+
+	return err
+}
+
+// Undo undoes the last performed action.
+//
+// Experimental.
+func Undo(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("DOM.undo", nil))
+
+	return err
+}
+
+// GetFrameOwnerParams are the parameters of GetFrameOwner.
+type GetFrameOwnerParams struct {
+	FrameID cdp.PageFrameID `json:"frameId"`
+}
+
+// GetFrameOwnerResult is the result of GetFrameOwner.
+type GetFrameOwnerResult struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+	// Id of the node at given coordinates, only when enabled and requested document.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+}
+
+// GetFrameOwner returns iframe node that owns iframe with the given domain.
+//
+// Experimental.
+func GetFrameOwner(ctx context.Context, session bidi.Transport, params GetFrameOwnerParams) (*GetFrameOwnerResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetFrameOwnerResult]("DOM.getFrameOwner", params))
+}
+
+// GetContainerForNodeParams are the parameters of GetContainerForNode.
+type GetContainerForNodeParams struct {
+	NodeID             NodeID       `json:"nodeId"`
+	ContainerName      string       `json:"containerName,omitempty"`
+	PhysicalAxes       PhysicalAxes `json:"physicalAxes,omitempty"`
+	LogicalAxes        LogicalAxes  `json:"logicalAxes,omitempty"`
+	QueriesScrollState *bool        `json:"queriesScrollState,omitempty"`
+	QueriesAnchored    *bool        `json:"queriesAnchored,omitempty"`
+}
+
+// GetContainerForNodeResult is the result of GetContainerForNode.
+type GetContainerForNodeResult struct {
+	// The container node for the given node, or null if not found.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+}
+
+// GetContainerForNode returns the query container of the given node based on container query
+// conditions: containerName, physical and logical axes, and whether it queries
+// scroll-state or anchored elements. If no axes are provided and
+// queriesScrollState is false, the style container is returned, which is the
+// direct parent or the closest element with a matching container-name.
+//
+// Experimental.
+func GetContainerForNode(ctx context.Context, session bidi.Transport, params GetContainerForNodeParams) (*GetContainerForNodeResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetContainerForNodeResult]("DOM.getContainerForNode", params))
+}
+
+// GetQueryingDescendantsForContainerParams are the parameters of GetQueryingDescendantsForContainer.
+type GetQueryingDescendantsForContainerParams struct {
+	// Id of the container node to find querying descendants from.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetQueryingDescendantsForContainerResult is the result of GetQueryingDescendantsForContainer.
+type GetQueryingDescendantsForContainerResult struct {
+	// Descendant nodes with container queries against the given container.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// GetQueryingDescendantsForContainer returns the descendants of a container query container that have
+// container queries against this container.
+//
+// Experimental.
+func GetQueryingDescendantsForContainer(ctx context.Context, session bidi.Transport, params GetQueryingDescendantsForContainerParams) (*GetQueryingDescendantsForContainerResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetQueryingDescendantsForContainerResult]("DOM.getQueryingDescendantsForContainer", params))
+}
+
+// GetAnchorElementParams are the parameters of GetAnchorElement.
+type GetAnchorElementParams struct {
+	// Id of the positioned element from which to find the anchor.
+	NodeID NodeID `json:"nodeId"`
+	// An optional anchor specifier, as defined in
+	// https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier.
+	// If not provided, it will return the implicit anchor element for
+	// the given positioned element.
+	AnchorSpecifier string `json:"anchorSpecifier,omitempty"`
+}
+
+// GetAnchorElementResult is the result of GetAnchorElement.
+type GetAnchorElementResult struct {
+	// The anchor element of the given anchor query.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// GetAnchorElement returns the target anchor element of the given anchor query according to
+// https://www.w3.org/TR/css-anchor-position-1/#target.
+//
+// Experimental.
+func GetAnchorElement(ctx context.Context, session bidi.Transport, params GetAnchorElementParams) (*GetAnchorElementResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetAnchorElementResult]("DOM.getAnchorElement", params))
+}
+
+// ForceShowPopoverParams are the parameters of ForceShowPopover.
+type ForceShowPopoverParams struct {
+	// Id of the popover HTMLElement.
+	NodeID NodeID `json:"nodeId"`
+	// If true, opens the popover and keeps it open. If false, closes the
+	// popover if it was previously force-opened.
+	Enable bool `json:"enable"`
+}
+
+// ForceShowPopoverResult is the result of ForceShowPopover.
+type ForceShowPopoverResult struct {
+	// List of popovers that were closed in order to respect popover stacking order.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// ForceShowPopover when enabling, this API force-opens the popover identified by nodeId
+// and keeps it open until disabled.
+//
+// Experimental.
+func ForceShowPopover(ctx context.Context, session bidi.Transport, params ForceShowPopoverParams) (*ForceShowPopoverResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[ForceShowPopoverResult]("DOM.forceShowPopover", params))
+}
+
+// AttributeModifiedEvent is sent on DOM.attributeModified.
+//
+// Fired when `Element`'s attribute is modified.
+type AttributeModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// Attribute name.
+	Name string `json:"name"`
+	// Attribute value.
+	Value string `json:"value"`
+}
+
+// EventAttributeModified is the method of AttributeModifiedEvent.
+const EventAttributeModified = "DOM.attributeModified"
+
+// OnAttributeModified calls handler for every AttributeModifiedEvent.
+//
+// The returned function removes the handler.
+func OnAttributeModified(source cdp.EventSource, handler func(*AttributeModifiedEvent)) func() {
+	return cdp.On(source, EventAttributeModified, handler)
+}
+
+// AttributeRemovedEvent is sent on DOM.attributeRemoved.
+//
+// Fired when `Element`'s attribute is removed.
+type AttributeRemovedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// A ttribute name.
+	Name string `json:"name"`
+}
+
+// EventAttributeRemoved is the method of AttributeRemovedEvent.
+const EventAttributeRemoved = "DOM.attributeRemoved"
+
+// OnAttributeRemoved calls handler for every AttributeRemovedEvent.
+//
+// The returned function removes the handler.
+func OnAttributeRemoved(source cdp.EventSource, handler func(*AttributeRemovedEvent)) func() {
+	return cdp.On(source, EventAttributeRemoved, handler)
+}
+
+// CharacterDataModifiedEvent is sent on DOM.characterDataModified.
+//
+// Mirrors `DOMCharacterDataModified` event.
+type CharacterDataModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// New text value.
+	CharacterData string `json:"characterData"`
+}
+
+// EventCharacterDataModified is the method of CharacterDataModifiedEvent.
+const EventCharacterDataModified = "DOM.characterDataModified"
+
+// OnCharacterDataModified calls handler for every CharacterDataModifiedEvent.
+//
+// The returned function removes the handler.
+func OnCharacterDataModified(source cdp.EventSource, handler func(*CharacterDataModifiedEvent)) func() {
+	return cdp.On(source, EventCharacterDataModified, handler)
+}
+
+// ChildNodeCountUpdatedEvent is sent on DOM.childNodeCountUpdated.
+//
+// Fired when `Container`'s child node count has changed.
+type ChildNodeCountUpdatedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+	// New node count.
+	ChildNodeCount int64 `json:"childNodeCount"`
+}
+
+// EventChildNodeCountUpdated is the method of ChildNodeCountUpdatedEvent.
+const EventChildNodeCountUpdated = "DOM.childNodeCountUpdated"
+
+// OnChildNodeCountUpdated calls handler for every ChildNodeCountUpdatedEvent.
+//
+// The returned function removes the handler.
+func OnChildNodeCountUpdated(source cdp.EventSource, handler func(*ChildNodeCountUpdatedEvent)) func() {
+	return cdp.On(source, EventChildNodeCountUpdated, handler)
+}
+
+// ChildNodeInsertedEvent is sent on DOM.childNodeInserted.
+//
+// Mirrors `DOMNodeInserted` event.
+type ChildNodeInsertedEvent struct {
+	// Id of the node that has changed.
+	ParentNodeID NodeID `json:"parentNodeId"`
+	// Id of the previous sibling.
+	PreviousNodeID NodeID `json:"previousNodeId"`
+	// Inserted node data.
+	Node Node `json:"node"`
+}
+
+// EventChildNodeInserted is the method of ChildNodeInsertedEvent.
+const EventChildNodeInserted = "DOM.childNodeInserted"
+
+// OnChildNodeInserted calls handler for every ChildNodeInsertedEvent.
+//
+// The returned function removes the handler.
+func OnChildNodeInserted(source cdp.EventSource, handler func(*ChildNodeInsertedEvent)) func() {
+	return cdp.On(source, EventChildNodeInserted, handler)
+}
+
+// ChildNodeRemovedEvent is sent on DOM.childNodeRemoved.
+//
+// Mirrors `DOMNodeRemoved` event.
+type ChildNodeRemovedEvent struct {
+	// Parent id.
+	ParentNodeID NodeID `json:"parentNodeId"`
+	// Id of the node that has been removed.
+	NodeID NodeID `json:"nodeId"`
+}
+
+// EventChildNodeRemoved is the method of ChildNodeRemovedEvent.
+const EventChildNodeRemoved = "DOM.childNodeRemoved"
+
+// OnChildNodeRemoved calls handler for every ChildNodeRemovedEvent.
+//
+// The returned function removes the handler.
+func OnChildNodeRemoved(source cdp.EventSource, handler func(*ChildNodeRemovedEvent)) func() {
+	return cdp.On(source, EventChildNodeRemoved, handler)
+}
+
+// DistributedNodesUpdatedEvent is sent on DOM.distributedNodesUpdated.
+//
+// Called when distribution is changed.
+//
+// Experimental.
+type DistributedNodesUpdatedEvent struct {
+	// Insertion point where distributed nodes were updated.
+	InsertionPointID NodeID `json:"insertionPointId"`
+	// Distributed nodes for given insertion point.
+	DistributedNodes []BackendNode `json:"distributedNodes"`
+}
+
+// EventDistributedNodesUpdated is the method of DistributedNodesUpdatedEvent.
+const EventDistributedNodesUpdated = "DOM.distributedNodesUpdated"
+
+// OnDistributedNodesUpdated calls handler for every DistributedNodesUpdatedEvent.
+//
+// The returned function removes the handler.
+func OnDistributedNodesUpdated(source cdp.EventSource, handler func(*DistributedNodesUpdatedEvent)) func() {
+	return cdp.On(source, EventDistributedNodesUpdated, handler)
+}
+
+// DocumentUpdatedEvent is sent on DOM.documentUpdated.
+//
+// Fired when `Document` has been totally updated. Node ids are no longer valid.
+type DocumentUpdatedEvent struct {
+}
+
+// EventDocumentUpdated is the method of DocumentUpdatedEvent.
+const EventDocumentUpdated = "DOM.documentUpdated"
+
+// OnDocumentUpdated calls handler for every DocumentUpdatedEvent.
+//
+// The returned function removes the handler.
+func OnDocumentUpdated(source cdp.EventSource, handler func(*DocumentUpdatedEvent)) func() {
+	return cdp.On(source, EventDocumentUpdated, handler)
+}
+
+// InlineStyleInvalidatedEvent is sent on DOM.inlineStyleInvalidated.
+//
+// Fired when `Element`'s inline style is modified via a CSS property modification.
+//
+// Experimental.
+type InlineStyleInvalidatedEvent struct {
+	// Ids of the nodes for which the inline styles have been invalidated.
+	NodeIds []NodeID `json:"nodeIds"`
+}
+
+// EventInlineStyleInvalidated is the method of InlineStyleInvalidatedEvent.
+const EventInlineStyleInvalidated = "DOM.inlineStyleInvalidated"
+
+// OnInlineStyleInvalidated calls handler for every InlineStyleInvalidatedEvent.
+//
+// The returned function removes the handler.
+func OnInlineStyleInvalidated(source cdp.EventSource, handler func(*InlineStyleInvalidatedEvent)) func() {
+	return cdp.On(source, EventInlineStyleInvalidated, handler)
+}
+
+// PseudoElementAddedEvent is sent on DOM.pseudoElementAdded.
+//
+// Called when a pseudo element is added to an element.
+//
+// Experimental.
+type PseudoElementAddedEvent struct {
+	// Pseudo element's parent element id.
+	ParentID NodeID `json:"parentId"`
+	// The added pseudo element.
+	PseudoElement Node `json:"pseudoElement"`
+}
+
+// EventPseudoElementAdded is the method of PseudoElementAddedEvent.
+const EventPseudoElementAdded = "DOM.pseudoElementAdded"
+
+// OnPseudoElementAdded calls handler for every PseudoElementAddedEvent.
+//
+// The returned function removes the handler.
+func OnPseudoElementAdded(source cdp.EventSource, handler func(*PseudoElementAddedEvent)) func() {
+	return cdp.On(source, EventPseudoElementAdded, handler)
+}
+
+// TopLayerElementsUpdatedEvent is sent on DOM.topLayerElementsUpdated.
+//
+// Called when top layer elements are changed.
+//
+// Experimental.
+type TopLayerElementsUpdatedEvent struct {
+}
+
+// EventTopLayerElementsUpdated is the method of TopLayerElementsUpdatedEvent.
+const EventTopLayerElementsUpdated = "DOM.topLayerElementsUpdated"
+
+// OnTopLayerElementsUpdated calls handler for every TopLayerElementsUpdatedEvent.
+//
+// The returned function removes the handler.
+func OnTopLayerElementsUpdated(source cdp.EventSource, handler func(*TopLayerElementsUpdatedEvent)) func() {
+	return cdp.On(source, EventTopLayerElementsUpdated, handler)
+}
+
+// ScrollableFlagUpdatedEvent is sent on DOM.scrollableFlagUpdated.
+//
+// Fired when a node's scrollability state changes.
+//
+// Experimental.
+type ScrollableFlagUpdatedEvent struct {
+	// The id of the node.
+	NodeID NodeID `json:"nodeId"`
+	// If the node is scrollable.
+	IsScrollable bool `json:"isScrollable"`
+}
+
+// EventScrollableFlagUpdated is the method of ScrollableFlagUpdatedEvent.
+const EventScrollableFlagUpdated = "DOM.scrollableFlagUpdated"
+
+// OnScrollableFlagUpdated calls handler for every ScrollableFlagUpdatedEvent.
+//
+// The returned function removes the handler.
+func OnScrollableFlagUpdated(source cdp.EventSource, handler func(*ScrollableFlagUpdatedEvent)) func() {
+	return cdp.On(source, EventScrollableFlagUpdated, handler)
+}
+
+// PseudoElementRemovedEvent is sent on DOM.pseudoElementRemoved.
+//
+// Called when a pseudo element is removed from an element.
+//
+// Experimental.
+type PseudoElementRemovedEvent struct {
+	// Pseudo element's parent element id.
+	ParentID NodeID `json:"parentId"`
+	// The removed pseudo element id.
+	PseudoElementID NodeID `json:"pseudoElementId"`
+}
+
+// EventPseudoElementRemoved is the method of PseudoElementRemovedEvent.
+const EventPseudoElementRemoved = "DOM.pseudoElementRemoved"
+
+// OnPseudoElementRemoved calls handler for every PseudoElementRemovedEvent.
+//
+// The returned function removes the handler.
+func OnPseudoElementRemoved(source cdp.EventSource, handler func(*PseudoElementRemovedEvent)) func() {
+	return cdp.On(source, EventPseudoElementRemoved, handler)
+}
+
+// SetChildNodesEvent is sent on DOM.setChildNodes.
+//
+// Fired when backend wants to provide client with the missing DOM structure. This happens upon
+// most of the calls requesting node ids.
+type SetChildNodesEvent struct {
+	// Parent node id to populate with children.
+	ParentID NodeID `json:"parentId"`
+	// Child nodes array.
+	Nodes []Node `json:"nodes"`
+}
+
+// EventSetChildNodes is the method of SetChildNodesEvent.
+const EventSetChildNodes = "DOM.setChildNodes"
+
+// OnSetChildNodes calls handler for every SetChildNodesEvent.
+//
+// The returned function removes the handler.
+func OnSetChildNodes(source cdp.EventSource, handler func(*SetChildNodesEvent)) func() {
+	return cdp.On(source, EventSetChildNodes, handler)
+}
+
+// ShadowRootPoppedEvent is sent on DOM.shadowRootPopped.
+//
+// Called when shadow root is popped from the element.
+//
+// Experimental.
+type ShadowRootPoppedEvent struct {
+	// Host element id.
+	HostID NodeID `json:"hostId"`
+	// Shadow root id.
+	RootID NodeID `json:"rootId"`
+}
+
+// EventShadowRootPopped is the method of ShadowRootPoppedEvent.
+const EventShadowRootPopped = "DOM.shadowRootPopped"
+
+// OnShadowRootPopped calls handler for every ShadowRootPoppedEvent.
+//
+// The returned function removes the handler.
+func OnShadowRootPopped(source cdp.EventSource, handler func(*ShadowRootPoppedEvent)) func() {
+	return cdp.On(source, EventShadowRootPopped, handler)
+}
+
+// ShadowRootPushedEvent is sent on DOM.shadowRootPushed.
+//
+// Called when shadow root is pushed into the element.
+//
+// Experimental.
+type ShadowRootPushedEvent struct {
+	// Host element id.
+	HostID NodeID `json:"hostId"`
+	// Shadow root.
+	Root Node `json:"root"`
+}
+
+// EventShadowRootPushed is the method of ShadowRootPushedEvent.
+const EventShadowRootPushed = "DOM.shadowRootPushed"
+
+// OnShadowRootPushed calls handler for every ShadowRootPushedEvent.
+//
+// The returned function removes the handler.
+func OnShadowRootPushed(source cdp.EventSource, handler func(*ShadowRootPushedEvent)) func() {
+	return cdp.On(source, EventShadowRootPushed, handler)
+}
@@ -0,0 +1,908 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package emulation provides typed bindings for the CDP Emulation domain.
+//
+// This domain emulates different environments for the page.
+package emulation
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// SafeAreaInsets is the Emulation.SafeAreaInsets type.
+//
+// Experimental.
+type SafeAreaInsets struct {
+	// Overrides safe-area-inset-top.
+	Top *int64 `json:"top,omitempty"`
+	// Overrides safe-area-max-inset-top.
+	TopMax *int64 `json:"topMax,omitempty"`
+	// Overrides safe-area-inset-left.
+	Left *int64 `json:"left,omitempty"`
+	// Overrides safe-area-max-inset-left.
+	LeftMax *int64 `json:"leftMax,omitempty"`
+	// Overrides safe-area-inset-bottom.
+	Bottom *int64 `json:"bottom,omitempty"`
+	// Overrides safe-area-max-inset-bottom.
+	BottomMax *int64 `json:"bottomMax,omitempty"`
+	// Overrides safe-area-inset-right.
+	Right *int64 `json:"right,omitempty"`
+	// Overrides safe-area-max-inset-right.
+	RightMax *int64 `json:"rightMax,omitempty"`
+}
+
+// ScreenOrientation screen orientation.
+type ScreenOrientation = cdp.EmulationScreenOrientation
+
+// DisplayFeature is the Emulation.DisplayFeature type.
+type DisplayFeature struct {
+	// Orientation of a display feature in relation to screen.
+	//
+	// Values: vertical, horizontal.
+	Orientation string `json:"orientation"`
+	// The offset from the screen origin in either the x (for vertical
+	// orientation) or y (for horizontal orientation) direction.
+	Offset int64 `json:"offset"`
+	// A display feature may mask content such that it is not physically
+	// displayed - this length along with the offset describes this area.
+	// A display feature that only splits content will have a 0 mask_length.
+	MaskLength int64 `json:"maskLength"`
+}
+
+// DevicePosture is the Emulation.DevicePosture type.
+type DevicePosture struct {
+	// Current posture of the device.
+	//
+	// Values: continuous, folded.
+	Type string `json:"type"`
+}
+
+// MediaFeature is the Emulation.MediaFeature type.
+type MediaFeature struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// VirtualTimePolicy advance: If the scheduler runs out of immediate work, the virtual time base may fast forward to
+// allow the next delayed task (if any) to run; pause: The virtual time base may not advance;
+// pauseIfNetworkFetchesPending: The virtual time base may not advance if there are any pending
+// resource fetches.
+//
+// Experimental.
+type VirtualTimePolicy string
+
+const (
+	VirtualTimePolicyAdvance                      VirtualTimePolicy = "advance"
+	VirtualTimePolicyPause                        VirtualTimePolicy = "pause"
+	VirtualTimePolicyPauseIfNetworkFetchesPending VirtualTimePolicy = "pauseIfNetworkFetchesPending"
+)
+
+// UserAgentBrandVersion used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints.
+//
+// Experimental.
+type UserAgentBrandVersion = cdp.EmulationUserAgentBrandVersion
+
+// UserAgentMetadata used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints
+// Missing optional values will be filled in by the target with what it would normally use.
+//
+// Experimental.
+type UserAgentMetadata = cdp.EmulationUserAgentMetadata
+
+// SensorType used to specify sensor types to emulate.
+// See https://w3c.github.io/sensors/#automation for more information.
+//
+// Experimental.
+type SensorType string
+
+const (
+	SensorTypeAbsoluteOrientation SensorType = "absolute-orientation"
+	SensorTypeAccelerometer       SensorType = "accelerometer"
+	SensorTypeAmbientLight        SensorType = "ambient-light"
+	SensorTypeGravity             SensorType = "gravity"
+	SensorTypeGyroscope           SensorType = "gyroscope"
+	SensorTypeLinearAcceleration  SensorType = "linear-acceleration"
+	SensorTypeMagnetometer        SensorType = "magnetometer"
+	SensorTypeRelativeOrientation SensorType = "relative-orientation"
+)
+
+// SensorMetadata is the Emulation.SensorMetadata type.
+//
+// Experimental.
+type SensorMetadata struct {
+	Available        *bool    `json:"available,omitempty"`
+	MinimumFrequency *float64 `json:"minimumFrequency,omitempty"`
+	MaximumFrequency *float64 `json:"maximumFrequency,omitempty"`
+}
+
+// SensorReadingSingle is the Emulation.SensorReadingSingle type.
+//
+// Experimental.
+type SensorReadingSingle struct {
+	Value float64 `json:"value"`
+}
+
+// SensorReadingXYZ is the Emulation.SensorReadingXYZ type.
+//
+// Experimental.
+type SensorReadingXYZ struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// SensorReadingQuaternion is the Emulation.SensorReadingQuaternion type.
+//
+// Experimental.
+type SensorReadingQuaternion struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+	W float64 `json:"w"`
+}
+
+// SensorReading is the Emulation.SensorReading type.
+//
+// Experimental.
+type SensorReading struct {
+	Single     *SensorReadingSingle     `json:"single,omitempty"`
+	Xyz        *SensorReadingXYZ        `json:"xyz,omitempty"`
+	Quaternion *SensorReadingQuaternion `json:"quaternion,omitempty"`
+}
+
+// PressureSource is the Emulation.PressureSource type.
+//
+// Experimental.
+type PressureSource string
+
+const (
+	PressureSourceCPU PressureSource = "cpu"
+)
+
+// PressureState is the Emulation.PressureState type.
+//
+// Experimental.
+type PressureState string
+
+const (
+	PressureStateNominal  PressureState = "nominal"
+	PressureStateFair     PressureState = "fair"
+	PressureStateSerious  PressureState = "serious"
+	PressureStateCritical PressureState = "critical"
+)
+
+// PressureMetadata is the Emulation.PressureMetadata type.
+//
+// Experimental.
+type PressureMetadata struct {
+	Available *bool `json:"available,omitempty"`
+}
+
+// DisabledImageType enum of image types that can be disabled.
+//
+// Experimental.
+type DisabledImageType string
+
+const (
+	DisabledImageTypeAvif DisabledImageType = "avif"
+	DisabledImageTypeWebp DisabledImageType = "webp"
+)
+
+// CanEmulateResult is the result of CanEmulate.
+type CanEmulateResult struct {
+	// True if emulation is supported.
+	Result bool `json:"result"`
+}
+
+// CanEmulate tells whether emulation is supported.
+//
+// Deprecated: deprecated in the protocol.
+func CanEmulate(ctx context.Context, session bidi.Transport) (*CanEmulateResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CanEmulateResult]("Emulation.canEmulate", nil))
+}
+
+// ClearDeviceMetricsOverride clears the overridden device metrics.
+func ClearDeviceMetricsOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.clearDeviceMetricsOverride", nil))
+
+	return err
+}
+
+// ClearGeolocationOverride clears the overridden Geolocation Position and Error.
+func ClearGeolocationOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.clearGeolocationOverride", nil))
+
+	return err
+}
+
+// ResetPageScaleFactor requests that page scale factor is reset to initial values.
+//
+// Experimental.
+func ResetPageScaleFactor(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.resetPageScaleFactor", nil))
+
+	return err
+}
+
+// SetFocusEmulationEnabledParams are the parameters of SetFocusEmulationEnabled.
+type SetFocusEmulationEnabledParams struct {
+	// Whether to enable to disable focus emulation.
+	Enabled bool `json:"enabled"`
+}
+
+// SetFocusEmulationEnabled enables or disables simulating a focused and active page.
+//
+// Experimental.
+func SetFocusEmulationEnabled(ctx context.Context, session bidi.Transport, params SetFocusEmulationEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setFocusEmulationEnabled", params))
+
+	return err
+}
+
+// SetAutoDarkModeOverrideParams are the parameters of SetAutoDarkModeOverride.
+type SetAutoDarkModeOverrideParams struct {
+	// Whether to enable or disable automatic dark mode.
+	// If not specified, any existing override will be cleared.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SetAutoDarkModeOverride automatically render all web contents using a dark theme.
+//
+// Experimental.
+func SetAutoDarkModeOverride(ctx context.Context, session bidi.Transport, params SetAutoDarkModeOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setAutoDarkModeOverride", params))
+
+	return err
+}
+
+// SetCPUThrottlingRateParams are the parameters of SetCPUThrottlingRate.
+type SetCPUThrottlingRateParams struct {
+	// Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
+	Rate float64 `json:"rate"`
+}
+
+// SetCPUThrottlingRate enables CPU throttling to emulate slow CPUs.
+func SetCPUThrottlingRate(ctx context.Context, session bidi.Transport, params SetCPUThrottlingRateParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setCPUThrottlingRate", params))
+
+	return err
+}
+
+// SetDefaultBackgroundColorOverrideParams are the parameters of SetDefaultBackgroundColorOverride.
+type SetDefaultBackgroundColorOverrideParams struct {
+	// RGBA of the default background color. If not specified, any existing override will be
+	// cleared.
+	Color *cdp.DOMRGBA `json:"color,omitempty"`
+}
+
+// SetDefaultBackgroundColorOverride sets or clears an override of the default background color of the frame. This override is used
+// if the content does not specify one.
+func SetDefaultBackgroundColorOverride(ctx context.Context, session bidi.Transport, params SetDefaultBackgroundColorOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setDefaultBackgroundColorOverride", params))
+
+	return err
+}
+
+// SetSafeAreaInsetsOverrideParams are the parameters of SetSafeAreaInsetsOverride.
+type SetSafeAreaInsetsOverrideParams struct {
+	Insets SafeAreaInsets `json:"insets"`
+}
+
+// SetSafeAreaInsetsOverride overrides the values for env(safe-area-inset-*) and env(safe-area-max-inset-*). Unset values will cause the
+// respective variables to be undefined, even if previously overridden.
+//
+// Experimental.
+func SetSafeAreaInsetsOverride(ctx context.Context, session bidi.Transport, params SetSafeAreaInsetsOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setSafeAreaInsetsOverride", params))
+
+	return err
+}
+
+// SetDeviceMetricsOverrideParams are the parameters of SetDeviceMetricsOverride.
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Width int64 `json:"width"`
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height int64 `json:"height"`
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text
+	// autosizing and more.
+	Mobile bool `json:"mobile"`
+	// Scale to apply to resulting view image.
+	Scale *float64 `json:"scale,omitempty"`
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	ScreenWidth *int64 `json:"screenWidth,omitempty"`
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	ScreenHeight *int64 `json:"screenHeight,omitempty"`
+	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+	PositionX *int64 `json:"positionX,omitempty"`
+	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+	PositionY *int64 `json:"positionY,omitempty"`
+	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
+	// Screen orientation override.
+	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
+	// If set, the visible area of the page will be overridden to this viewport. This viewport
+	// change is not observed by the page, e.g. viewport-relative elements do not change positions.
+	Viewport *cdp.PageViewport `json:"viewport,omitempty"`
+	// If set, the display feature of a multi-segment screen. If not set, multi-segment support
+	// is turned-off.
+	// Deprecated, use Emulation.setDisplayFeaturesOverride.
+	//
+	// Deprecated: deprecated in the protocol.
+	DisplayFeature *DisplayFeature `json:"displayFeature,omitempty"`
+	// If set, the posture of a foldable device. If not set the posture is set
+	// to continuous.
+	// Deprecated, use Emulation.setDevicePostureOverride.
+	//
+	// Deprecated: deprecated in the protocol.
+	DevicePosture *DevicePosture `json:"devicePosture,omitempty"`
+}
+
+// SetDeviceMetricsOverride overrides the values of device screen dimensions (window.screen.width, window.screen.height,
+// window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
+// query results).
+func SetDeviceMetricsOverride(ctx context.Context, session bidi.Transport, params SetDeviceMetricsOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setDeviceMetricsOverride", params))
+
+	return err
+}
+
+// SetDevicePostureOverrideParams are the parameters of SetDevicePostureOverride.
+type SetDevicePostureOverrideParams struct {
+	Posture DevicePosture `json:"posture"`
+}
+
+// SetDevicePostureOverride start reporting the given posture value to the Device Posture API.
+// This override can also be set in setDeviceMetricsOverride().
+//
+// Experimental.
+func SetDevicePostureOverride(ctx context.Context, session bidi.Transport, params SetDevicePostureOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setDevicePostureOverride", params))
+
+	return err
+}
+
+// ClearDevicePostureOverride clears a device posture override set with either setDeviceMetricsOverride()
+// or setDevicePostureOverride() and starts using posture information from the
+// platform again.
+// Does nothing if no override is set.
+//
+// Experimental.
+func ClearDevicePostureOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.clearDevicePostureOverride", nil))
+
+	return err
+}
+
+// SetDisplayFeaturesOverrideParams are the parameters of SetDisplayFeaturesOverride.
+type SetDisplayFeaturesOverrideParams struct {
+	Features []DisplayFeature `json:"features"`
+}
+
+// SetDisplayFeaturesOverride start using the given display features to pupulate the Viewport Segments API.
+// This override can also be set in setDeviceMetricsOverride().
+//
+// Experimental.
+func SetDisplayFeaturesOverride(ctx context.Context, session bidi.Transport, params SetDisplayFeaturesOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setDisplayFeaturesOverride", params))
+
+	return err
+}
+
+// ClearDisplayFeaturesOverride clears the display features override set with either setDeviceMetricsOverride()
+// or setDisplayFeaturesOverride() and starts using display features from the
+// platform again.
+// Does nothing if no override is set.
+//
+// Experimental.
+func ClearDisplayFeaturesOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.clearDisplayFeaturesOverride", nil))
+
+	return err
+}
+
+// SetScrollbarsHiddenParams are the parameters of SetScrollbarsHidden.
+type SetScrollbarsHiddenParams struct {
+	// Whether scrollbars should be always hidden.
+	Hidden bool `json:"hidden"`
+}
+
+// SetScrollbarsHidden runs Emulation.setScrollbarsHidden.
+//
+// Experimental.
+func SetScrollbarsHidden(ctx context.Context, session bidi.Transport, params SetScrollbarsHiddenParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setScrollbarsHidden", params))
+
+	return err
+}
+
+// SetDocumentCookieDisabledParams are the parameters of SetDocumentCookieDisabled.
+type SetDocumentCookieDisabledParams struct {
+	// Whether document.coookie API should be disabled.
+	Disabled bool `json:"disabled"`
+}
+
+// SetDocumentCookieDisabled runs Emulation.setDocumentCookieDisabled.
+//
+// Experimental.
+func SetDocumentCookieDisabled(ctx context.Context, session bidi.Transport, params SetDocumentCookieDisabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setDocumentCookieDisabled", params))
+
+	return err
+}
+
+// SetEmitTouchEventsForMouseParams are the parameters of SetEmitTouchEventsForMouse.
+type SetEmitTouchEventsForMouseParams struct {
+	// Whether touch emulation based on mouse input should be enabled.
+	Enabled bool `json:"enabled"`
+	// Touch/gesture events configuration. Default: current platform.
+	//
+	// Values: mobile, desktop.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// SetEmitTouchEventsForMouse runs Emulation.setEmitTouchEventsForMouse.
+//
+// Experimental.
+func SetEmitTouchEventsForMouse(ctx context.Context, session bidi.Transport, params SetEmitTouchEventsForMouseParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setEmitTouchEventsForMouse", params))
+
+	return err
+}
+
+// SetEmulatedMediaParams are the parameters of SetEmulatedMedia.
+type SetEmulatedMediaParams struct {
+	// Media type to emulate. Empty string disables the override.
+	Media string `json:"media,omitempty"`
+	// Media features to emulate.
+	Features []MediaFeature `json:"features,omitempty"`
+}
+
+// SetEmulatedMedia emulates the given media type or media feature for CSS media queries.
+func SetEmulatedMedia(ctx context.Context, session bidi.Transport, params SetEmulatedMediaParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setEmulatedMedia", params))
+
+	return err
+}
+
+// SetEmulatedVisionDeficiencyParams are the parameters of SetEmulatedVisionDeficiency.
+type SetEmulatedVisionDeficiencyParams struct {
+	// Vision deficiency to emulate. Order: best-effort emulations come first, followed by any
+	// physiologically accurate emulations for medically recognized color vision deficiencies.
+	//
+	// Values: none, blurredVision, reducedContrast, achromatopsia, deuteranopia, protanopia, tritanopia.
+	Type string `json:"type"`
+}
+
+// SetEmulatedVisionDeficiency emulates the given vision deficiency.
+func SetEmulatedVisionDeficiency(ctx context.Context, session bidi.Transport, params SetEmulatedVisionDeficiencyParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setEmulatedVisionDeficiency", params))
+
+	return err
+}
+
+// SetEmulatedOSTextScaleParams are the parameters of SetEmulatedOSTextScale.
+type SetEmulatedOSTextScaleParams struct {
+	Scale *float64 `json:"scale,omitempty"`
+}
+
+// SetEmulatedOSTextScale emulates the given OS text scale.
+func SetEmulatedOSTextScale(ctx context.Context, session bidi.Transport, params SetEmulatedOSTextScaleParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setEmulatedOSTextScale", params))
+
+	return err
+}
+
+// SetGeolocationOverrideParams are the parameters of SetGeolocationOverride.
+type SetGeolocationOverrideParams struct {
+	// Mock latitude.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Mock longitude.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Mock accuracy.
+	Accuracy *float64 `json:"accuracy,omitempty"`
+	// Mock altitude.
+	Altitude *float64 `json:"altitude,omitempty"`
+	// Mock altitudeAccuracy.
+	AltitudeAccuracy *float64 `json:"altitudeAccuracy,omitempty"`
+	// Mock heading.
+	Heading *float64 `json:"heading,omitempty"`
+	// Mock speed.
+	Speed *float64 `json:"speed,omitempty"`
+}
+
+// SetGeolocationOverride overrides the Geolocation Position or Error. Omitting latitude, longitude or
+// accuracy emulates position unavailable.
+func SetGeolocationOverride(ctx context.Context, session bidi.Transport, params SetGeolocationOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setGeolocationOverride", params))
+
+	return err
+}
+
+// GetOverriddenSensorInformationParams are the parameters of GetOverriddenSensorInformation.
+type GetOverriddenSensorInformationParams struct {
+	Type SensorType `json:"type"`
+}
+
+// GetOverriddenSensorInformationResult is the result of GetOverriddenSensorInformation.
+type GetOverriddenSensorInformationResult struct {
+	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"`
+}
+
+// GetOverriddenSensorInformation runs Emulation.getOverriddenSensorInformation.
+//
+// Experimental.
+func GetOverriddenSensorInformation(ctx context.Context, session bidi.Transport, params GetOverriddenSensorInformationParams) (*GetOverriddenSensorInformationResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetOverriddenSensorInformationResult]("Emulation.getOverriddenSensorInformation", params))
+}
+
+// SetSensorOverrideEnabledParams are the parameters of SetSensorOverrideEnabled.
+type SetSensorOverrideEnabledParams struct {
+	Enabled  bool            `json:"enabled"`
+	Type     SensorType      `json:"type"`
+	Metadata *SensorMetadata `json:"metadata,omitempty"`
+}
+
+// SetSensorOverrideEnabled overrides a platform sensor of a given type. If |enabled| is true, calls to
+// Sensor.start() will use a virtual sensor as backend rather than fetching
+// data from a real hardware sensor. Otherwise, existing virtual
+// sensor-backend Sensor objects will fire an error event and new calls to
+// Sensor.start() will attempt to use a real sensor instead.
+//
+// Experimental.
+func SetSensorOverrideEnabled(ctx context.Context, session bidi.Transport, params SetSensorOverrideEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setSensorOverrideEnabled", params))
+
+	return err
+}
+
+// SetSensorOverrideReadingsParams are the parameters of SetSensorOverrideReadings.
+type SetSensorOverrideReadingsParams struct {
+	Type    SensorType    `json:"type"`
+	Reading SensorReading `json:"reading"`
+}
+
+// SetSensorOverrideReadings updates the sensor readings reported by a sensor type previously overridden
+// by setSensorOverrideEnabled.
+//
+// Experimental.
+func SetSensorOverrideReadings(ctx context.Context, session bidi.Transport, params SetSensorOverrideReadingsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setSensorOverrideReadings", params))
+
+	return err
+}
+
+// SetPressureSourceOverrideEnabledParams are the parameters of SetPressureSourceOverrideEnabled.
+type SetPressureSourceOverrideEnabledParams struct {
+	Enabled  bool              `json:"enabled"`
+	Source   PressureSource    `json:"source"`
+	Metadata *PressureMetadata `json:"metadata,omitempty"`
+}
+
+// SetPressureSourceOverrideEnabled overrides a pressure source of a given type, as used by the Compute
+// Pressure API, so that updates to PressureObserver.observe() are provided
+// via setPressureStateOverride instead of being retrieved from
+// platform-provided telemetry data.
+//
+// Experimental.
+func SetPressureSourceOverrideEnabled(ctx context.Context, session bidi.Transport, params SetPressureSourceOverrideEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setPressureSourceOverrideEnabled", params))
+
+	return err
+}
+
+// SetPressureStateOverrideParams are the parameters of SetPressureStateOverride.
+type SetPressureStateOverrideParams struct {
+	Source PressureSource `json:"source"`
+	State  PressureState  `json:"state"`
+}
+
+// SetPressureStateOverride TODO: OBSOLETE: To remove when setPressureDataOverride is merged.
+// Provides a given pressure state that will be processed and eventually be
+// delivered to PressureObserver users. |source| must have been previously
+// overridden by setPressureSourceOverrideEnabled.
+//
+// Experimental.
+func SetPressureStateOverride(ctx context.Context, session bidi.Transport, params SetPressureStateOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setPressureStateOverride", params))
+
+	return err
+}
+
+// SetPressureDataOverrideParams are the parameters of SetPressureDataOverride.
+type SetPressureDataOverrideParams struct {
+	Source                  PressureSource `json:"source"`
+	State                   PressureState  `json:"state"`
+	OwnContributionEstimate *float64       `json:"ownContributionEstimate,omitempty"`
+}
+
+// SetPressureDataOverride provides a given pressure data set that will be processed and eventually be
+// delivered to PressureObserver users. |source| must have been previously
+// overridden by setPressureSourceOverrideEnabled.
+//
+// Experimental.
+func SetPressureDataOverride(ctx context.Context, session bidi.Transport, params SetPressureDataOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setPressureDataOverride", params))
+
+	return err
+}
+
+// SetIdleOverrideParams are the parameters of SetIdleOverride.
+type SetIdleOverrideParams struct {
+	// Mock isUserActive.
+	IsUserActive bool `json:"isUserActive"`
+	// Mock isScreenUnlocked.
+	IsScreenUnlocked bool `json:"isScreenUnlocked"`
+}
+
+// SetIdleOverride overrides the Idle state.
+func SetIdleOverride(ctx context.Context, session bidi.Transport, params SetIdleOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setIdleOverride", params))
+
+	return err
+}
+
+// ClearIdleOverride clears Idle state overrides.
+func ClearIdleOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.clearIdleOverride", nil))
+
+	return err
+}
+
+// SetNavigatorOverridesParams are the parameters of SetNavigatorOverrides.
+type SetNavigatorOverridesParams struct {
+	// The platform navigator.platform should return.
+	Platform string `json:"platform"`
+}
+
+// SetNavigatorOverrides overrides value returned by the javascript navigator object.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetNavigatorOverrides(ctx context.Context, session bidi.Transport, params SetNavigatorOverridesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setNavigatorOverrides", params))
+
+	return err
+}
+
+// SetPageScaleFactorParams are the parameters of SetPageScaleFactor.
+type SetPageScaleFactorParams struct {
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+}
+
+// SetPageScaleFactor sets a specified page scale factor.
+//
+// Experimental.
+func SetPageScaleFactor(ctx context.Context, session bidi.Transport, params SetPageScaleFactorParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setPageScaleFactor", params))
+
+	return err
+}
+
+// SetScriptExecutionDisabledParams are the parameters of SetScriptExecutionDisabled.
+type SetScriptExecutionDisabledParams struct {
+	// Whether script execution should be disabled in the page.
+	Value bool `json:"value"`
+}
+
+// SetScriptExecutionDisabled switches script execution in the page.
+func SetScriptExecutionDisabled(ctx context.Context, session bidi.Transport, params SetScriptExecutionDisabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setScriptExecutionDisabled", params))
+
+	return err
+}
+
+// SetTouchEmulationEnabledParams are the parameters of SetTouchEmulationEnabled.
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+	// Maximum touch points supported. Defaults to one.
+	MaxTouchPoints *int64 `json:"maxTouchPoints,omitempty"`
+}
+
+// SetTouchEmulationEnabled enables touch on platforms which do not support them.
+func SetTouchEmulationEnabled(ctx context.Context, session bidi.Transport, params SetTouchEmulationEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setTouchEmulationEnabled", params))
+
+	return err
+}
+
+// SetVirtualTimePolicyParams are the parameters of SetVirtualTimePolicy.
+type SetVirtualTimePolicyParams struct {
+	Policy VirtualTimePolicy `json:"policy"`
+	// If set, after this many virtual milliseconds have elapsed virtual time will be paused and a
+	// virtualTimeBudgetExpired event is sent.
+	Budget *float64 `json:"budget,omitempty"`
+	// If set this specifies the maximum number of tasks that can be run before virtual is forced
+	// forwards to prevent deadlock.
+	MaxVirtualTimeTaskStarvationCount *int64 `json:"maxVirtualTimeTaskStarvationCount,omitempty"`
+	// If set, base::Time::Now will be overridden to initially return this value.
+	InitialVirtualTime *cdp.NetworkTimeSinceEpoch `json:"initialVirtualTime,omitempty"`
+}
+
+// SetVirtualTimePolicyResult is the result of SetVirtualTimePolicy.
+type SetVirtualTimePolicyResult struct {
+	// Absolute timestamp at which virtual time was first enabled (up time in milliseconds).
+	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"`
+}
+
+// SetVirtualTimePolicy turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets
+// the current virtual time policy.  Note this supersedes any previous time budget.
+//
+// Experimental.
+func SetVirtualTimePolicy(ctx context.Context, session bidi.Transport, params SetVirtualTimePolicyParams) (*SetVirtualTimePolicyResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[SetVirtualTimePolicyResult]("Emulation.setVirtualTimePolicy", params))
+}
+
+// SetLocaleOverrideParams are the parameters of SetLocaleOverride.
+type SetLocaleOverrideParams struct {
+	// ICU style C locale (e.g. "en_US"). If not specified or empty, disables the override and
+	// restores default host system locale.
+	Locale string `json:"locale,omitempty"`
+}
+
+// SetLocaleOverride overrides default host system locale with the specified one.
+//
+// Experimental.
+func SetLocaleOverride(ctx context.Context, session bidi.Transport, params SetLocaleOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setLocaleOverride", params))
+
+	return err
+}
+
+// SetTimezoneOverrideParams are the parameters of SetTimezoneOverride.
+type SetTimezoneOverrideParams struct {
+	// The timezone identifier. List of supported timezones:
+	// https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt
+	// If empty, disables the override and restores default host system timezone.
+	TimezoneID string `json:"timezoneId"`
+}
+
+// SetTimezoneOverride overrides default host system timezone with the specified one.
+func SetTimezoneOverride(ctx context.Context, session bidi.Transport, params SetTimezoneOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setTimezoneOverride", params))
+
+	return err
+}
+
+// SetVisibleSizeParams are the parameters of SetVisibleSize.
+type SetVisibleSizeParams struct {
+	// Frame width (DIP).
+	Width int64 `json:"width"`
+	// Frame height (DIP).
+	Height int64 `json:"height"`
+}
+
+// SetVisibleSize resizes the frame/viewport of the page. Note that this does not affect the frame's container
+// (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported
+// on Android.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetVisibleSize(ctx context.Context, session bidi.Transport, params SetVisibleSizeParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setVisibleSize", params))
+
+	return err
+}
+
+// SetDisabledImageTypesParams are the parameters of SetDisabledImageTypes.
+type SetDisabledImageTypesParams struct {
+	// Image types to disable.
+	ImageTypes []DisabledImageType `json:"imageTypes"`
+}
+
+// SetDisabledImageTypes runs Emulation.setDisabledImageTypes.
+//
+// Experimental.
+func SetDisabledImageTypes(ctx context.Context, session bidi.Transport, params SetDisabledImageTypesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setDisabledImageTypes", params))
+
+	return err
+}
+
+// SetDataSaverOverrideParams are the parameters of SetDataSaverOverride.
+type SetDataSaverOverrideParams struct {
+	// Override value. Omitting the parameter disables the override.
+	DataSaverEnabled *bool `json:"dataSaverEnabled,omitempty"`
+}
+
+// SetDataSaverOverride override the value of navigator.connection.saveData.
+//
+// Experimental.
+func SetDataSaverOverride(ctx context.Context, session bidi.Transport, params SetDataSaverOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setDataSaverOverride", params))
+
+	return err
+}
+
+// SetHardwareConcurrencyOverrideParams are the parameters of SetHardwareConcurrencyOverride.
+type SetHardwareConcurrencyOverrideParams struct {
+	// Hardware concurrency to report.
+	HardwareConcurrency int64 `json:"hardwareConcurrency"`
+}
+
+// SetHardwareConcurrencyOverride runs Emulation.setHardwareConcurrencyOverride.
+//
+// Experimental.
+func SetHardwareConcurrencyOverride(ctx context.Context, session bidi.Transport, params SetHardwareConcurrencyOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setHardwareConcurrencyOverride", params))
+
+	return err
+}
+
+// SetUserAgentOverrideParams are the parameters of SetUserAgentOverride.
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+	// Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+	// The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData.
+	UserAgentMetadata *UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// SetUserAgentOverride allows overriding user agent with the given string.
+// `userAgentMetadata` must be set for Client Hint headers to be sent.
+func SetUserAgentOverride(ctx context.Context, session bidi.Transport, params SetUserAgentOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setUserAgentOverride", params))
+
+	return err
+}
+
+// SetAutomationOverrideParams are the parameters of SetAutomationOverride.
+type SetAutomationOverrideParams struct {
+	// Whether the override should be enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAutomationOverride allows overriding the automation flag.
+//
+// Experimental.
+func SetAutomationOverride(ctx context.Context, session bidi.Transport, params SetAutomationOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setAutomationOverride", params))
+
+	return err
+}
+
+// SetSmallViewportHeightDifferenceOverrideParams are the parameters of SetSmallViewportHeightDifferenceOverride.
+type SetSmallViewportHeightDifferenceOverrideParams struct {
+	// This will cause an element of size 100svh to be `difference` pixels smaller than an element
+	// of size 100lvh.
+	Difference int64 `json:"difference"`
+}
+
+// SetSmallViewportHeightDifferenceOverride allows overriding the difference between the small and large viewport sizes, which determine the
+// value of the `svh` and `lvh` unit, respectively. Only supported for top-level frames.
+//
+// Experimental.
+func SetSmallViewportHeightDifferenceOverride(ctx context.Context, session bidi.Transport, params SetSmallViewportHeightDifferenceOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Emulation.setSmallViewportHeightDifferenceOverride", params))
+
+	return err
+}
+
+// VirtualTimeBudgetExpiredEvent is sent on Emulation.virtualTimeBudgetExpired.
+//
+// Notification sent after the virtual time budget for the current VirtualTimePolicy has run out.
+//
+// Experimental.
+type VirtualTimeBudgetExpiredEvent struct {
+}
+
+// EventVirtualTimeBudgetExpired is the method of VirtualTimeBudgetExpiredEvent.
+const EventVirtualTimeBudgetExpired = "Emulation.virtualTimeBudgetExpired"
+
+// OnVirtualTimeBudgetExpired calls handler for every VirtualTimeBudgetExpiredEvent.
+//
+// The returned function removes the handler.
+func OnVirtualTimeBudgetExpired(source cdp.EventSource, handler func(*VirtualTimeBudgetExpiredEvent)) func() {
+	return cdp.On(source, EventVirtualTimeBudgetExpired, handler)
+}
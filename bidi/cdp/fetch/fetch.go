@@ -0,0 +1,343 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package fetch provides typed bindings for the CDP Fetch domain.
+//
+// A domain for letting clients substitute browser's network layer with client code.
+package fetch
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// RequestID unique request identifier.
+// Note that this does not identify individual HTTP requests that are part of
+// a network request.
+type RequestID string
+
+// RequestStage stages of the request to handle. Request will intercept before the request is
+// sent. Response will intercept after the response is received (but before response
+// body is received).
+type RequestStage string
+
+const (
+	RequestStageRequest  RequestStage = "Request"
+	RequestStageResponse RequestStage = "Response"
+)
+
+// RequestPattern is the Fetch.RequestPattern type.
+type RequestPattern struct {
+	// Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is
+	// backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+	// If set, only requests for matching resource types will be intercepted.
+	ResourceType cdp.NetworkResourceType `json:"resourceType,omitempty"`
+	// Stage at which to begin intercepting requests. Default is Request.
+	RequestStage RequestStage `json:"requestStage,omitempty"`
+}
+
+// HeaderEntry response HTTP header entry.
+type HeaderEntry struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// AuthChallenge authorization challenge for HTTP status code 401 or 407.
+type AuthChallenge struct {
+	// Source of the authentication challenge.
+	//
+	// Values: Server, Proxy.
+	Source string `json:"source,omitempty"`
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+	// The authentication scheme used, such as basic or digest.
+	Scheme string `json:"scheme"`
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+// AuthChallengeResponse response to an AuthChallenge.
+type AuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge.  Default means
+	// deferring to the default behavior of the net stack, which will likely either the Cancel
+	// authentication or display a popup dialog box.
+	//
+	// Values: Default, CancelAuth, ProvideCredentials.
+	Response string `json:"response"`
+	// The username to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Username string `json:"username,omitempty"`
+	// The password to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
+
+// Disable disables the fetch domain.
+func Disable(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Fetch.disable", nil))
+
+	return err
+}
+
+// EnableParams are the parameters of Enable.
+type EnableParams struct {
+	// If specified, only requests matching any of these patterns will produce
+	// fetchRequested event and will be paused until clients response. If not set,
+	// all requests will be affected.
+	Patterns []RequestPattern `json:"patterns,omitempty"`
+	// If true, authRequired events will be issued and requests will be paused
+	// expecting a call to continueWithAuth.
+	HandleAuthRequests *bool `json:"handleAuthRequests,omitempty"`
+}
+
+// Enable enables issuing of requestPaused events. A request will be paused until client
+// calls one of failRequest, fulfillRequest or continueRequest/continueWithAuth.
+func Enable(ctx context.Context, session bidi.Transport, params EnableParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Fetch.enable", params))
+
+	return err
+}
+
+// FailRequestParams are the parameters of FailRequest.
+type FailRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// Causes the request to fail with the given reason.
+	ErrorReason cdp.NetworkErrorReason `json:"errorReason"`
+}
+
+// FailRequest causes the request to fail with specified reason.
+func FailRequest(ctx context.Context, session bidi.Transport, params FailRequestParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Fetch.failRequest", params))
+
+	return err
+}
+
+// FulfillRequestParams are the parameters of FulfillRequest.
+type FulfillRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// An HTTP response code.
+	ResponseCode int64 `json:"responseCode"`
+	// Response headers.
+	ResponseHeaders []HeaderEntry `json:"responseHeaders,omitempty"`
+	// Alternative way of specifying response headers as a \0-separated
+	// series of name: value pairs. Prefer the above method unless you
+	// need to represent some non-UTF8 values that can't be transmitted
+	// over the protocol as text. (Encoded as a base64 string when passed over JSON).
+	BinaryResponseHeaders string `json:"binaryResponseHeaders,omitempty"`
+	// A response body. If absent, original response body will be used if
+	// the request is intercepted at the response stage and empty body
+	// will be used if the request is intercepted at the request stage. (Encoded as a base64 string when passed over JSON).
+	Body string `json:"body,omitempty"`
+	// A textual representation of responseCode.
+	// If absent, a standard phrase matching responseCode is used.
+	ResponsePhrase string `json:"responsePhrase,omitempty"`
+}
+
+// FulfillRequest provides response to the request.
+func FulfillRequest(ctx context.Context, session bidi.Transport, params FulfillRequestParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Fetch.fulfillRequest", params))
+
+	return err
+}
+
+// ContinueRequestParams are the parameters of ContinueRequest.
+type ContinueRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// If set, the request url will be modified in a way that's not observable by page.
+	URL string `json:"url,omitempty"`
+	// If set, the request method is overridden.
+	Method string `json:"method,omitempty"`
+	// If set, overrides the post data in the request. (Encoded as a base64 string when passed over JSON).
+	PostData string `json:"postData,omitempty"`
+	// If set, overrides the request headers. Note that the overrides do not
+	// extend to subsequent redirect hops, if a redirect happens. Another override
+	// may be applied to a different request produced by a redirect.
+	Headers []HeaderEntry `json:"headers,omitempty"`
+	// If set, overrides response interception behavior for this request.
+	InterceptResponse *bool `json:"interceptResponse,omitempty"`
+}
+
+// ContinueRequest continues the request, optionally modifying some of its parameters.
+func ContinueRequest(ctx context.Context, session bidi.Transport, params ContinueRequestParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Fetch.continueRequest", params))
+
+	return err
+}
+
+// ContinueWithAuthParams are the parameters of ContinueWithAuth.
+type ContinueWithAuthParams struct {
+	// An id the client received in authRequired event.
+	RequestID RequestID `json:"requestId"`
+	// Response to  with an authChallenge.
+	AuthChallengeResponse AuthChallengeResponse `json:"authChallengeResponse"`
+}
+
+// ContinueWithAuth continues a request supplying authChallengeResponse following authRequired event.
+func ContinueWithAuth(ctx context.Context, session bidi.Transport, params ContinueWithAuthParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Fetch.continueWithAuth", params))
+
+	return err
+}
+
+// ContinueResponseParams are the parameters of ContinueResponse.
+type ContinueResponseParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+	// An HTTP response code. If absent, original response code will be used.
+	ResponseCode *int64 `json:"responseCode,omitempty"`
+	// A textual representation of responseCode.
+	// If absent, a standard phrase matching responseCode is used.
+	ResponsePhrase string `json:"responsePhrase,omitempty"`
+	// Response headers. If absent, original response headers will be used.
+	ResponseHeaders []HeaderEntry `json:"responseHeaders,omitempty"`
+	// Alternative way of specifying response headers as a \0-separated
+	// series of name: value pairs. Prefer the above method unless you
+	// need to represent some non-UTF8 values that can't be transmitted
+	// over the protocol as text. (Encoded as a base64 string when passed over JSON).
+	BinaryResponseHeaders string `json:"binaryResponseHeaders,omitempty"`
+}
+
+// ContinueResponse continues loading of the paused response, optionally modifying the
+// response headers. If either responseCode or headers are modified, all of them
+// must be present.
+//
+// Experimental.
+func ContinueResponse(ctx context.Context, session bidi.Transport, params ContinueResponseParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Fetch.continueResponse", params))
+
+	return err
+}
+
+// GetResponseBodyParams are the parameters of GetResponseBody.
+type GetResponseBodyParams struct {
+	// Identifier for the intercepted request to get body for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// GetResponseBodyResult is the result of GetResponseBody.
+type GetResponseBodyResult struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// GetResponseBody causes the body of the response to be received from the server and
+// returned as a single string. May only be issued for a request that
+// is paused in the Response stage and is mutually exclusive with
+// takeResponseBodyForInterceptionAsStream. Calling other methods that
+// affect the request or disabling fetch domain before body is received
+// results in an undefined behavior.
+// Note that the response body is not available for redirects. Requests
+// paused in the _redirect received_ state may be differentiated by
+// `responseCode` and presence of `location` response header, see
+// comments to `requestPaused` for details.
+func GetResponseBody(ctx context.Context, session bidi.Transport, params GetResponseBodyParams) (*GetResponseBodyResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetResponseBodyResult]("Fetch.getResponseBody", params))
+}
+
+// TakeResponseBodyAsStreamParams are the parameters of TakeResponseBodyAsStream.
+type TakeResponseBodyAsStreamParams struct {
+	RequestID RequestID `json:"requestId"`
+}
+
+// TakeResponseBodyAsStreamResult is the result of TakeResponseBodyAsStream.
+type TakeResponseBodyAsStreamResult struct {
+	Stream cdp.IOStreamHandle `json:"stream"`
+}
+
+// TakeResponseBodyAsStream returns a handle to the stream representing the response body.
+// The request must be paused in the HeadersReceived stage.
+// Note that after this command the request can't be continued
+// as is -- client either needs to cancel it or to provide the
+// response body.
+// The stream only supports sequential read, IO.read will fail if the position
+// is specified.
+// This method is mutually exclusive with getResponseBody.
+// Calling other methods that affect the request or disabling fetch
+// domain before body is received results in an undefined behavior.
+func TakeResponseBodyAsStream(ctx context.Context, session bidi.Transport, params TakeResponseBodyAsStreamParams) (*TakeResponseBodyAsStreamResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[TakeResponseBodyAsStreamResult]("Fetch.takeResponseBodyAsStream", params))
+}
+
+// RequestPausedEvent is sent on Fetch.requestPaused.
+//
+// Issued when the domain is enabled and the request URL matches the
+// specified filter. The request is paused until the client responds
+// with one of continueRequest, failRequest or fulfillRequest.
+// The stage of the request can be determined by presence of responseErrorReason
+// and responseStatusCode -- the request is at the response stage if either
+// of these fields is present and in the request stage otherwise.
+// Redirect responses and subsequent requests are reported similarly to regular
+// responses and requests. Redirect responses may be distinguished by the value
+// of `responseStatusCode` (which is one of 301, 302, 303, 307, 308) along with
+// presence of the `location` header. Requests resulting from a redirect will
+// have `redirectedRequestId` field set.
+type RequestPausedEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+	// The details of the request.
+	Request cdp.NetworkRequest `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID cdp.PageFrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType cdp.NetworkResourceType `json:"resourceType"`
+	// Response error if intercepted at response stage.
+	ResponseErrorReason cdp.NetworkErrorReason `json:"responseErrorReason,omitempty"`
+	// Response code if intercepted at response stage.
+	ResponseStatusCode *int64 `json:"responseStatusCode,omitempty"`
+	// Response status text if intercepted at response stage.
+	ResponseStatusText string `json:"responseStatusText,omitempty"`
+	// Response headers if intercepted at the response stage.
+	ResponseHeaders []HeaderEntry `json:"responseHeaders,omitempty"`
+	// If the intercepted request had a corresponding Network.requestWillBeSent event fired for it,
+	// then this networkId will be the same as the requestId present in the requestWillBeSent event.
+	NetworkID cdp.NetworkRequestID `json:"networkId,omitempty"`
+	// If the request is due to a redirect response from the server, the id of the request that
+	// has caused the redirect.
+	RedirectedRequestID RequestID `json:"redirectedRequestId,omitempty"`
+}
+
+// EventRequestPaused is the method of RequestPausedEvent.
+const EventRequestPaused = "Fetch.requestPaused"
+
+// OnRequestPaused calls handler for every RequestPausedEvent.
+//
+// The returned function removes the handler.
+func OnRequestPaused(source cdp.EventSource, handler func(*RequestPausedEvent)) func() {
+	return cdp.On(source, EventRequestPaused, handler)
+}
+
+// AuthRequiredEvent is sent on Fetch.authRequired.
+//
+// Issued when the domain is enabled with handleAuthRequests set to true.
+// The request is paused until client responds with continueWithAuth.
+type AuthRequiredEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+	// The details of the request.
+	Request cdp.NetworkRequest `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID cdp.PageFrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType cdp.NetworkResourceType `json:"resourceType"`
+	// Details of the Authorization Challenge encountered.
+	// If this is set, client should respond with continueRequest that
+	// contains AuthChallengeResponse.
+	AuthChallenge AuthChallenge `json:"authChallenge"`
+}
+
+// EventAuthRequired is the method of AuthRequiredEvent.
+const EventAuthRequired = "Fetch.authRequired"
+
+// OnAuthRequired calls handler for every AuthRequiredEvent.
+//
+// The returned function removes the handler.
+func OnAuthRequired(source cdp.EventSource, handler func(*AuthRequiredEvent)) func() {
+	return cdp.On(source, EventAuthRequired, handler)
+}
@@ -0,0 +1,2865 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package network provides typed bindings for the CDP Network domain.
+//
+// Network domain allows tracking network activities of the page. It exposes information about http,
+// file, data and other requests and responses, their headers, bodies, timing, etc.
+package network
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// ResourceType resource type as it was perceived by the rendering engine.
+type ResourceType = cdp.NetworkResourceType
+
+const (
+	ResourceTypeDocument           = cdp.NetworkResourceTypeDocument
+	ResourceTypeStylesheet         = cdp.NetworkResourceTypeStylesheet
+	ResourceTypeImage              = cdp.NetworkResourceTypeImage
+	ResourceTypeMedia              = cdp.NetworkResourceTypeMedia
+	ResourceTypeFont               = cdp.NetworkResourceTypeFont
+	ResourceTypeScript             = cdp.NetworkResourceTypeScript
+	ResourceTypeTextTrack          = cdp.NetworkResourceTypeTextTrack
+	ResourceTypeXHR                = cdp.NetworkResourceTypeXHR
+	ResourceTypeFetch              = cdp.NetworkResourceTypeFetch
+	ResourceTypePrefetch           = cdp.NetworkResourceTypePrefetch
+	ResourceTypeEventSource        = cdp.NetworkResourceTypeEventSource
+	ResourceTypeWebSocket          = cdp.NetworkResourceTypeWebSocket
+	ResourceTypeManifest           = cdp.NetworkResourceTypeManifest
+	ResourceTypeSignedExchange     = cdp.NetworkResourceTypeSignedExchange
+	ResourceTypePing               = cdp.NetworkResourceTypePing
+	ResourceTypeCSPViolationReport = cdp.NetworkResourceTypeCSPViolationReport
+	ResourceTypePreflight          = cdp.NetworkResourceTypePreflight
+	ResourceTypeFedCM              = cdp.NetworkResourceTypeFedCM
+	ResourceTypeOther              = cdp.NetworkResourceTypeOther
+)
+
+// LoaderID unique loader identifier.
+type LoaderID = cdp.NetworkLoaderID
+
+// RequestID unique network request identifier.
+// Note that this does not identify individual HTTP requests that are part of
+// a network request.
+type RequestID = cdp.NetworkRequestID
+
+// InterceptionID unique intercepted request identifier.
+type InterceptionID string
+
+// ErrorReason network level fetch failure reason.
+type ErrorReason = cdp.NetworkErrorReason
+
+const (
+	ErrorReasonFailed               = cdp.NetworkErrorReasonFailed
+	ErrorReasonAborted              = cdp.NetworkErrorReasonAborted
+	ErrorReasonTimedOut             = cdp.NetworkErrorReasonTimedOut
+	ErrorReasonAccessDenied         = cdp.NetworkErrorReasonAccessDenied
+	ErrorReasonConnectionClosed     = cdp.NetworkErrorReasonConnectionClosed
+	ErrorReasonConnectionReset      = cdp.NetworkErrorReasonConnectionReset
+	ErrorReasonConnectionRefused    = cdp.NetworkErrorReasonConnectionRefused
+	ErrorReasonConnectionAborted    = cdp.NetworkErrorReasonConnectionAborted
+	ErrorReasonConnectionFailed     = cdp.NetworkErrorReasonConnectionFailed
+	ErrorReasonNameNotResolved      = cdp.NetworkErrorReasonNameNotResolved
+	ErrorReasonInternetDisconnected = cdp.NetworkErrorReasonInternetDisconnected
+	ErrorReasonAddressUnreachable   = cdp.NetworkErrorReasonAddressUnreachable
+	ErrorReasonBlockedByClient      = cdp.NetworkErrorReasonBlockedByClient
+	ErrorReasonBlockedByResponse    = cdp.NetworkErrorReasonBlockedByResponse
+)
+
+// TimeSinceEpoch UTC time in seconds, counted from January 1, 1970.
+type TimeSinceEpoch = cdp.NetworkTimeSinceEpoch
+
+// MonotonicTime monotonically increasing time in seconds since an arbitrary point in the past.
+type MonotonicTime = cdp.NetworkMonotonicTime
+
+// Headers request / response headers as keys / values of JSON object.
+type Headers = cdp.NetworkHeaders
+
+// ConnectionType the underlying connection technology that the browser is supposedly using.
+type ConnectionType string
+
+const (
+	ConnectionTypeNone       ConnectionType = "none"
+	ConnectionTypeCellular2g ConnectionType = "cellular2g"
+	ConnectionTypeCellular3g ConnectionType = "cellular3g"
+	ConnectionTypeCellular4g ConnectionType = "cellular4g"
+	ConnectionTypeBluetooth  ConnectionType = "bluetooth"
+	ConnectionTypeEthernet   ConnectionType = "ethernet"
+	ConnectionTypeWifi       ConnectionType = "wifi"
+	ConnectionTypeWimax      ConnectionType = "wimax"
+	ConnectionTypeOther      ConnectionType = "other"
+)
+
+// CookieSameSite represents the cookie's 'SameSite' status:
+// https://tools.ietf.org/html/draft-west-first-party-cookies.
+type CookieSameSite string
+
+const (
+	CookieSameSiteStrict CookieSameSite = "Strict"
+	CookieSameSiteLax    CookieSameSite = "Lax"
+	CookieSameSiteNone   CookieSameSite = "None"
+)
+
+// CookiePriority represents the cookie's 'Priority' status:
+// https://tools.ietf.org/html/draft-west-cookie-priority-00.
+//
+// Experimental.
+type CookiePriority string
+
+const (
+	CookiePriorityLow    CookiePriority = "Low"
+	CookiePriorityMedium CookiePriority = "Medium"
+	CookiePriorityHigh   CookiePriority = "High"
+)
+
+// CookieSourceScheme represents the source scheme of the origin that originally set the cookie.
+// A value of "Unset" allows protocol clients to emulate legacy cookie scope for the scheme.
+// This is a temporary ability and it will be removed in the future.
+//
+// Experimental.
+type CookieSourceScheme string
+
+const (
+	CookieSourceSchemeUnset     CookieSourceScheme = "Unset"
+	CookieSourceSchemeNonSecure CookieSourceScheme = "NonSecure"
+	CookieSourceSchemeSecure    CookieSourceScheme = "Secure"
+)
+
+// ResourceTiming timing information for the request.
+type ResourceTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are ticks in
+	// milliseconds relatively to this requestTime.
+	RequestTime float64 `json:"requestTime"`
+	// Started resolving proxy.
+	ProxyStart float64 `json:"proxyStart"`
+	// Finished resolving proxy.
+	ProxyEnd float64 `json:"proxyEnd"`
+	// Started DNS address resolve.
+	DNSStart float64 `json:"dnsStart"`
+	// Finished DNS address resolve.
+	DNSEnd float64 `json:"dnsEnd"`
+	// Started connecting to the remote host.
+	ConnectStart float64 `json:"connectStart"`
+	// Connected to the remote host.
+	ConnectEnd float64 `json:"connectEnd"`
+	// Started SSL handshake.
+	SSLStart float64 `json:"sslStart"`
+	// Finished SSL handshake.
+	SSLEnd float64 `json:"sslEnd"`
+	// Started running ServiceWorker.
+	WorkerStart float64 `json:"workerStart"`
+	// Finished Starting ServiceWorker.
+	WorkerReady float64 `json:"workerReady"`
+	// Started fetch event.
+	WorkerFetchStart float64 `json:"workerFetchStart"`
+	// Settled fetch event respondWith promise.
+	WorkerRespondWithSettled float64 `json:"workerRespondWithSettled"`
+	// Started ServiceWorker static routing source evaluation.
+	WorkerRouterEvaluationStart *float64 `json:"workerRouterEvaluationStart,omitempty"`
+	// Started cache lookup when the source was evaluated to `cache`.
+	WorkerCacheLookupStart *float64 `json:"workerCacheLookupStart,omitempty"`
+	// Started sending request.
+	SendStart float64 `json:"sendStart"`
+	// Finished sending request.
+	SendEnd float64 `json:"sendEnd"`
+	// Time the server started pushing request.
+	PushStart float64 `json:"pushStart"`
+	// Time the server finished pushing request.
+	PushEnd float64 `json:"pushEnd"`
+	// Started receiving response headers.
+	ReceiveHeadersStart float64 `json:"receiveHeadersStart"`
+	// Finished receiving response headers.
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+// ResourcePriority loading priority of a resource request.
+type ResourcePriority = cdp.NetworkResourcePriority
+
+const (
+	ResourcePriorityVeryLow  = cdp.NetworkResourcePriorityVeryLow
+	ResourcePriorityLow      = cdp.NetworkResourcePriorityLow
+	ResourcePriorityMedium   = cdp.NetworkResourcePriorityMedium
+	ResourcePriorityHigh     = cdp.NetworkResourcePriorityHigh
+	ResourcePriorityVeryHigh = cdp.NetworkResourcePriorityVeryHigh
+)
+
+// PostDataEntry post data entry for HTTP request.
+type PostDataEntry = cdp.NetworkPostDataEntry
+
+// Request HTTP request data.
+type Request = cdp.NetworkRequest
+
+// SignedCertificateTimestamp details of a signed certificate timestamp (SCT).
+type SignedCertificateTimestamp struct {
+	// Validation status.
+	Status string `json:"status"`
+	// Origin.
+	Origin string `json:"origin"`
+	// Log name / description.
+	LogDescription string `json:"logDescription"`
+	// Log ID.
+	LogID string `json:"logId"`
+	// Issuance date. Unlike TimeSinceEpoch, this contains the number of
+	// milliseconds since January 1, 1970, UTC, not the number of seconds.
+	Timestamp float64 `json:"timestamp"`
+	// Hash algorithm.
+	HashAlgorithm string `json:"hashAlgorithm"`
+	// Signature algorithm.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+	// Signature data.
+	SignatureData string `json:"signatureData"`
+}
+
+// SecurityDetails security details about a request.
+type SecurityDetails struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+	// (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+	// Cipher name.
+	Cipher string `json:"cipher"`
+	// TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+	// Certificate ID value.
+	CertificateID cdp.SecurityCertificateID `json:"certificateId"`
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+	// Subject Alternative Name (SAN) DNS names and IP addresses.
+	SanList []string `json:"sanList"`
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+	// Certificate valid from date.
+	ValidFrom TimeSinceEpoch `json:"validFrom"`
+	// Certificate valid to (expiration) date.
+	ValidTo TimeSinceEpoch `json:"validTo"`
+	// List of signed certificate timestamps (SCTs).
+	SignedCertificateTimestampList []SignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+	// Whether the request complied with Certificate Transparency policy.
+	CertificateTransparencyCompliance CertificateTransparencyCompliance `json:"certificateTransparencyCompliance"`
+	// The signature algorithm used by the server in the TLS server signature,
+	// represented as a TLS SignatureScheme code point. Omitted if not
+	// applicable or not known.
+	ServerSignatureAlgorithm *int64 `json:"serverSignatureAlgorithm,omitempty"`
+	// Whether the connection used Encrypted ClientHello.
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+// CertificateTransparencyCompliance whether the request complied with Certificate Transparency policy.
+type CertificateTransparencyCompliance string
+
+const (
+	CertificateTransparencyComplianceUnknown      CertificateTransparencyCompliance = "unknown"
+	CertificateTransparencyComplianceNotCompliant CertificateTransparencyCompliance = "not-compliant"
+	CertificateTransparencyComplianceCompliant    CertificateTransparencyCompliance = "compliant"
+)
+
+// BlockedReason the reason why request was blocked.
+type BlockedReason string
+
+const (
+	BlockedReasonOther                                                   BlockedReason = "other"
+	BlockedReasonCSP                                                     BlockedReason = "csp"
+	BlockedReasonMixedContent                                            BlockedReason = "mixed-content"
+	BlockedReasonOrigin                                                  BlockedReason = "origin"
+	BlockedReasonInspector                                               BlockedReason = "inspector"
+	BlockedReasonIntegrity                                               BlockedReason = "integrity"
+	BlockedReasonSubresourceFilter                                       BlockedReason = "subresource-filter"
+	BlockedReasonContentType                                             BlockedReason = "content-type"
+	BlockedReasonCoepFrameResourceNeedsCoepHeader                        BlockedReason = "coep-frame-resource-needs-coep-header"
+	BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             BlockedReason = "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+	BlockedReasonCorpNotSameOrigin                                       BlockedReason = "corp-not-same-origin"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+	BlockedReasonCorpNotSameSite                                         BlockedReason = "corp-not-same-site"
+	BlockedReasonSriMessageSignatureMismatch                             BlockedReason = "sri-message-signature-mismatch"
+)
+
+// CORSError the reason why request was blocked.
+type CORSError string
+
+const (
+	CORSErrorDisallowedByMode                          CORSError = "DisallowedByMode"
+	CORSErrorInvalidResponse                           CORSError = "InvalidResponse"
+	CORSErrorWildcardOriginNotAllowed                  CORSError = "WildcardOriginNotAllowed"
+	CORSErrorMissingAllowOriginHeader                  CORSError = "MissingAllowOriginHeader"
+	CORSErrorMultipleAllowOriginValues                 CORSError = "MultipleAllowOriginValues"
+	CORSErrorInvalidAllowOriginValue                   CORSError = "InvalidAllowOriginValue"
+	CORSErrorAllowOriginMismatch                       CORSError = "AllowOriginMismatch"
+	CORSErrorInvalidAllowCredentials                   CORSError = "InvalidAllowCredentials"
+	CORSErrorCORSDisabledScheme                        CORSError = "CorsDisabledScheme"
+	CORSErrorPreflightInvalidStatus                    CORSError = "PreflightInvalidStatus"
+	CORSErrorPreflightDisallowedRedirect               CORSError = "PreflightDisallowedRedirect"
+	CORSErrorPreflightWildcardOriginNotAllowed         CORSError = "PreflightWildcardOriginNotAllowed"
+	CORSErrorPreflightMissingAllowOriginHeader         CORSError = "PreflightMissingAllowOriginHeader"
+	CORSErrorPreflightMultipleAllowOriginValues        CORSError = "PreflightMultipleAllowOriginValues"
+	CORSErrorPreflightInvalidAllowOriginValue          CORSError = "PreflightInvalidAllowOriginValue"
+	CORSErrorPreflightAllowOriginMismatch              CORSError = "PreflightAllowOriginMismatch"
+	CORSErrorPreflightInvalidAllowCredentials          CORSError = "PreflightInvalidAllowCredentials"
+	CORSErrorPreflightMissingAllowExternal             CORSError = "PreflightMissingAllowExternal"
+	CORSErrorPreflightInvalidAllowExternal             CORSError = "PreflightInvalidAllowExternal"
+	CORSErrorPreflightMissingAllowPrivateNetwork       CORSError = "PreflightMissingAllowPrivateNetwork"
+	CORSErrorPreflightInvalidAllowPrivateNetwork       CORSError = "PreflightInvalidAllowPrivateNetwork"
+	CORSErrorInvalidAllowMethodsPreflightResponse      CORSError = "InvalidAllowMethodsPreflightResponse"
+	CORSErrorInvalidAllowHeadersPreflightResponse      CORSError = "InvalidAllowHeadersPreflightResponse"
+	CORSErrorMethodDisallowedByPreflightResponse       CORSError = "MethodDisallowedByPreflightResponse"
+	CORSErrorHeaderDisallowedByPreflightResponse       CORSError = "HeaderDisallowedByPreflightResponse"
+	CORSErrorRedirectContainsCredentials               CORSError = "RedirectContainsCredentials"
+	CORSErrorInsecurePrivateNetwork                    CORSError = "InsecurePrivateNetwork"
+	CORSErrorInvalidPrivateNetworkAccess               CORSError = "InvalidPrivateNetworkAccess"
+	CORSErrorUnexpectedPrivateNetworkAccess            CORSError = "UnexpectedPrivateNetworkAccess"
+	CORSErrorNoCORSRedirectModeNotFollow               CORSError = "NoCorsRedirectModeNotFollow"
+	CORSErrorPreflightMissingPrivateNetworkAccessID    CORSError = "PreflightMissingPrivateNetworkAccessId"
+	CORSErrorPreflightMissingPrivateNetworkAccessName  CORSError = "PreflightMissingPrivateNetworkAccessName"
+	CORSErrorPrivateNetworkAccessPermissionUnavailable CORSError = "PrivateNetworkAccessPermissionUnavailable"
+	CORSErrorPrivateNetworkAccessPermissionDenied      CORSError = "PrivateNetworkAccessPermissionDenied"
+	CORSErrorLocalNetworkAccessPermissionDenied        CORSError = "LocalNetworkAccessPermissionDenied"
+)
+
+// CORSErrorStatus is the Network.CorsErrorStatus type.
+type CORSErrorStatus struct {
+	CORSError       CORSError `json:"corsError"`
+	FailedParameter string    `json:"failedParameter"`
+}
+
+// ServiceWorkerResponseSource source of serviceworker response.
+type ServiceWorkerResponseSource string
+
+const (
+	ServiceWorkerResponseSourceCacheStorage ServiceWorkerResponseSource = "cache-storage"
+	ServiceWorkerResponseSourceHTTPCache    ServiceWorkerResponseSource = "http-cache"
+	ServiceWorkerResponseSourceFallbackCode ServiceWorkerResponseSource = "fallback-code"
+	ServiceWorkerResponseSourceNetwork      ServiceWorkerResponseSource = "network"
+)
+
+// TrustTokenParams determines what type of Trust Token operation is executed and
+// depending on the type, some additional parameters. The values
+// are specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+//
+// Experimental.
+type TrustTokenParams = cdp.NetworkTrustTokenParams
+
+// TrustTokenOperationType is the Network.TrustTokenOperationType type.
+//
+// Experimental.
+type TrustTokenOperationType = cdp.NetworkTrustTokenOperationType
+
+const (
+	TrustTokenOperationTypeIssuance   = cdp.NetworkTrustTokenOperationTypeIssuance
+	TrustTokenOperationTypeRedemption = cdp.NetworkTrustTokenOperationTypeRedemption
+	TrustTokenOperationTypeSigning    = cdp.NetworkTrustTokenOperationTypeSigning
+)
+
+// AlternateProtocolUsage the reason why Chrome uses a specific transport protocol for HTTP semantics.
+//
+// Experimental.
+type AlternateProtocolUsage string
+
+const (
+	AlternateProtocolUsageAlternativeJobWonWithoutRace AlternateProtocolUsage = "alternativeJobWonWithoutRace"
+	AlternateProtocolUsageAlternativeJobWonRace        AlternateProtocolUsage = "alternativeJobWonRace"
+	AlternateProtocolUsageMainJobWonRace               AlternateProtocolUsage = "mainJobWonRace"
+	AlternateProtocolUsageMappingMissing               AlternateProtocolUsage = "mappingMissing"
+	AlternateProtocolUsageBroken                       AlternateProtocolUsage = "broken"
+	AlternateProtocolUsageDNSAlpnH3JobWonWithoutRace   AlternateProtocolUsage = "dnsAlpnH3JobWonWithoutRace"
+	AlternateProtocolUsageDNSAlpnH3JobWonRace          AlternateProtocolUsage = "dnsAlpnH3JobWonRace"
+	AlternateProtocolUsageUnspecifiedReason            AlternateProtocolUsage = "unspecifiedReason"
+)
+
+// ServiceWorkerRouterSource source of service worker router.
+type ServiceWorkerRouterSource string
+
+const (
+	ServiceWorkerRouterSourceNetwork                    ServiceWorkerRouterSource = "network"
+	ServiceWorkerRouterSourceCache                      ServiceWorkerRouterSource = "cache"
+	ServiceWorkerRouterSourceFetchEvent                 ServiceWorkerRouterSource = "fetch-event"
+	ServiceWorkerRouterSourceRaceNetworkAndFetchHandler ServiceWorkerRouterSource = "race-network-and-fetch-handler"
+	ServiceWorkerRouterSourceRaceNetworkAndCache        ServiceWorkerRouterSource = "race-network-and-cache"
+)
+
+// ServiceWorkerRouterInfo is the Network.ServiceWorkerRouterInfo type.
+//
+// Experimental.
+type ServiceWorkerRouterInfo struct {
+	// ID of the rule matched. If there is a matched rule, this field will
+	// be set, otherwiser no value will be set.
+	RuleIDMatched *int64 `json:"ruleIdMatched,omitempty"`
+	// The router source of the matched rule. If there is a matched rule, this
+	// field will be set, otherwise no value will be set.
+	MatchedSourceType ServiceWorkerRouterSource `json:"matchedSourceType,omitempty"`
+	// The actual router source used.
+	ActualSourceType ServiceWorkerRouterSource `json:"actualSourceType,omitempty"`
+}
+
+// Response HTTP response data.
+type Response struct {
+	// Response URL. This URL can be different from CachedResource.url in case of redirect.
+	URL string `json:"url"`
+	// HTTP response status code.
+	Status int64 `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+	// HTTP response headers text. This has been replaced by the headers in Network.responseReceivedExtraInfo.
+	//
+	// Deprecated: deprecated in the protocol.
+	HeadersText string `json:"headersText,omitempty"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// Resource charset as determined by the browser (if applicable).
+	Charset string `json:"charset"`
+	// Refined HTTP request headers that were actually transmitted over the network.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+	// HTTP request headers text. This has been replaced by the headers in Network.requestWillBeSentExtraInfo.
+	//
+	// Deprecated: deprecated in the protocol.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+	// Specifies whether physical connection was actually reused for this request.
+	ConnectionReused bool `json:"connectionReused"`
+	// Physical connection id that was actually used for this request.
+	ConnectionID float64 `json:"connectionId"`
+	// Remote IP address.
+	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
+	// Remote port.
+	RemotePort *int64 `json:"remotePort,omitempty"`
+	// Specifies that the request was served from the disk cache.
+	FromDiskCache *bool `json:"fromDiskCache,omitempty"`
+	// Specifies that the request was served from the ServiceWorker.
+	FromServiceWorker *bool `json:"fromServiceWorker,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromPrefetchCache *bool `json:"fromPrefetchCache,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromEarlyHints *bool `json:"fromEarlyHints,omitempty"`
+	// Information about how ServiceWorker Static Router API was used. If this
+	// field is set with `matchedSourceType` field, a matching rule is found.
+	// If this field is set without `matchedSource`, no matching rule is found.
+	// Otherwise, the API is not used.
+	ServiceWorkerRouterInfo *ServiceWorkerRouterInfo `json:"serviceWorkerRouterInfo,omitempty"`
+	// Total number of bytes received for this request so far.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+	// Timing information for the given request.
+	Timing *ResourceTiming `json:"timing,omitempty"`
+	// Response source of response from ServiceWorker.
+	ServiceWorkerResponseSource ServiceWorkerResponseSource `json:"serviceWorkerResponseSource,omitempty"`
+	// The time at which the returned response was generated.
+	ResponseTime *TimeSinceEpoch `json:"responseTime,omitempty"`
+	// Cache Storage Cache Name.
+	CacheStorageCacheName string `json:"cacheStorageCacheName,omitempty"`
+	// Protocol used to fetch this request.
+	Protocol string `json:"protocol,omitempty"`
+	// The reason why Chrome uses a specific transport protocol for HTTP semantics.
+	AlternateProtocolUsage AlternateProtocolUsage `json:"alternateProtocolUsage,omitempty"`
+	// Security state of the request resource.
+	SecurityState cdp.SecurityState `json:"securityState"`
+	// Security details for the request.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+	// Indicates whether the request was sent through IP Protection proxies. If
+	// set to true, the request used the IP Protection privacy feature.
+	IsIPProtectionUsed *bool `json:"isIpProtectionUsed,omitempty"`
+}
+
+// WebSocketRequest webSocket request data.
+type WebSocketRequest struct {
+	// HTTP request headers.
+	Headers Headers `json:"headers"`
+}
+
+// WebSocketResponse webSocket response data.
+type WebSocketResponse struct {
+	// HTTP response status code.
+	Status int64 `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+	// HTTP response headers text.
+	HeadersText string `json:"headersText,omitempty"`
+	// HTTP request headers.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+	// HTTP request headers text.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+}
+
+// WebSocketFrame webSocket message data. This represents an entire WebSocket message, not just a fragmented frame as the name suggests.
+type WebSocketFrame struct {
+	// WebSocket message opcode.
+	Opcode float64 `json:"opcode"`
+	// WebSocket message mask.
+	Mask bool `json:"mask"`
+	// WebSocket message payload data.
+	// If the opcode is 1, this is a text message and payloadData is a UTF-8 string.
+	// If the opcode isn't 1, then payloadData is a base64 encoded string representing binary data.
+	PayloadData string `json:"payloadData"`
+}
+
+// CachedResource information about the cached resource.
+type CachedResource struct {
+	// Resource URL. This is the url of the original network request.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type ResourceType `json:"type"`
+	// Cached response data.
+	Response *Response `json:"response,omitempty"`
+	// Cached response body size.
+	BodySize float64 `json:"bodySize"`
+}
+
+// Initiator information about the request initiator.
+type Initiator struct {
+	// Type of this initiator.
+	//
+	// Values: parser, script, preload, SignedExchange, preflight, other.
+	Type string `json:"type"`
+	// Initiator JavaScript stack trace, set for Script only.
+	// Requires the Debugger domain to be enabled.
+	Stack *cdp.RuntimeStackTrace `json:"stack,omitempty"`
+	// Initiator URL, set for Parser type or for Script type (when script is importing module) or for SignedExchange type.
+	URL string `json:"url,omitempty"`
+	// Initiator line number, set for Parser type or for Script type (when script is importing
+	// module) (0-based).
+	LineNumber *float64 `json:"lineNumber,omitempty"`
+	// Initiator column number, set for Parser type or for Script type (when script is importing
+	// module) (0-based).
+	ColumnNumber *float64 `json:"columnNumber,omitempty"`
+	// Set if another request triggered this request (e.g. preflight).
+	RequestID RequestID `json:"requestId,omitempty"`
+}
+
+// CookiePartitionKey cookiePartitionKey object
+// The representation of the components of the key that are created by the cookiePartitionKey class contained in net/cookies/cookie_partition_key.h.
+//
+// Experimental.
+type CookiePartitionKey struct {
+	// The site of the top-level URL the browser was visiting at the start
+	// of the request to the endpoint that set the cookie.
+	TopLevelSite string `json:"topLevelSite"`
+	// Indicates if the cookie has any ancestors that are cross-site to the topLevelSite.
+	HasCrossSiteAncestor bool `json:"hasCrossSiteAncestor"`
+}
+
+// Cookie cookie object.
+type Cookie struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// Cookie domain.
+	Domain string `json:"domain"`
+	// Cookie path.
+	Path string `json:"path"`
+	// Cookie expiration date as the number of seconds since the UNIX epoch.
+	Expires float64 `json:"expires"`
+	// Cookie size.
+	Size int64 `json:"size"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly"`
+	// True if cookie is secure.
+	Secure bool `json:"secure"`
+	// True in case of session cookie.
+	Session bool `json:"session"`
+	// Cookie SameSite type.
+	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	// Cookie Priority.
+	Priority CookiePriority `json:"priority"`
+	// True if cookie is SameParty.
+	//
+	// Deprecated: deprecated in the protocol.
+	SameParty bool `json:"sameParty"`
+	// Cookie source scheme type.
+	SourceScheme CookieSourceScheme `json:"sourceScheme"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	// An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	// This is a temporary ability and it will be removed in the future.
+	SourcePort int64 `json:"sourcePort"`
+	// Cookie partition key.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+	// True if cookie partition key is opaque.
+	PartitionKeyOpaque *bool `json:"partitionKeyOpaque,omitempty"`
+}
+
+// SetCookieBlockedReason types of reasons why a cookie may not be stored from a response.
+//
+// Experimental.
+type SetCookieBlockedReason string
+
+const (
+	SetCookieBlockedReasonSecureOnly                               SetCookieBlockedReason = "SecureOnly"
+	SetCookieBlockedReasonSameSiteStrict                           SetCookieBlockedReason = "SameSiteStrict"
+	SetCookieBlockedReasonSameSiteLax                              SetCookieBlockedReason = "SameSiteLax"
+	SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          SetCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	SetCookieBlockedReasonSameSiteNoneInsecure                     SetCookieBlockedReason = "SameSiteNoneInsecure"
+	SetCookieBlockedReasonUserPreferences                          SetCookieBlockedReason = "UserPreferences"
+	SetCookieBlockedReasonThirdPartyPhaseout                       SetCookieBlockedReason = "ThirdPartyPhaseout"
+	SetCookieBlockedReasonThirdPartyBlockedInFirstPartySet         SetCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	SetCookieBlockedReasonSyntaxError                              SetCookieBlockedReason = "SyntaxError"
+	SetCookieBlockedReasonSchemeNotSupported                       SetCookieBlockedReason = "SchemeNotSupported"
+	SetCookieBlockedReasonOverwriteSecure                          SetCookieBlockedReason = "OverwriteSecure"
+	SetCookieBlockedReasonInvalidDomain                            SetCookieBlockedReason = "InvalidDomain"
+	SetCookieBlockedReasonInvalidPrefix                            SetCookieBlockedReason = "InvalidPrefix"
+	SetCookieBlockedReasonUnknownError                             SetCookieBlockedReason = "UnknownError"
+	SetCookieBlockedReasonSchemefulSameSiteStrict                  SetCookieBlockedReason = "SchemefulSameSiteStrict"
+	SetCookieBlockedReasonSchemefulSameSiteLax                     SetCookieBlockedReason = "SchemefulSameSiteLax"
+	SetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax SetCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	SetCookieBlockedReasonSamePartyFromCrossPartyContext           SetCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	SetCookieBlockedReasonSamePartyConflictsWithOtherAttributes    SetCookieBlockedReason = "SamePartyConflictsWithOtherAttributes"
+	SetCookieBlockedReasonNameValuePairExceedsMaxSize              SetCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	SetCookieBlockedReasonDisallowedCharacter                      SetCookieBlockedReason = "DisallowedCharacter"
+	SetCookieBlockedReasonNoCookieContent                          SetCookieBlockedReason = "NoCookieContent"
+)
+
+// CookieBlockedReason types of reasons why a cookie may not be sent with a request.
+//
+// Experimental.
+type CookieBlockedReason string
+
+const (
+	CookieBlockedReasonSecureOnly                               CookieBlockedReason = "SecureOnly"
+	CookieBlockedReasonNotOnPath                                CookieBlockedReason = "NotOnPath"
+	CookieBlockedReasonDomainMismatch                           CookieBlockedReason = "DomainMismatch"
+	CookieBlockedReasonSameSiteStrict                           CookieBlockedReason = "SameSiteStrict"
+	CookieBlockedReasonSameSiteLax                              CookieBlockedReason = "SameSiteLax"
+	CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          CookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	CookieBlockedReasonSameSiteNoneInsecure                     CookieBlockedReason = "SameSiteNoneInsecure"
+	CookieBlockedReasonUserPreferences                          CookieBlockedReason = "UserPreferences"
+	CookieBlockedReasonThirdPartyPhaseout                       CookieBlockedReason = "ThirdPartyPhaseout"
+	CookieBlockedReasonThirdPartyBlockedInFirstPartySet         CookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	CookieBlockedReasonUnknownError                             CookieBlockedReason = "UnknownError"
+	CookieBlockedReasonSchemefulSameSiteStrict                  CookieBlockedReason = "SchemefulSameSiteStrict"
+	CookieBlockedReasonSchemefulSameSiteLax                     CookieBlockedReason = "SchemefulSameSiteLax"
+	CookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax CookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	CookieBlockedReasonSamePartyFromCrossPartyContext           CookieBlockedReason = "SamePartyFromCrossPartyContext"
+	CookieBlockedReasonNameValuePairExceedsMaxSize              CookieBlockedReason = "NameValuePairExceedsMaxSize"
+	CookieBlockedReasonPortMismatch                             CookieBlockedReason = "PortMismatch"
+	CookieBlockedReasonSchemeMismatch                           CookieBlockedReason = "SchemeMismatch"
+	CookieBlockedReasonAnonymousContext                         CookieBlockedReason = "AnonymousContext"
+)
+
+// CookieExemptionReason types of reasons why a cookie should have been blocked by 3PCD but is exempted for the request.
+//
+// Experimental.
+type CookieExemptionReason string
+
+const (
+	CookieExemptionReasonNone                         CookieExemptionReason = "None"
+	CookieExemptionReasonUserSetting                  CookieExemptionReason = "UserSetting"
+	CookieExemptionReasonTPCDMetadata                 CookieExemptionReason = "TPCDMetadata"
+	CookieExemptionReasonTPCDDeprecationTrial         CookieExemptionReason = "TPCDDeprecationTrial"
+	CookieExemptionReasonTopLevelTPCDDeprecationTrial CookieExemptionReason = "TopLevelTPCDDeprecationTrial"
+	CookieExemptionReasonTPCDHeuristics               CookieExemptionReason = "TPCDHeuristics"
+	CookieExemptionReasonEnterprisePolicy             CookieExemptionReason = "EnterprisePolicy"
+	CookieExemptionReasonStorageAccess                CookieExemptionReason = "StorageAccess"
+	CookieExemptionReasonTopLevelStorageAccess        CookieExemptionReason = "TopLevelStorageAccess"
+	CookieExemptionReasonScheme                       CookieExemptionReason = "Scheme"
+	CookieExemptionReasonSameSiteNoneCookiesInSandbox CookieExemptionReason = "SameSiteNoneCookiesInSandbox"
+)
+
+// BlockedSetCookieWithReason a cookie which was not stored from a response with the corresponding reason.
+//
+// Experimental.
+type BlockedSetCookieWithReason struct {
+	// The reason(s) this cookie was blocked.
+	BlockedReasons []SetCookieBlockedReason `json:"blockedReasons"`
+	// The string representing this individual cookie as it would appear in the header.
+	// This is not the entire "cookie" or "set-cookie" header which could have multiple cookies.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object which represents the cookie which was not stored. It is optional because
+	// sometimes complete cookie information is not available, such as in the case of parsing
+	// errors.
+	Cookie *Cookie `json:"cookie,omitempty"`
+}
+
+// ExemptedSetCookieWithReason a cookie should have been blocked by 3PCD but is exempted and stored from a response with the
+// corresponding reason. A cookie could only have at most one exemption reason.
+//
+// Experimental.
+type ExemptedSetCookieWithReason struct {
+	// The reason the cookie was exempted.
+	ExemptionReason CookieExemptionReason `json:"exemptionReason"`
+	// The string representing this individual cookie as it would appear in the header.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object representing the cookie.
+	Cookie Cookie `json:"cookie"`
+}
+
+// AssociatedCookie a cookie associated with the request which may or may not be sent with it.
+// Includes the cookies itself and reasons for blocking or exemption.
+//
+// Experimental.
+type AssociatedCookie struct {
+	// The cookie object representing the cookie which was not sent.
+	Cookie Cookie `json:"cookie"`
+	// The reason(s) the cookie was blocked. If empty means the cookie is included.
+	BlockedReasons []CookieBlockedReason `json:"blockedReasons"`
+	// The reason the cookie should have been blocked by 3PCD but is exempted. A cookie could
+	// only have at most one exemption reason.
+	ExemptionReason CookieExemptionReason `json:"exemptionReason,omitempty"`
+}
+
+// CookieParam cookie parameter object.
+type CookieParam struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can affect the
+	// default domain, path, source port, and source scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure *bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly *bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set.
+	Expires *TimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority.
+	Priority CookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	SameParty *bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	SourceScheme CookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	// An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	// This is a temporary ability and it will be removed in the future.
+	SourcePort *int64 `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// AuthChallenge authorization challenge for HTTP status code 401 or 407.
+//
+// Experimental.
+type AuthChallenge struct {
+	// Source of the authentication challenge.
+	//
+	// Values: Server, Proxy.
+	Source string `json:"source,omitempty"`
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+	// The authentication scheme used, such as basic or digest.
+	Scheme string `json:"scheme"`
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+// AuthChallengeResponse response to an AuthChallenge.
+//
+// Experimental.
+type AuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge.  Default means
+	// deferring to the default behavior of the net stack, which will likely either the Cancel
+	// authentication or display a popup dialog box.
+	//
+	// Values: Default, CancelAuth, ProvideCredentials.
+	Response string `json:"response"`
+	// The username to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Username string `json:"username,omitempty"`
+	// The password to provide, possibly empty. Should only be set if response is
+	// ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
+
+// InterceptionStage stages of the interception to begin intercepting. Request will intercept before the request is
+// sent. Response will intercept after the response is received.
+//
+// Experimental.
+type InterceptionStage string
+
+const (
+	InterceptionStageRequest         InterceptionStage = "Request"
+	InterceptionStageHeadersReceived InterceptionStage = "HeadersReceived"
+)
+
+// RequestPattern request pattern for interception.
+//
+// Experimental.
+type RequestPattern struct {
+	// Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is
+	// backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+	// If set, only requests for matching resource types will be intercepted.
+	ResourceType ResourceType `json:"resourceType,omitempty"`
+	// Stage at which to begin intercepting requests. Default is Request.
+	InterceptionStage InterceptionStage `json:"interceptionStage,omitempty"`
+}
+
+// SignedExchangeSignature information about a signed exchange signature.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1.
+//
+// Experimental.
+type SignedExchangeSignature struct {
+	// Signed exchange signature label.
+	Label string `json:"label"`
+	// The hex string of signed exchange signature.
+	Signature string `json:"signature"`
+	// Signed exchange signature integrity.
+	Integrity string `json:"integrity"`
+	// Signed exchange signature cert Url.
+	CertURL string `json:"certUrl,omitempty"`
+	// The hex string of signed exchange signature cert sha256.
+	CertSha256 string `json:"certSha256,omitempty"`
+	// Signed exchange signature validity Url.
+	ValidityURL string `json:"validityUrl"`
+	// Signed exchange signature date.
+	Date int64 `json:"date"`
+	// Signed exchange signature expires.
+	Expires int64 `json:"expires"`
+	// The encoded certificates.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// SignedExchangeHeader information about a signed exchange header.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation.
+//
+// Experimental.
+type SignedExchangeHeader struct {
+	// Signed exchange request URL.
+	RequestURL string `json:"requestUrl"`
+	// Signed exchange response code.
+	ResponseCode int64 `json:"responseCode"`
+	// Signed exchange response headers.
+	ResponseHeaders Headers `json:"responseHeaders"`
+	// Signed exchange response signature.
+	Signatures []SignedExchangeSignature `json:"signatures"`
+	// Signed exchange header integrity hash in the form of `sha256-<base64-hash-value>`.
+	HeaderIntegrity string `json:"headerIntegrity"`
+}
+
+// SignedExchangeErrorField field type for a signed exchange related error.
+//
+// Experimental.
+type SignedExchangeErrorField string
+
+const (
+	SignedExchangeErrorFieldSignatureSig         SignedExchangeErrorField = "signatureSig"
+	SignedExchangeErrorFieldSignatureIntegrity   SignedExchangeErrorField = "signatureIntegrity"
+	SignedExchangeErrorFieldSignatureCertURL     SignedExchangeErrorField = "signatureCertUrl"
+	SignedExchangeErrorFieldSignatureCertSha256  SignedExchangeErrorField = "signatureCertSha256"
+	SignedExchangeErrorFieldSignatureValidityURL SignedExchangeErrorField = "signatureValidityUrl"
+	SignedExchangeErrorFieldSignatureTimestamps  SignedExchangeErrorField = "signatureTimestamps"
+)
+
+// SignedExchangeError information about a signed exchange response.
+//
+// Experimental.
+type SignedExchangeError struct {
+	// Error message.
+	Message string `json:"message"`
+	// The index of the signature which caused the error.
+	SignatureIndex *int64 `json:"signatureIndex,omitempty"`
+	// The field which caused the error.
+	ErrorField SignedExchangeErrorField `json:"errorField,omitempty"`
+}
+
+// SignedExchangeInfo information about a signed exchange response.
+//
+// Experimental.
+type SignedExchangeInfo struct {
+	// The outer response of signed HTTP exchange which was received from network.
+	OuterResponse Response `json:"outerResponse"`
+	// Whether network response for the signed exchange was accompanied by
+	// extra headers.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	// Information about the signed exchange header.
+	Header *SignedExchangeHeader `json:"header,omitempty"`
+	// Security details for the signed exchange header.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+	// Errors occurred while handling the signed exchange.
+	Errors []SignedExchangeError `json:"errors,omitempty"`
+}
+
+// ContentEncoding list of content encodings supported by the backend.
+//
+// Experimental.
+type ContentEncoding string
+
+const (
+	ContentEncodingDeflate ContentEncoding = "deflate"
+	ContentEncodingGzip    ContentEncoding = "gzip"
+	ContentEncodingBr      ContentEncoding = "br"
+	ContentEncodingZstd    ContentEncoding = "zstd"
+)
+
+// DirectSocketDNSQueryType is the Network.DirectSocketDnsQueryType type.
+//
+// Experimental.
+type DirectSocketDNSQueryType string
+
+const (
+	DirectSocketDNSQueryTypeIpv4 DirectSocketDNSQueryType = "ipv4"
+	DirectSocketDNSQueryTypeIpv6 DirectSocketDNSQueryType = "ipv6"
+)
+
+// DirectTCPSocketOptions is the Network.DirectTCPSocketOptions type.
+//
+// Experimental.
+type DirectTCPSocketOptions struct {
+	// TCP_NODELAY option.
+	NoDelay bool `json:"noDelay"`
+	// Expected to be unsigned integer.
+	KeepAliveDelay *float64 `json:"keepAliveDelay,omitempty"`
+	// Expected to be unsigned integer.
+	SendBufferSize *float64 `json:"sendBufferSize,omitempty"`
+	// Expected to be unsigned integer.
+	ReceiveBufferSize *float64                 `json:"receiveBufferSize,omitempty"`
+	DNSQueryType      DirectSocketDNSQueryType `json:"dnsQueryType,omitempty"`
+}
+
+// DirectUDPSocketOptions is the Network.DirectUDPSocketOptions type.
+//
+// Experimental.
+type DirectUDPSocketOptions struct {
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Unsigned int 16.
+	RemotePort *int64 `json:"remotePort,omitempty"`
+	LocalAddr  string `json:"localAddr,omitempty"`
+	// Unsigned int 16.
+	LocalPort    *int64                   `json:"localPort,omitempty"`
+	DNSQueryType DirectSocketDNSQueryType `json:"dnsQueryType,omitempty"`
+	// Expected to be unsigned integer.
+	SendBufferSize *float64 `json:"sendBufferSize,omitempty"`
+	// Expected to be unsigned integer.
+	ReceiveBufferSize *float64 `json:"receiveBufferSize,omitempty"`
+}
+
+// DirectUDPMessage is the Network.DirectUDPMessage type.
+//
+// Experimental.
+type DirectUDPMessage struct {
+	Data string `json:"data"`
+	// Null for connected mode.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Null for connected mode.
+	// Expected to be unsigned integer.
+	RemotePort *int64 `json:"remotePort,omitempty"`
+}
+
+// PrivateNetworkRequestPolicy is the Network.PrivateNetworkRequestPolicy type.
+//
+// Experimental.
+type PrivateNetworkRequestPolicy string
+
+const (
+	PrivateNetworkRequestPolicyAllow                          PrivateNetworkRequestPolicy = "Allow"
+	PrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate PrivateNetworkRequestPolicy = "BlockFromInsecureToMorePrivate"
+	PrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate  PrivateNetworkRequestPolicy = "WarnFromInsecureToMorePrivate"
+	PrivateNetworkRequestPolicyPreflightBlock                 PrivateNetworkRequestPolicy = "PreflightBlock"
+	PrivateNetworkRequestPolicyPreflightWarn                  PrivateNetworkRequestPolicy = "PreflightWarn"
+	PrivateNetworkRequestPolicyPermissionBlock                PrivateNetworkRequestPolicy = "PermissionBlock"
+	PrivateNetworkRequestPolicyPermissionWarn                 PrivateNetworkRequestPolicy = "PermissionWarn"
+)
+
+// IPAddressSpace is the Network.IPAddressSpace type.
+//
+// Experimental.
+type IPAddressSpace string
+
+const (
+	IPAddressSpaceLoopback IPAddressSpace = "Loopback"
+	IPAddressSpaceLocal    IPAddressSpace = "Local"
+	IPAddressSpacePublic   IPAddressSpace = "Public"
+	IPAddressSpaceUnknown  IPAddressSpace = "Unknown"
+)
+
+// ConnectTiming is the Network.ConnectTiming type.
+//
+// Experimental.
+type ConnectTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are ticks in
+	// milliseconds relatively to this requestTime. Matches ResourceTiming's requestTime for
+	// the same request (but not for redirected requests).
+	RequestTime float64 `json:"requestTime"`
+}
+
+// ClientSecurityState is the Network.ClientSecurityState type.
+//
+// Experimental.
+type ClientSecurityState struct {
+	InitiatorIsSecureContext    bool                        `json:"initiatorIsSecureContext"`
+	InitiatorIPAddressSpace     IPAddressSpace              `json:"initiatorIPAddressSpace"`
+	PrivateNetworkRequestPolicy PrivateNetworkRequestPolicy `json:"privateNetworkRequestPolicy"`
+}
+
+// CrossOriginOpenerPolicyValue is the Network.CrossOriginOpenerPolicyValue type.
+//
+// Experimental.
+type CrossOriginOpenerPolicyValue string
+
+const (
+	CrossOriginOpenerPolicyValueSameOrigin                 CrossOriginOpenerPolicyValue = "SameOrigin"
+	CrossOriginOpenerPolicyValueSameOriginAllowPopups      CrossOriginOpenerPolicyValue = "SameOriginAllowPopups"
+	CrossOriginOpenerPolicyValueRestrictProperties         CrossOriginOpenerPolicyValue = "RestrictProperties"
+	CrossOriginOpenerPolicyValueUnsafeNone                 CrossOriginOpenerPolicyValue = "UnsafeNone"
+	CrossOriginOpenerPolicyValueSameOriginPlusCoep         CrossOriginOpenerPolicyValue = "SameOriginPlusCoep"
+	CrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep CrossOriginOpenerPolicyValue = "RestrictPropertiesPlusCoep"
+	CrossOriginOpenerPolicyValueNoopenerAllowPopups        CrossOriginOpenerPolicyValue = "NoopenerAllowPopups"
+)
+
+// CrossOriginOpenerPolicyStatus is the Network.CrossOriginOpenerPolicyStatus type.
+//
+// Experimental.
+type CrossOriginOpenerPolicyStatus struct {
+	Value                       CrossOriginOpenerPolicyValue `json:"value"`
+	ReportOnlyValue             CrossOriginOpenerPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                       `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                       `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// CrossOriginEmbedderPolicyValue is the Network.CrossOriginEmbedderPolicyValue type.
+//
+// Experimental.
+type CrossOriginEmbedderPolicyValue string
+
+const (
+	CrossOriginEmbedderPolicyValueNone           CrossOriginEmbedderPolicyValue = "None"
+	CrossOriginEmbedderPolicyValueCredentialless CrossOriginEmbedderPolicyValue = "Credentialless"
+	CrossOriginEmbedderPolicyValueRequireCorp    CrossOriginEmbedderPolicyValue = "RequireCorp"
+)
+
+// CrossOriginEmbedderPolicyStatus is the Network.CrossOriginEmbedderPolicyStatus type.
+//
+// Experimental.
+type CrossOriginEmbedderPolicyStatus struct {
+	Value                       CrossOriginEmbedderPolicyValue `json:"value"`
+	ReportOnlyValue             CrossOriginEmbedderPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                         `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                         `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// ContentSecurityPolicySource is the Network.ContentSecurityPolicySource type.
+//
+// Experimental.
+type ContentSecurityPolicySource string
+
+const (
+	ContentSecurityPolicySourceHTTP ContentSecurityPolicySource = "HTTP"
+	ContentSecurityPolicySourceMeta ContentSecurityPolicySource = "Meta"
+)
+
+// ContentSecurityPolicyStatus is the Network.ContentSecurityPolicyStatus type.
+//
+// Experimental.
+type ContentSecurityPolicyStatus struct {
+	EffectiveDirectives string                      `json:"effectiveDirectives"`
+	IsEnforced          bool                        `json:"isEnforced"`
+	Source              ContentSecurityPolicySource `json:"source"`
+}
+
+// SecurityIsolationStatus is the Network.SecurityIsolationStatus type.
+//
+// Experimental.
+type SecurityIsolationStatus struct {
+	Coop *CrossOriginOpenerPolicyStatus   `json:"coop,omitempty"`
+	Coep *CrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+	CSP  []ContentSecurityPolicyStatus    `json:"csp,omitempty"`
+}
+
+// ReportStatus the status of a Reporting API report.
+//
+// Experimental.
+type ReportStatus string
+
+const (
+	ReportStatusQueued           ReportStatus = "Queued"
+	ReportStatusPending          ReportStatus = "Pending"
+	ReportStatusMarkedForRemoval ReportStatus = "MarkedForRemoval"
+	ReportStatusSuccess          ReportStatus = "Success"
+)
+
+// ReportID is the Network.ReportId type.
+//
+// Experimental.
+type ReportID string
+
+// ReportingAPIReport an object representing a report generated by the Reporting API.
+//
+// Experimental.
+type ReportingAPIReport struct {
+	ID ReportID `json:"id"`
+	// The URL of the document that triggered the report.
+	InitiatorURL string `json:"initiatorUrl"`
+	// The name of the endpoint group that should be used to deliver the report.
+	Destination string `json:"destination"`
+	// The type of the report (specifies the set of data that is contained in the report body).
+	Type string `json:"type"`
+	// When the report was generated.
+	Timestamp TimeSinceEpoch `json:"timestamp"`
+	// How many uploads deep the related request was.
+	Depth int64 `json:"depth"`
+	// The number of delivery attempts made so far, not including an active attempt.
+	CompletedAttempts int64                  `json:"completedAttempts"`
+	Body              map[string]interface{} `json:"body"`
+	Status            ReportStatus           `json:"status"`
+}
+
+// ReportingAPIEndpoint is the Network.ReportingApiEndpoint type.
+//
+// Experimental.
+type ReportingAPIEndpoint struct {
+	// The URL of the endpoint to which reports may be delivered.
+	URL string `json:"url"`
+	// Name of the endpoint group.
+	GroupName string `json:"groupName"`
+}
+
+// LoadNetworkResourcePageResult an object providing the result of a network resource load.
+//
+// Experimental.
+type LoadNetworkResourcePageResult struct {
+	Success bool `json:"success"`
+	// Optional values used for error reporting.
+	NetError       *float64 `json:"netError,omitempty"`
+	NetErrorName   string   `json:"netErrorName,omitempty"`
+	HTTPStatusCode *float64 `json:"httpStatusCode,omitempty"`
+	// If successful, one of the following two fields holds the result.
+	Stream cdp.IOStreamHandle `json:"stream,omitempty"`
+	// Response headers.
+	Headers Headers `json:"headers,omitempty"`
+}
+
+// LoadNetworkResourceOptions an options object that may be extended later to better support CORS,
+// CORB and streaming.
+//
+// Experimental.
+type LoadNetworkResourceOptions struct {
+	DisableCache       bool `json:"disableCache"`
+	IncludeCredentials bool `json:"includeCredentials"`
+}
+
+// SetAcceptedEncodingsParams are the parameters of SetAcceptedEncodings.
+type SetAcceptedEncodingsParams struct {
+	// List of accepted content encodings.
+	Encodings []ContentEncoding `json:"encodings"`
+}
+
+// SetAcceptedEncodings sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.
+//
+// Experimental.
+func SetAcceptedEncodings(ctx context.Context, session bidi.Transport, params SetAcceptedEncodingsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setAcceptedEncodings", params))
+
+	return err
+}
+
+// ClearAcceptedEncodingsOverride clears accepted encodings set by setAcceptedEncodings.
+//
+// Experimental.
+func ClearAcceptedEncodingsOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.clearAcceptedEncodingsOverride", nil))
+
+	return err
+}
+
+// CanClearBrowserCacheResult is the result of CanClearBrowserCache.
+type CanClearBrowserCacheResult struct {
+	// True if browser cache can be cleared.
+	Result bool `json:"result"`
+}
+
+// CanClearBrowserCache tells whether clearing browser cache is supported.
+//
+// Deprecated: deprecated in the protocol.
+func CanClearBrowserCache(ctx context.Context, session bidi.Transport) (*CanClearBrowserCacheResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CanClearBrowserCacheResult]("Network.canClearBrowserCache", nil))
+}
+
+// CanClearBrowserCookiesResult is the result of CanClearBrowserCookies.
+type CanClearBrowserCookiesResult struct {
+	// True if browser cookies can be cleared.
+	Result bool `json:"result"`
+}
+
+// CanClearBrowserCookies tells whether clearing browser cookies is supported.
+//
+// Deprecated: deprecated in the protocol.
+func CanClearBrowserCookies(ctx context.Context, session bidi.Transport) (*CanClearBrowserCookiesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CanClearBrowserCookiesResult]("Network.canClearBrowserCookies", nil))
+}
+
+// CanEmulateNetworkConditionsResult is the result of CanEmulateNetworkConditions.
+type CanEmulateNetworkConditionsResult struct {
+	// True if emulation of network conditions is supported.
+	Result bool `json:"result"`
+}
+
+// CanEmulateNetworkConditions tells whether emulation of network conditions is supported.
+//
+// Deprecated: deprecated in the protocol.
+func CanEmulateNetworkConditions(ctx context.Context, session bidi.Transport) (*CanEmulateNetworkConditionsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CanEmulateNetworkConditionsResult]("Network.canEmulateNetworkConditions", nil))
+}
+
+// ClearBrowserCache clears browser cache.
+func ClearBrowserCache(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.clearBrowserCache", nil))
+
+	return err
+}
+
+// ClearBrowserCookies clears browser cookies.
+func ClearBrowserCookies(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.clearBrowserCookies", nil))
+
+	return err
+}
+
+// ContinueInterceptedRequestParams are the parameters of ContinueInterceptedRequest.
+type ContinueInterceptedRequestParams struct {
+	InterceptionID InterceptionID `json:"interceptionId"`
+	// If set this causes the request to fail with the given reason. Passing `Aborted` for requests
+	// marked with `isNavigationRequest` also cancels the navigation. Must not be set in response
+	// to an authChallenge.
+	ErrorReason ErrorReason `json:"errorReason,omitempty"`
+	// If set the requests completes using with the provided base64 encoded raw response, including
+	// HTTP status line and headers etc... Must not be set in response to an authChallenge. (Encoded as a base64 string when passed over JSON).
+	RawResponse string `json:"rawResponse,omitempty"`
+	// If set the request url will be modified in a way that's not observable by page. Must not be
+	// set in response to an authChallenge.
+	URL string `json:"url,omitempty"`
+	// If set this allows the request method to be overridden. Must not be set in response to an
+	// authChallenge.
+	Method string `json:"method,omitempty"`
+	// If set this allows postData to be set. Must not be set in response to an authChallenge.
+	PostData string `json:"postData,omitempty"`
+	// If set this allows the request headers to be changed. Must not be set in response to an
+	// authChallenge.
+	Headers Headers `json:"headers,omitempty"`
+	// Response to a requestIntercepted with an authChallenge. Must not be set otherwise.
+	AuthChallengeResponse *AuthChallengeResponse `json:"authChallengeResponse,omitempty"`
+}
+
+// ContinueInterceptedRequest response to Network.requestIntercepted which either modifies the request to continue with any
+// modifications, or blocks it, or completes it with the provided response bytes. If a network
+// fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted
+// event will be sent with the same InterceptionId.
+// Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func ContinueInterceptedRequest(ctx context.Context, session bidi.Transport, params ContinueInterceptedRequestParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.continueInterceptedRequest", params))
+
+	return err
+}
+
+// DeleteCookiesParams are the parameters of DeleteCookies.
+type DeleteCookiesParams struct {
+	// Name of the cookies to remove.
+	Name string `json:"name"`
+	// If specified, deletes all the cookies with the given name where domain and path match
+	// provided URL.
+	URL string `json:"url,omitempty"`
+	// If specified, deletes only cookies with the exact domain.
+	Domain string `json:"domain,omitempty"`
+	// If specified, deletes only cookies with the exact path.
+	Path string `json:"path,omitempty"`
+	// If specified, deletes only cookies with the the given name and partitionKey where
+	// all partition key attributes match the cookie partition key attribute.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// DeleteCookies deletes browser cookies with matching name and url or domain/path/partitionKey pair.
+func DeleteCookies(ctx context.Context, session bidi.Transport, params DeleteCookiesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.deleteCookies", params))
+
+	return err
+}
+
+// Disable disables network tracking, prevents network events from being sent to the client.
+func Disable(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.disable", nil))
+
+	return err
+}
+
+// EmulateNetworkConditionsParams are the parameters of EmulateNetworkConditions.
+type EmulateNetworkConditionsParams struct {
+	// True to emulate internet disconnection.
+	Offline bool `json:"offline"`
+	// Minimum latency from request sent to response headers received (ms).
+	Latency float64 `json:"latency"`
+	// Maximal aggregated download throughput (bytes/sec). -1 disables download throttling.
+	DownloadThroughput float64 `json:"downloadThroughput"`
+	// Maximal aggregated upload throughput (bytes/sec).  -1 disables upload throttling.
+	UploadThroughput float64 `json:"uploadThroughput"`
+	// Connection type if known.
+	ConnectionType ConnectionType `json:"connectionType,omitempty"`
+	// WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100 drops all the packets.
+	PacketLoss *float64 `json:"packetLoss,omitempty"`
+	// WebRTC packet queue length (packet). 0 removes any queue length limitations.
+	PacketQueueLength *int64 `json:"packetQueueLength,omitempty"`
+	// WebRTC packetReordering feature.
+	PacketReordering *bool `json:"packetReordering,omitempty"`
+}
+
+// EmulateNetworkConditions activates emulation of network conditions.
+func EmulateNetworkConditions(ctx context.Context, session bidi.Transport, params EmulateNetworkConditionsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.emulateNetworkConditions", params))
+
+	return err
+}
+
+// EnableParams are the parameters of Enable.
+type EnableParams struct {
+	// Buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	MaxTotalBufferSize *int64 `json:"maxTotalBufferSize,omitempty"`
+	// Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	MaxResourceBufferSize *int64 `json:"maxResourceBufferSize,omitempty"`
+	// Longest post body size (in bytes) that would be included in requestWillBeSent notification.
+	MaxPostDataSize *int64 `json:"maxPostDataSize,omitempty"`
+	// Whether DirectSocket chunk send/receive events should be reported.
+	ReportDirectSocketTraffic *bool `json:"reportDirectSocketTraffic,omitempty"`
+}
+
+// Enable enables network tracking, network events will now be delivered to the client.
+func Enable(ctx context.Context, session bidi.Transport, params EnableParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.enable", params))
+
+	return err
+}
+
+// GetAllCookiesResult is the result of GetAllCookies.
+type GetAllCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []Cookie `json:"cookies"`
+}
+
+// GetAllCookies returns all browser cookies. Depending on the backend support, will return detailed cookie
+// information in the `cookies` field.
+// Deprecated. Use Storage.getCookies instead.
+//
+// Deprecated: deprecated in the protocol.
+func GetAllCookies(ctx context.Context, session bidi.Transport) (*GetAllCookiesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetAllCookiesResult]("Network.getAllCookies", nil))
+}
+
+// GetCertificateParams are the parameters of GetCertificate.
+type GetCertificateParams struct {
+	// Origin to get certificate for.
+	Origin string `json:"origin"`
+}
+
+// GetCertificateResult is the result of GetCertificate.
+type GetCertificateResult struct {
+	TableNames []string `json:"tableNames"`
+}
+
+// GetCertificate returns the DER-encoded certificate.
+//
+// Experimental.
+func GetCertificate(ctx context.Context, session bidi.Transport, params GetCertificateParams) (*GetCertificateResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetCertificateResult]("Network.getCertificate", params))
+}
+
+// GetCookiesParams are the parameters of GetCookies.
+type GetCookiesParams struct {
+	// The list of URLs for which applicable cookies will be fetched.
+	// If not specified, it's assumed to be set to the list containing
+	// the URLs of the page and all of its subframes.
+	Urls []string `json:"urls,omitempty"`
+}
+
+// GetCookiesResult is the result of GetCookies.
+type GetCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []Cookie `json:"cookies"`
+}
+
+// GetCookies returns all browser cookies for the current URL. Depending on the backend support, will return
+// detailed cookie information in the `cookies` field.
+func GetCookies(ctx context.Context, session bidi.Transport, params GetCookiesParams) (*GetCookiesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetCookiesResult]("Network.getCookies", params))
+}
+
+// GetResponseBodyParams are the parameters of GetResponseBody.
+type GetResponseBodyParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// GetResponseBodyResult is the result of GetResponseBody.
+type GetResponseBodyResult struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// GetResponseBody returns content served for the given request.
+func GetResponseBody(ctx context.Context, session bidi.Transport, params GetResponseBodyParams) (*GetResponseBodyResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetResponseBodyResult]("Network.getResponseBody", params))
+}
+
+// GetRequestPostDataParams are the parameters of GetRequestPostData.
+type GetRequestPostDataParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+// GetRequestPostDataResult is the result of GetRequestPostData.
+type GetRequestPostDataResult struct {
+	// Request body string, omitting files from multipart requests.
+	PostData string `json:"postData"`
+}
+
+// GetRequestPostData returns post data sent with the request. Returns an error when no data was sent with the request.
+func GetRequestPostData(ctx context.Context, session bidi.Transport, params GetRequestPostDataParams) (*GetRequestPostDataResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetRequestPostDataResult]("Network.getRequestPostData", params))
+}
+
+// GetResponseBodyForInterceptionParams are the parameters of GetResponseBodyForInterception.
+type GetResponseBodyForInterceptionParams struct {
+	// Identifier for the intercepted request to get body for.
+	InterceptionID InterceptionID `json:"interceptionId"`
+}
+
+// GetResponseBodyForInterceptionResult is the result of GetResponseBodyForInterception.
+type GetResponseBodyForInterceptionResult struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// GetResponseBodyForInterception returns content served for the given currently intercepted request.
+//
+// Experimental.
+func GetResponseBodyForInterception(ctx context.Context, session bidi.Transport, params GetResponseBodyForInterceptionParams) (*GetResponseBodyForInterceptionResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetResponseBodyForInterceptionResult]("Network.getResponseBodyForInterception", params))
+}
+
+// TakeResponseBodyForInterceptionAsStreamParams are the parameters of TakeResponseBodyForInterceptionAsStream.
+type TakeResponseBodyForInterceptionAsStreamParams struct {
+	InterceptionID InterceptionID `json:"interceptionId"`
+}
+
+// TakeResponseBodyForInterceptionAsStreamResult is the result of TakeResponseBodyForInterceptionAsStream.
+type TakeResponseBodyForInterceptionAsStreamResult struct {
+	Stream cdp.IOStreamHandle `json:"stream"`
+}
+
+// TakeResponseBodyForInterceptionAsStream returns a handle to the stream representing the response body. Note that after this command,
+// the intercepted request can't be continued as is -- you either need to cancel it or to provide
+// the response body. The stream only supports sequential read, IO.read will fail if the position
+// is specified.
+//
+// Experimental.
+func TakeResponseBodyForInterceptionAsStream(ctx context.Context, session bidi.Transport, params TakeResponseBodyForInterceptionAsStreamParams) (*TakeResponseBodyForInterceptionAsStreamResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[TakeResponseBodyForInterceptionAsStreamResult]("Network.takeResponseBodyForInterceptionAsStream", params))
+}
+
+// ReplayXHRParams are the parameters of ReplayXHR.
+type ReplayXHRParams struct {
+	// Identifier of XHR to replay.
+	RequestID RequestID `json:"requestId"`
+}
+
+// ReplayXHR this method sends a new XMLHttpRequest which is identical to the original one. The following
+// parameters should be identical: method, url, async, request body, extra headers, withCredentials
+// attribute, user, password.
+//
+// Experimental.
+func ReplayXHR(ctx context.Context, session bidi.Transport, params ReplayXHRParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.replayXHR", params))
+
+	return err
+}
+
+// SearchInResponseBodyParams are the parameters of SearchInResponseBody.
+type SearchInResponseBodyParams struct {
+	// Identifier of the network response to search.
+	RequestID RequestID `json:"requestId"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// SearchInResponseBodyResult is the result of SearchInResponseBody.
+type SearchInResponseBodyResult struct {
+	// List of search matches.
+	Result []cdp.DebuggerSearchMatch `json:"result"`
+}
+
+// SearchInResponseBody searches for given string in response content.
+//
+// Experimental.
+func SearchInResponseBody(ctx context.Context, session bidi.Transport, params SearchInResponseBodyParams) (*SearchInResponseBodyResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[SearchInResponseBodyResult]("Network.searchInResponseBody", params))
+}
+
+// SetBlockedURLsParams are the parameters of SetBlockedURLs.
+type SetBlockedURLsParams struct {
+	// URL patterns to block. Wildcards ('*') are allowed.
+	Urls []string `json:"urls"`
+}
+
+// SetBlockedURLs blocks URLs from loading.
+//
+// Experimental.
+func SetBlockedURLs(ctx context.Context, session bidi.Transport, params SetBlockedURLsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setBlockedURLs", params))
+
+	return err
+}
+
+// SetBypassServiceWorkerParams are the parameters of SetBypassServiceWorker.
+type SetBypassServiceWorkerParams struct {
+	// Bypass service worker and load from network.
+	Bypass bool `json:"bypass"`
+}
+
+// SetBypassServiceWorker toggles ignoring of service worker for each request.
+func SetBypassServiceWorker(ctx context.Context, session bidi.Transport, params SetBypassServiceWorkerParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setBypassServiceWorker", params))
+
+	return err
+}
+
+// SetCacheDisabledParams are the parameters of SetCacheDisabled.
+type SetCacheDisabledParams struct {
+	// Cache disabled state.
+	CacheDisabled bool `json:"cacheDisabled"`
+}
+
+// SetCacheDisabled toggles ignoring cache for each request. If `true`, cache will not be used.
+func SetCacheDisabled(ctx context.Context, session bidi.Transport, params SetCacheDisabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setCacheDisabled", params))
+
+	return err
+}
+
+// SetCookieParams are the parameters of SetCookie.
+type SetCookieParams struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can affect the
+	// default domain, path, source port, and source scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure *bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly *bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set.
+	Expires *TimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority type.
+	Priority CookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	SameParty *bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	SourceScheme CookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	// An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	// This is a temporary ability and it will be removed in the future.
+	SourcePort *int64 `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// SetCookieResult is the result of SetCookie.
+type SetCookieResult struct {
+	// Always set to true. If an error occurs, the response indicates protocol error.
+	//
+	// Deprecated: deprecated in the protocol.
+	Success bool `json:"success"`
+}
+
+// SetCookie sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.
+func SetCookie(ctx context.Context, session bidi.Transport, params SetCookieParams) (*SetCookieResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[SetCookieResult]("Network.setCookie", params))
+}
+
+// SetCookiesParams are the parameters of SetCookies.
+type SetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []CookieParam `json:"cookies"`
+}
+
+// SetCookies sets given cookies.
+func SetCookies(ctx context.Context, session bidi.Transport, params SetCookiesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setCookies", params))
+
+	return err
+}
+
+// SetExtraHTTPHeadersParams are the parameters of SetExtraHTTPHeaders.
+type SetExtraHTTPHeadersParams struct {
+	// Map with extra HTTP headers.
+	Headers Headers `json:"headers"`
+}
+
+// SetExtraHTTPHeaders specifies whether to always send extra HTTP headers with the requests from this page.
+func SetExtraHTTPHeaders(ctx context.Context, session bidi.Transport, params SetExtraHTTPHeadersParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setExtraHTTPHeaders", params))
+
+	return err
+}
+
+// SetAttachDebugStackParams are the parameters of SetAttachDebugStack.
+type SetAttachDebugStackParams struct {
+	// Whether to attach a page script stack for debugging purpose.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAttachDebugStack specifies whether to attach a page script stack id in requests.
+//
+// Experimental.
+func SetAttachDebugStack(ctx context.Context, session bidi.Transport, params SetAttachDebugStackParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setAttachDebugStack", params))
+
+	return err
+}
+
+// SetRequestInterceptionParams are the parameters of SetRequestInterception.
+type SetRequestInterceptionParams struct {
+	// Requests matching any of these patterns will be forwarded and wait for the corresponding
+	// continueInterceptedRequest call.
+	Patterns []RequestPattern `json:"patterns"`
+}
+
+// SetRequestInterception sets the requests to intercept that match the provided patterns and optionally resource types.
+// Deprecated, please use Fetch.enable instead.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetRequestInterception(ctx context.Context, session bidi.Transport, params SetRequestInterceptionParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setRequestInterception", params))
+
+	return err
+}
+
+// SetUserAgentOverrideParams are the parameters of SetUserAgentOverride.
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+	// Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+	// The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData.
+	UserAgentMetadata *cdp.EmulationUserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// SetUserAgentOverride allows overriding user agent with the given string.
+func SetUserAgentOverride(ctx context.Context, session bidi.Transport, params SetUserAgentOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setUserAgentOverride", params))
+
+	return err
+}
+
+// StreamResourceContentParams are the parameters of StreamResourceContent.
+type StreamResourceContentParams struct {
+	// Identifier of the request to stream.
+	RequestID RequestID `json:"requestId"`
+}
+
+// StreamResourceContentResult is the result of StreamResourceContent.
+type StreamResourceContentResult struct {
+	// Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON).
+	BufferedData string `json:"bufferedData"`
+}
+
+// StreamResourceContent enables streaming of the response for the given requestId.
+// If enabled, the dataReceived event contains the data that was received during streaming.
+//
+// Experimental.
+func StreamResourceContent(ctx context.Context, session bidi.Transport, params StreamResourceContentParams) (*StreamResourceContentResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[StreamResourceContentResult]("Network.streamResourceContent", params))
+}
+
+// GetSecurityIsolationStatusParams are the parameters of GetSecurityIsolationStatus.
+type GetSecurityIsolationStatusParams struct {
+	// If no frameId is provided, the status of the target is provided.
+	FrameID cdp.PageFrameID `json:"frameId,omitempty"`
+}
+
+// GetSecurityIsolationStatusResult is the result of GetSecurityIsolationStatus.
+type GetSecurityIsolationStatusResult struct {
+	Status SecurityIsolationStatus `json:"status"`
+}
+
+// GetSecurityIsolationStatus returns information about the COEP/COOP isolation status.
+//
+// Experimental.
+func GetSecurityIsolationStatus(ctx context.Context, session bidi.Transport, params GetSecurityIsolationStatusParams) (*GetSecurityIsolationStatusResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetSecurityIsolationStatusResult]("Network.getSecurityIsolationStatus", params))
+}
+
+// EnableReportingAPIParams are the parameters of EnableReportingAPI.
+type EnableReportingAPIParams struct {
+	// Whether to enable or disable events for the Reporting API.
+	Enable bool `json:"enable"`
+}
+
+// EnableReportingAPI enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client.
+// Enabling triggers 'reportingApiReportAdded' for all existing reports.
+//
+// Experimental.
+func EnableReportingAPI(ctx context.Context, session bidi.Transport, params EnableReportingAPIParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.enableReportingApi", params))
+
+	return err
+}
+
+// LoadNetworkResourceParams are the parameters of LoadNetworkResource.
+type LoadNetworkResourceParams struct {
+	// Frame id to get the resource for. Mandatory for frame targets, and
+	// should be omitted for worker targets.
+	FrameID cdp.PageFrameID `json:"frameId,omitempty"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+	// Options for the request.
+	Options LoadNetworkResourceOptions `json:"options"`
+}
+
+// LoadNetworkResourceResult is the result of LoadNetworkResource.
+type LoadNetworkResourceResult struct {
+	Resource LoadNetworkResourcePageResult `json:"resource"`
+}
+
+// LoadNetworkResource fetches the resource and returns the content.
+//
+// Experimental.
+func LoadNetworkResource(ctx context.Context, session bidi.Transport, params LoadNetworkResourceParams) (*LoadNetworkResourceResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[LoadNetworkResourceResult]("Network.loadNetworkResource", params))
+}
+
+// SetCookieControlsParams are the parameters of SetCookieControls.
+type SetCookieControlsParams struct {
+	// Whether 3pc restriction is enabled.
+	EnableThirdPartyCookieRestriction bool `json:"enableThirdPartyCookieRestriction"`
+	// Whether 3pc grace period exception should be enabled; false by default.
+	DisableThirdPartyCookieMetadata bool `json:"disableThirdPartyCookieMetadata"`
+	// Whether 3pc heuristics exceptions should be enabled; false by default.
+	DisableThirdPartyCookieHeuristics bool `json:"disableThirdPartyCookieHeuristics"`
+}
+
+// SetCookieControls sets Controls for third-party cookie access
+// Page reload is required before the new cookie behavior will be observed.
+//
+// Experimental.
+func SetCookieControls(ctx context.Context, session bidi.Transport, params SetCookieControlsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Network.setCookieControls", params))
+
+	return err
+}
+
+// DataReceivedEvent is sent on Network.dataReceived.
+//
+// Fired when data chunk was received over the network.
+type DataReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Data chunk length.
+	DataLength int64 `json:"dataLength"`
+	// Actual bytes received (might be less than dataLength for compressed encodings).
+	EncodedDataLength int64 `json:"encodedDataLength"`
+	// Data that was received. (Encoded as a base64 string when passed over JSON).
+	Data string `json:"data,omitempty"`
+}
+
+// EventDataReceived is the method of DataReceivedEvent.
+const EventDataReceived = "Network.dataReceived"
+
+// OnDataReceived calls handler for every DataReceivedEvent.
+//
+// The returned function removes the handler.
+func OnDataReceived(source cdp.EventSource, handler func(*DataReceivedEvent)) func() {
+	return cdp.On(source, EventDataReceived, handler)
+}
+
+// EventSourceMessageReceivedEvent is sent on Network.eventSourceMessageReceived.
+//
+// Fired when EventSource message is received.
+type EventSourceMessageReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Message type.
+	EventName string `json:"eventName"`
+	// Message identifier.
+	EventID string `json:"eventId"`
+	// Message content.
+	Data string `json:"data"`
+}
+
+// EventEventSourceMessageReceived is the method of EventSourceMessageReceivedEvent.
+const EventEventSourceMessageReceived = "Network.eventSourceMessageReceived"
+
+// OnEventSourceMessageReceived calls handler for every EventSourceMessageReceivedEvent.
+//
+// The returned function removes the handler.
+func OnEventSourceMessageReceived(source cdp.EventSource, handler func(*EventSourceMessageReceivedEvent)) func() {
+	return cdp.On(source, EventEventSourceMessageReceived, handler)
+}
+
+// LoadingFailedEvent is sent on Network.loadingFailed.
+//
+// Fired when HTTP request has failed to load.
+type LoadingFailedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type ResourceType `json:"type"`
+	// Error message. List of network errors: https://cs.chromium.org/chromium/src/net/base/net_error_list.h.
+	ErrorText string `json:"errorText"`
+	// True if loading was canceled.
+	Canceled *bool `json:"canceled,omitempty"`
+	// The reason why loading was blocked, if any.
+	BlockedReason BlockedReason `json:"blockedReason,omitempty"`
+	// The reason why loading was blocked by CORS, if any.
+	CORSErrorStatus *CORSErrorStatus `json:"corsErrorStatus,omitempty"`
+}
+
+// EventLoadingFailed is the method of LoadingFailedEvent.
+const EventLoadingFailed = "Network.loadingFailed"
+
+// OnLoadingFailed calls handler for every LoadingFailedEvent.
+//
+// The returned function removes the handler.
+func OnLoadingFailed(source cdp.EventSource, handler func(*LoadingFailedEvent)) func() {
+	return cdp.On(source, EventLoadingFailed, handler)
+}
+
+// LoadingFinishedEvent is sent on Network.loadingFinished.
+//
+// Fired when HTTP request has finished loading.
+type LoadingFinishedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Total number of bytes received for this request.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+}
+
+// EventLoadingFinished is the method of LoadingFinishedEvent.
+const EventLoadingFinished = "Network.loadingFinished"
+
+// OnLoadingFinished calls handler for every LoadingFinishedEvent.
+//
+// The returned function removes the handler.
+func OnLoadingFinished(source cdp.EventSource, handler func(*LoadingFinishedEvent)) func() {
+	return cdp.On(source, EventLoadingFinished, handler)
+}
+
+// RequestInterceptedEvent is sent on Network.requestIntercepted.
+//
+// Details of an intercepted HTTP request, which must be either allowed, blocked, modified or
+// mocked.
+// Deprecated, use Fetch.requestPaused instead.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+type RequestInterceptedEvent struct {
+	// Each request the page makes will have a unique id, however if any redirects are encountered
+	// while processing that fetch, they will be reported with the same id as the original fetch.
+	// Likewise if HTTP authentication is needed then the same fetch id will be used.
+	InterceptionID InterceptionID `json:"interceptionId"`
+	Request        Request        `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID cdp.PageFrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType ResourceType `json:"resourceType"`
+	// Whether this is a navigation request, which can abort the navigation completely.
+	IsNavigationRequest bool `json:"isNavigationRequest"`
+	// Set if the request is a navigation that will result in a download.
+	// Only present after response is received from the server (i.e. HeadersReceived stage).
+	IsDownload *bool `json:"isDownload,omitempty"`
+	// Redirect location, only sent if a redirect was intercepted.
+	RedirectURL string `json:"redirectUrl,omitempty"`
+	// Details of the Authorization Challenge encountered. If this is set then
+	// continueInterceptedRequest must contain an authChallengeResponse.
+	AuthChallenge *AuthChallenge `json:"authChallenge,omitempty"`
+	// Response error if intercepted at response stage or if redirect occurred while intercepting
+	// request.
+	ResponseErrorReason ErrorReason `json:"responseErrorReason,omitempty"`
+	// Response code if intercepted at response stage or if redirect occurred while intercepting
+	// request or auth retry occurred.
+	ResponseStatusCode *int64 `json:"responseStatusCode,omitempty"`
+	// Response headers if intercepted at the response stage or if redirect occurred while
+	// intercepting request or auth retry occurred.
+	ResponseHeaders Headers `json:"responseHeaders,omitempty"`
+	// If the intercepted request had a corresponding requestWillBeSent event fired for it, then
+	// this requestId will be the same as the requestId present in the requestWillBeSent event.
+	RequestID RequestID `json:"requestId,omitempty"`
+}
+
+// EventRequestIntercepted is the method of RequestInterceptedEvent.
+const EventRequestIntercepted = "Network.requestIntercepted"
+
+// OnRequestIntercepted calls handler for every RequestInterceptedEvent.
+//
+// The returned function removes the handler.
+func OnRequestIntercepted(source cdp.EventSource, handler func(*RequestInterceptedEvent)) func() {
+	return cdp.On(source, EventRequestIntercepted, handler)
+}
+
+// RequestServedFromCacheEvent is sent on Network.requestServedFromCache.
+//
+// Fired if request ended up loading from cache.
+type RequestServedFromCacheEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+}
+
+// EventRequestServedFromCache is the method of RequestServedFromCacheEvent.
+const EventRequestServedFromCache = "Network.requestServedFromCache"
+
+// OnRequestServedFromCache calls handler for every RequestServedFromCacheEvent.
+//
+// The returned function removes the handler.
+func OnRequestServedFromCache(source cdp.EventSource, handler func(*RequestServedFromCacheEvent)) func() {
+	return cdp.On(source, EventRequestServedFromCache, handler)
+}
+
+// RequestWillBeSentEvent is sent on Network.requestWillBeSent.
+//
+// Fired when page is about to send HTTP request.
+type RequestWillBeSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+	// URL of the document this request is loaded for.
+	DocumentURL string `json:"documentURL"`
+	// Request data.
+	Request Request `json:"request"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+	// Request initiator.
+	Initiator Initiator `json:"initiator"`
+	// In the case that redirectResponse is populated, this flag indicates whether
+	// requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted
+	// for the request which was just redirected.
+	RedirectHasExtraInfo bool `json:"redirectHasExtraInfo"`
+	// Redirect response data.
+	RedirectResponse *Response `json:"redirectResponse,omitempty"`
+	// Type of this resource.
+	Type ResourceType `json:"type,omitempty"`
+	// Frame identifier.
+	FrameID cdp.PageFrameID `json:"frameId,omitempty"`
+	// Whether the request is initiated by a user gesture. Defaults to false.
+	HasUserGesture *bool `json:"hasUserGesture,omitempty"`
+}
+
+// EventRequestWillBeSent is the method of RequestWillBeSentEvent.
+const EventRequestWillBeSent = "Network.requestWillBeSent"
+
+// OnRequestWillBeSent calls handler for every RequestWillBeSentEvent.
+//
+// The returned function removes the handler.
+func OnRequestWillBeSent(source cdp.EventSource, handler func(*RequestWillBeSentEvent)) func() {
+	return cdp.On(source, EventRequestWillBeSent, handler)
+}
+
+// ResourceChangedPriorityEvent is sent on Network.resourceChangedPriority.
+//
+// Fired when resource loading priority is changed.
+//
+// Experimental.
+type ResourceChangedPriorityEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// New priority.
+	NewPriority ResourcePriority `json:"newPriority"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventResourceChangedPriority is the method of ResourceChangedPriorityEvent.
+const EventResourceChangedPriority = "Network.resourceChangedPriority"
+
+// OnResourceChangedPriority calls handler for every ResourceChangedPriorityEvent.
+//
+// The returned function removes the handler.
+func OnResourceChangedPriority(source cdp.EventSource, handler func(*ResourceChangedPriorityEvent)) func() {
+	return cdp.On(source, EventResourceChangedPriority, handler)
+}
+
+// SignedExchangeReceivedEvent is sent on Network.signedExchangeReceived.
+//
+// Fired when a signed exchange was received over the network.
+//
+// Experimental.
+type SignedExchangeReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Information about the signed exchange response.
+	Info SignedExchangeInfo `json:"info"`
+}
+
+// EventSignedExchangeReceived is the method of SignedExchangeReceivedEvent.
+const EventSignedExchangeReceived = "Network.signedExchangeReceived"
+
+// OnSignedExchangeReceived calls handler for every SignedExchangeReceivedEvent.
+//
+// The returned function removes the handler.
+func OnSignedExchangeReceived(source cdp.EventSource, handler func(*SignedExchangeReceivedEvent)) func() {
+	return cdp.On(source, EventSignedExchangeReceived, handler)
+}
+
+// ResponseReceivedEvent is sent on Network.responseReceived.
+//
+// Fired when HTTP response is available.
+type ResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type ResourceType `json:"type"`
+	// Response data.
+	Response Response `json:"response"`
+	// Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be
+	// or were emitted for this request.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	// Frame identifier.
+	FrameID cdp.PageFrameID `json:"frameId,omitempty"`
+}
+
+// EventResponseReceived is the method of ResponseReceivedEvent.
+const EventResponseReceived = "Network.responseReceived"
+
+// OnResponseReceived calls handler for every ResponseReceivedEvent.
+//
+// The returned function removes the handler.
+func OnResponseReceived(source cdp.EventSource, handler func(*ResponseReceivedEvent)) func() {
+	return cdp.On(source, EventResponseReceived, handler)
+}
+
+// WebSocketClosedEvent is sent on Network.webSocketClosed.
+//
+// Fired when WebSocket is closed.
+type WebSocketClosedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventWebSocketClosed is the method of WebSocketClosedEvent.
+const EventWebSocketClosed = "Network.webSocketClosed"
+
+// OnWebSocketClosed calls handler for every WebSocketClosedEvent.
+//
+// The returned function removes the handler.
+func OnWebSocketClosed(source cdp.EventSource, handler func(*WebSocketClosedEvent)) func() {
+	return cdp.On(source, EventWebSocketClosed, handler)
+}
+
+// WebSocketCreatedEvent is sent on Network.webSocketCreated.
+//
+// Fired upon WebSocket creation.
+type WebSocketCreatedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// WebSocket request URL.
+	URL string `json:"url"`
+	// Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// EventWebSocketCreated is the method of WebSocketCreatedEvent.
+const EventWebSocketCreated = "Network.webSocketCreated"
+
+// OnWebSocketCreated calls handler for every WebSocketCreatedEvent.
+//
+// The returned function removes the handler.
+func OnWebSocketCreated(source cdp.EventSource, handler func(*WebSocketCreatedEvent)) func() {
+	return cdp.On(source, EventWebSocketCreated, handler)
+}
+
+// WebSocketFrameErrorEvent is sent on Network.webSocketFrameError.
+//
+// Fired when WebSocket message error occurs.
+type WebSocketFrameErrorEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket error message.
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// EventWebSocketFrameError is the method of WebSocketFrameErrorEvent.
+const EventWebSocketFrameError = "Network.webSocketFrameError"
+
+// OnWebSocketFrameError calls handler for every WebSocketFrameErrorEvent.
+//
+// The returned function removes the handler.
+func OnWebSocketFrameError(source cdp.EventSource, handler func(*WebSocketFrameErrorEvent)) func() {
+	return cdp.On(source, EventWebSocketFrameError, handler)
+}
+
+// WebSocketFrameReceivedEvent is sent on Network.webSocketFrameReceived.
+//
+// Fired when WebSocket message is received.
+type WebSocketFrameReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketFrame `json:"response"`
+}
+
+// EventWebSocketFrameReceived is the method of WebSocketFrameReceivedEvent.
+const EventWebSocketFrameReceived = "Network.webSocketFrameReceived"
+
+// OnWebSocketFrameReceived calls handler for every WebSocketFrameReceivedEvent.
+//
+// The returned function removes the handler.
+func OnWebSocketFrameReceived(source cdp.EventSource, handler func(*WebSocketFrameReceivedEvent)) func() {
+	return cdp.On(source, EventWebSocketFrameReceived, handler)
+}
+
+// WebSocketFrameSentEvent is sent on Network.webSocketFrameSent.
+//
+// Fired when WebSocket message is sent.
+type WebSocketFrameSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketFrame `json:"response"`
+}
+
+// EventWebSocketFrameSent is the method of WebSocketFrameSentEvent.
+const EventWebSocketFrameSent = "Network.webSocketFrameSent"
+
+// OnWebSocketFrameSent calls handler for every WebSocketFrameSentEvent.
+//
+// The returned function removes the handler.
+func OnWebSocketFrameSent(source cdp.EventSource, handler func(*WebSocketFrameSentEvent)) func() {
+	return cdp.On(source, EventWebSocketFrameSent, handler)
+}
+
+// WebSocketHandshakeResponseReceivedEvent is sent on Network.webSocketHandshakeResponseReceived.
+//
+// Fired when WebSocket handshake response becomes available.
+type WebSocketHandshakeResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response WebSocketResponse `json:"response"`
+}
+
+// EventWebSocketHandshakeResponseReceived is the method of WebSocketHandshakeResponseReceivedEvent.
+const EventWebSocketHandshakeResponseReceived = "Network.webSocketHandshakeResponseReceived"
+
+// OnWebSocketHandshakeResponseReceived calls handler for every WebSocketHandshakeResponseReceivedEvent.
+//
+// The returned function removes the handler.
+func OnWebSocketHandshakeResponseReceived(source cdp.EventSource, handler func(*WebSocketHandshakeResponseReceivedEvent)) func() {
+	return cdp.On(source, EventWebSocketHandshakeResponseReceived, handler)
+}
+
+// WebSocketWillSendHandshakeRequestEvent is sent on Network.webSocketWillSendHandshakeRequest.
+//
+// Fired when WebSocket is about to initiate handshake.
+type WebSocketWillSendHandshakeRequestEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// UTC Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+	// WebSocket request data.
+	Request WebSocketRequest `json:"request"`
+}
+
+// EventWebSocketWillSendHandshakeRequest is the method of WebSocketWillSendHandshakeRequestEvent.
+const EventWebSocketWillSendHandshakeRequest = "Network.webSocketWillSendHandshakeRequest"
+
+// OnWebSocketWillSendHandshakeRequest calls handler for every WebSocketWillSendHandshakeRequestEvent.
+//
+// The returned function removes the handler.
+func OnWebSocketWillSendHandshakeRequest(source cdp.EventSource, handler func(*WebSocketWillSendHandshakeRequestEvent)) func() {
+	return cdp.On(source, EventWebSocketWillSendHandshakeRequest, handler)
+}
+
+// WebTransportCreatedEvent is sent on Network.webTransportCreated.
+//
+// Fired upon WebTransport creation.
+type WebTransportCreatedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// WebTransport request URL.
+	URL string `json:"url"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+	// Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// EventWebTransportCreated is the method of WebTransportCreatedEvent.
+const EventWebTransportCreated = "Network.webTransportCreated"
+
+// OnWebTransportCreated calls handler for every WebTransportCreatedEvent.
+//
+// The returned function removes the handler.
+func OnWebTransportCreated(source cdp.EventSource, handler func(*WebTransportCreatedEvent)) func() {
+	return cdp.On(source, EventWebTransportCreated, handler)
+}
+
+// WebTransportConnectionEstablishedEvent is sent on Network.webTransportConnectionEstablished.
+//
+// Fired when WebTransport handshake is finished.
+type WebTransportConnectionEstablishedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventWebTransportConnectionEstablished is the method of WebTransportConnectionEstablishedEvent.
+const EventWebTransportConnectionEstablished = "Network.webTransportConnectionEstablished"
+
+// OnWebTransportConnectionEstablished calls handler for every WebTransportConnectionEstablishedEvent.
+//
+// The returned function removes the handler.
+func OnWebTransportConnectionEstablished(source cdp.EventSource, handler func(*WebTransportConnectionEstablishedEvent)) func() {
+	return cdp.On(source, EventWebTransportConnectionEstablished, handler)
+}
+
+// WebTransportClosedEvent is sent on Network.webTransportClosed.
+//
+// Fired when WebTransport is disposed.
+type WebTransportClosedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// EventWebTransportClosed is the method of WebTransportClosedEvent.
+const EventWebTransportClosed = "Network.webTransportClosed"
+
+// OnWebTransportClosed calls handler for every WebTransportClosedEvent.
+//
+// The returned function removes the handler.
+func OnWebTransportClosed(source cdp.EventSource, handler func(*WebTransportClosedEvent)) func() {
+	return cdp.On(source, EventWebTransportClosed, handler)
+}
+
+// DirectTCPSocketCreatedEvent is sent on Network.directTCPSocketCreated.
+//
+// Fired upon direct_socket.TCPSocket creation.
+//
+// Experimental.
+type DirectTCPSocketCreatedEvent struct {
+	Identifier RequestID `json:"identifier"`
+	RemoteAddr string    `json:"remoteAddr"`
+	// Unsigned int 16.
+	RemotePort int64                  `json:"remotePort"`
+	Options    DirectTCPSocketOptions `json:"options"`
+	Timestamp  MonotonicTime          `json:"timestamp"`
+	Initiator  *Initiator             `json:"initiator,omitempty"`
+}
+
+// EventDirectTCPSocketCreated is the method of DirectTCPSocketCreatedEvent.
+const EventDirectTCPSocketCreated = "Network.directTCPSocketCreated"
+
+// OnDirectTCPSocketCreated calls handler for every DirectTCPSocketCreatedEvent.
+//
+// The returned function removes the handler.
+func OnDirectTCPSocketCreated(source cdp.EventSource, handler func(*DirectTCPSocketCreatedEvent)) func() {
+	return cdp.On(source, EventDirectTCPSocketCreated, handler)
+}
+
+// DirectTCPSocketOpenedEvent is sent on Network.directTCPSocketOpened.
+//
+// Fired when direct_socket.TCPSocket connection is opened.
+//
+// Experimental.
+type DirectTCPSocketOpenedEvent struct {
+	Identifier RequestID `json:"identifier"`
+	RemoteAddr string    `json:"remoteAddr"`
+	// Expected to be unsigned integer.
+	RemotePort int64         `json:"remotePort"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+	LocalAddr  string        `json:"localAddr,omitempty"`
+	// Expected to be unsigned integer.
+	LocalPort *int64 `json:"localPort,omitempty"`
+}
+
+// EventDirectTCPSocketOpened is the method of DirectTCPSocketOpenedEvent.
+const EventDirectTCPSocketOpened = "Network.directTCPSocketOpened"
+
+// OnDirectTCPSocketOpened calls handler for every DirectTCPSocketOpenedEvent.
+//
+// The returned function removes the handler.
+func OnDirectTCPSocketOpened(source cdp.EventSource, handler func(*DirectTCPSocketOpenedEvent)) func() {
+	return cdp.On(source, EventDirectTCPSocketOpened, handler)
+}
+
+// DirectTCPSocketAbortedEvent is sent on Network.directTCPSocketAborted.
+//
+// Fired when direct_socket.TCPSocket is aborted.
+//
+// Experimental.
+type DirectTCPSocketAbortedEvent struct {
+	Identifier   RequestID     `json:"identifier"`
+	ErrorMessage string        `json:"errorMessage"`
+	Timestamp    MonotonicTime `json:"timestamp"`
+}
+
+// EventDirectTCPSocketAborted is the method of DirectTCPSocketAbortedEvent.
+const EventDirectTCPSocketAborted = "Network.directTCPSocketAborted"
+
+// OnDirectTCPSocketAborted calls handler for every DirectTCPSocketAbortedEvent.
+//
+// The returned function removes the handler.
+func OnDirectTCPSocketAborted(source cdp.EventSource, handler func(*DirectTCPSocketAbortedEvent)) func() {
+	return cdp.On(source, EventDirectTCPSocketAborted, handler)
+}
+
+// DirectTCPSocketClosedEvent is sent on Network.directTCPSocketClosed.
+//
+// Fired when direct_socket.TCPSocket is closed.
+//
+// Experimental.
+type DirectTCPSocketClosedEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// EventDirectTCPSocketClosed is the method of DirectTCPSocketClosedEvent.
+const EventDirectTCPSocketClosed = "Network.directTCPSocketClosed"
+
+// OnDirectTCPSocketClosed calls handler for every DirectTCPSocketClosedEvent.
+//
+// The returned function removes the handler.
+func OnDirectTCPSocketClosed(source cdp.EventSource, handler func(*DirectTCPSocketClosedEvent)) func() {
+	return cdp.On(source, EventDirectTCPSocketClosed, handler)
+}
+
+// DirectTCPSocketChunkSentEvent is sent on Network.directTCPSocketChunkSent.
+//
+// Fired when data is sent to tcp direct socket stream.
+//
+// Experimental.
+type DirectTCPSocketChunkSentEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Data       string        `json:"data"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// EventDirectTCPSocketChunkSent is the method of DirectTCPSocketChunkSentEvent.
+const EventDirectTCPSocketChunkSent = "Network.directTCPSocketChunkSent"
+
+// OnDirectTCPSocketChunkSent calls handler for every DirectTCPSocketChunkSentEvent.
+//
+// The returned function removes the handler.
+func OnDirectTCPSocketChunkSent(source cdp.EventSource, handler func(*DirectTCPSocketChunkSentEvent)) func() {
+	return cdp.On(source, EventDirectTCPSocketChunkSent, handler)
+}
+
+// DirectTCPSocketChunkReceivedEvent is sent on Network.directTCPSocketChunkReceived.
+//
+// Fired when data is received from tcp direct socket stream.
+//
+// Experimental.
+type DirectTCPSocketChunkReceivedEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Data       string        `json:"data"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// EventDirectTCPSocketChunkReceived is the method of DirectTCPSocketChunkReceivedEvent.
+const EventDirectTCPSocketChunkReceived = "Network.directTCPSocketChunkReceived"
+
+// OnDirectTCPSocketChunkReceived calls handler for every DirectTCPSocketChunkReceivedEvent.
+//
+// The returned function removes the handler.
+func OnDirectTCPSocketChunkReceived(source cdp.EventSource, handler func(*DirectTCPSocketChunkReceivedEvent)) func() {
+	return cdp.On(source, EventDirectTCPSocketChunkReceived, handler)
+}
+
+// DirectUDPSocketCreatedEvent is sent on Network.directUDPSocketCreated.
+//
+// Fired upon direct_socket.UDPSocket creation.
+//
+// Experimental.
+type DirectUDPSocketCreatedEvent struct {
+	Identifier RequestID              `json:"identifier"`
+	Options    DirectUDPSocketOptions `json:"options"`
+	Timestamp  MonotonicTime          `json:"timestamp"`
+	Initiator  *Initiator             `json:"initiator,omitempty"`
+}
+
+// EventDirectUDPSocketCreated is the method of DirectUDPSocketCreatedEvent.
+const EventDirectUDPSocketCreated = "Network.directUDPSocketCreated"
+
+// OnDirectUDPSocketCreated calls handler for every DirectUDPSocketCreatedEvent.
+//
+// The returned function removes the handler.
+func OnDirectUDPSocketCreated(source cdp.EventSource, handler func(*DirectUDPSocketCreatedEvent)) func() {
+	return cdp.On(source, EventDirectUDPSocketCreated, handler)
+}
+
+// DirectUDPSocketOpenedEvent is sent on Network.directUDPSocketOpened.
+//
+// Fired when direct_socket.UDPSocket connection is opened.
+//
+// Experimental.
+type DirectUDPSocketOpenedEvent struct {
+	Identifier RequestID `json:"identifier"`
+	LocalAddr  string    `json:"localAddr"`
+	// Expected to be unsigned integer.
+	LocalPort  int64         `json:"localPort"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+	RemoteAddr string        `json:"remoteAddr,omitempty"`
+	// Expected to be unsigned integer.
+	RemotePort *int64 `json:"remotePort,omitempty"`
+}
+
+// EventDirectUDPSocketOpened is the method of DirectUDPSocketOpenedEvent.
+const EventDirectUDPSocketOpened = "Network.directUDPSocketOpened"
+
+// OnDirectUDPSocketOpened calls handler for every DirectUDPSocketOpenedEvent.
+//
+// The returned function removes the handler.
+func OnDirectUDPSocketOpened(source cdp.EventSource, handler func(*DirectUDPSocketOpenedEvent)) func() {
+	return cdp.On(source, EventDirectUDPSocketOpened, handler)
+}
+
+// DirectUDPSocketAbortedEvent is sent on Network.directUDPSocketAborted.
+//
+// Fired when direct_socket.UDPSocket is aborted.
+//
+// Experimental.
+type DirectUDPSocketAbortedEvent struct {
+	Identifier   RequestID     `json:"identifier"`
+	ErrorMessage string        `json:"errorMessage"`
+	Timestamp    MonotonicTime `json:"timestamp"`
+}
+
+// EventDirectUDPSocketAborted is the method of DirectUDPSocketAbortedEvent.
+const EventDirectUDPSocketAborted = "Network.directUDPSocketAborted"
+
+// OnDirectUDPSocketAborted calls handler for every DirectUDPSocketAbortedEvent.
+//
+// The returned function removes the handler.
+func OnDirectUDPSocketAborted(source cdp.EventSource, handler func(*DirectUDPSocketAbortedEvent)) func() {
+	return cdp.On(source, EventDirectUDPSocketAborted, handler)
+}
+
+// DirectUDPSocketClosedEvent is sent on Network.directUDPSocketClosed.
+//
+// Fired when direct_socket.UDPSocket is closed.
+//
+// Experimental.
+type DirectUDPSocketClosedEvent struct {
+	Identifier RequestID     `json:"identifier"`
+	Timestamp  MonotonicTime `json:"timestamp"`
+}
+
+// EventDirectUDPSocketClosed is the method of DirectUDPSocketClosedEvent.
+const EventDirectUDPSocketClosed = "Network.directUDPSocketClosed"
+
+// OnDirectUDPSocketClosed calls handler for every DirectUDPSocketClosedEvent.
+//
+// The returned function removes the handler.
+func OnDirectUDPSocketClosed(source cdp.EventSource, handler func(*DirectUDPSocketClosedEvent)) func() {
+	return cdp.On(source, EventDirectUDPSocketClosed, handler)
+}
+
+// DirectUDPSocketChunkSentEvent is sent on Network.directUDPSocketChunkSent.
+//
+// Fired when message is sent to udp direct socket stream.
+//
+// Experimental.
+type DirectUDPSocketChunkSentEvent struct {
+	Identifier RequestID        `json:"identifier"`
+	Message    DirectUDPMessage `json:"message"`
+	Timestamp  MonotonicTime    `json:"timestamp"`
+}
+
+// EventDirectUDPSocketChunkSent is the method of DirectUDPSocketChunkSentEvent.
+const EventDirectUDPSocketChunkSent = "Network.directUDPSocketChunkSent"
+
+// OnDirectUDPSocketChunkSent calls handler for every DirectUDPSocketChunkSentEvent.
+//
+// The returned function removes the handler.
+func OnDirectUDPSocketChunkSent(source cdp.EventSource, handler func(*DirectUDPSocketChunkSentEvent)) func() {
+	return cdp.On(source, EventDirectUDPSocketChunkSent, handler)
+}
+
+// DirectUDPSocketChunkReceivedEvent is sent on Network.directUDPSocketChunkReceived.
+//
+// Fired when message is received from udp direct socket stream.
+//
+// Experimental.
+type DirectUDPSocketChunkReceivedEvent struct {
+	Identifier RequestID        `json:"identifier"`
+	Message    DirectUDPMessage `json:"message"`
+	Timestamp  MonotonicTime    `json:"timestamp"`
+}
+
+// EventDirectUDPSocketChunkReceived is the method of DirectUDPSocketChunkReceivedEvent.
+const EventDirectUDPSocketChunkReceived = "Network.directUDPSocketChunkReceived"
+
+// OnDirectUDPSocketChunkReceived calls handler for every DirectUDPSocketChunkReceivedEvent.
+//
+// The returned function removes the handler.
+func OnDirectUDPSocketChunkReceived(source cdp.EventSource, handler func(*DirectUDPSocketChunkReceivedEvent)) func() {
+	return cdp.On(source, EventDirectUDPSocketChunkReceived, handler)
+}
+
+// RequestWillBeSentExtraInfoEvent is sent on Network.requestWillBeSentExtraInfo.
+//
+// Fired when additional information about a requestWillBeSent event is available from the
+// network stack. Not every requestWillBeSent event will have an additional
+// requestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent
+// or requestWillBeSentExtraInfo will be fired first for the same request.
+//
+// Experimental.
+type RequestWillBeSentExtraInfoEvent struct {
+	// Request identifier. Used to match this information to an existing requestWillBeSent event.
+	RequestID RequestID `json:"requestId"`
+	// A list of cookies potentially associated to the requested URL. This includes both cookies sent with
+	// the request and the ones not sent; the latter are distinguished by having blockedReasons field set.
+	AssociatedCookies []AssociatedCookie `json:"associatedCookies"`
+	// Raw request headers as they will be sent over the wire.
+	Headers Headers `json:"headers"`
+	// Connection timing information for the request.
+	ConnectTiming ConnectTiming `json:"connectTiming"`
+	// The client security state set for the request.
+	ClientSecurityState *ClientSecurityState `json:"clientSecurityState,omitempty"`
+	// Whether the site has partitioned cookies stored in a partition different than the current one.
+	SiteHasCookieInOtherPartition *bool `json:"siteHasCookieInOtherPartition,omitempty"`
+}
+
+// EventRequestWillBeSentExtraInfo is the method of RequestWillBeSentExtraInfoEvent.
+const EventRequestWillBeSentExtraInfo = "Network.requestWillBeSentExtraInfo"
+
+// OnRequestWillBeSentExtraInfo calls handler for every RequestWillBeSentExtraInfoEvent.
+//
+// The returned function removes the handler.
+func OnRequestWillBeSentExtraInfo(source cdp.EventSource, handler func(*RequestWillBeSentExtraInfoEvent)) func() {
+	return cdp.On(source, EventRequestWillBeSentExtraInfo, handler)
+}
+
+// ResponseReceivedExtraInfoEvent is sent on Network.responseReceivedExtraInfo.
+//
+// Fired when additional information about a responseReceived event is available from the network
+// stack. Not every responseReceived event will have an additional responseReceivedExtraInfo for
+// it, and responseReceivedExtraInfo may be fired before or after responseReceived.
+//
+// Experimental.
+type ResponseReceivedExtraInfoEvent struct {
+	// Request identifier. Used to match this information to another responseReceived event.
+	RequestID RequestID `json:"requestId"`
+	// A list of cookies which were not stored from the response along with the corresponding
+	// reasons for blocking. The cookies here may not be valid due to syntax errors, which
+	// are represented by the invalid cookie line string instead of a proper cookie.
+	BlockedCookies []BlockedSetCookieWithReason `json:"blockedCookies"`
+	// Raw response headers as they were received over the wire.
+	// Duplicate headers in the response are represented as a single key with their values
+	// concatentated using `\n` as the separator.
+	// See also `headersText` that contains verbatim text for HTTP/1.*.
+	Headers Headers `json:"headers"`
+	// The IP address space of the resource. The address space can only be determined once the transport
+	// established the connection, so we can't send it in `requestWillBeSentExtraInfo`.
+	ResourceIPAddressSpace IPAddressSpace `json:"resourceIPAddressSpace"`
+	// The status code of the response. This is useful in cases the request failed and no responseReceived
+	// event is triggered, which is the case for, e.g., CORS errors. This is also the correct status code
+	// for cached requests, where the status in responseReceived is a 200 and this will be 304.
+	StatusCode int64 `json:"statusCode"`
+	// Raw response header text as it was received over the wire. The raw text may not always be
+	// available, such as in the case of HTTP/2 or QUIC.
+	HeadersText string `json:"headersText,omitempty"`
+	// The cookie partition key that will be used to store partitioned cookies set in this response.
+	// Only sent when partitioned cookies are enabled.
+	CookiePartitionKey *CookiePartitionKey `json:"cookiePartitionKey,omitempty"`
+	// True if partitioned cookies are enabled, but the partition key is not serializable to string.
+	CookiePartitionKeyOpaque *bool `json:"cookiePartitionKeyOpaque,omitempty"`
+	// A list of cookies which should have been blocked by 3PCD but are exempted and stored from
+	// the response with the corresponding reason.
+	ExemptedCookies []ExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`
+}
+
+// EventResponseReceivedExtraInfo is the method of ResponseReceivedExtraInfoEvent.
+const EventResponseReceivedExtraInfo = "Network.responseReceivedExtraInfo"
+
+// OnResponseReceivedExtraInfo calls handler for every ResponseReceivedExtraInfoEvent.
+//
+// The returned function removes the handler.
+func OnResponseReceivedExtraInfo(source cdp.EventSource, handler func(*ResponseReceivedExtraInfoEvent)) func() {
+	return cdp.On(source, EventResponseReceivedExtraInfo, handler)
+}
+
+// ResponseReceivedEarlyHintsEvent is sent on Network.responseReceivedEarlyHints.
+//
+// Fired when 103 Early Hints headers is received in addition to the common response.
+// Not every responseReceived event will have an responseReceivedEarlyHints fired.
+// Only one responseReceivedEarlyHints may be fired for eached responseReceived event.
+//
+// Experimental.
+type ResponseReceivedEarlyHintsEvent struct {
+	// Request identifier. Used to match this information to another responseReceived event.
+	RequestID RequestID `json:"requestId"`
+	// Raw response headers as they were received over the wire.
+	// Duplicate headers in the response are represented as a single key with their values
+	// concatentated using `\n` as the separator.
+	// See also `headersText` that contains verbatim text for HTTP/1.*.
+	Headers Headers `json:"headers"`
+}
+
+// EventResponseReceivedEarlyHints is the method of ResponseReceivedEarlyHintsEvent.
+const EventResponseReceivedEarlyHints = "Network.responseReceivedEarlyHints"
+
+// OnResponseReceivedEarlyHints calls handler for every ResponseReceivedEarlyHintsEvent.
+//
+// The returned function removes the handler.
+func OnResponseReceivedEarlyHints(source cdp.EventSource, handler func(*ResponseReceivedEarlyHintsEvent)) func() {
+	return cdp.On(source, EventResponseReceivedEarlyHints, handler)
+}
+
+// TrustTokenOperationDoneEvent is sent on Network.trustTokenOperationDone.
+//
+// Fired exactly once for each Trust Token operation. Depending on
+// the type of the operation and whether the operation succeeded or
+// failed, the event is fired before the corresponding request was sent
+// or after the response was received.
+//
+// Experimental.
+type TrustTokenOperationDoneEvent struct {
+	// Detailed success or error status of the operation.
+	// 'AlreadyExists' also signifies a successful operation, as the result
+	// of the operation already exists und thus, the operation was abort
+	// preemptively (e.g. a cache hit).
+	//
+	// Values: Ok, InvalidArgument, MissingIssuerKeys, FailedPrecondition, ResourceExhausted, AlreadyExists, ResourceLimited, Unauthorized, BadResponse, InternalError, UnknownError, FulfilledLocally, SiteIssuerLimit.
+	Status    string                  `json:"status"`
+	Type      TrustTokenOperationType `json:"type"`
+	RequestID RequestID               `json:"requestId"`
+	// Top level origin. The context in which the operation was attempted.
+	TopLevelOrigin string `json:"topLevelOrigin,omitempty"`
+	// Origin of the issuer in case of a "Issuance" or "Redemption" operation.
+	IssuerOrigin string `json:"issuerOrigin,omitempty"`
+	// The number of obtained Trust Tokens on a successful "Issuance" operation.
+	IssuedTokenCount *int64 `json:"issuedTokenCount,omitempty"`
+}
+
+// EventTrustTokenOperationDone is the method of TrustTokenOperationDoneEvent.
+const EventTrustTokenOperationDone = "Network.trustTokenOperationDone"
+
+// OnTrustTokenOperationDone calls handler for every TrustTokenOperationDoneEvent.
+//
+// The returned function removes the handler.
+func OnTrustTokenOperationDone(source cdp.EventSource, handler func(*TrustTokenOperationDoneEvent)) func() {
+	return cdp.On(source, EventTrustTokenOperationDone, handler)
+}
+
+// PolicyUpdatedEvent is sent on Network.policyUpdated.
+//
+// Fired once security policy has been updated.
+//
+// Experimental.
+type PolicyUpdatedEvent struct {
+}
+
+// EventPolicyUpdated is the method of PolicyUpdatedEvent.
+const EventPolicyUpdated = "Network.policyUpdated"
+
+// OnPolicyUpdated calls handler for every PolicyUpdatedEvent.
+//
+// The returned function removes the handler.
+func OnPolicyUpdated(source cdp.EventSource, handler func(*PolicyUpdatedEvent)) func() {
+	return cdp.On(source, EventPolicyUpdated, handler)
+}
+
+// SubresourceWebBundleMetadataReceivedEvent is sent on Network.subresourceWebBundleMetadataReceived.
+//
+// Fired once when parsing the .wbn file has succeeded.
+// The event contains the information about the web bundle contents.
+//
+// Experimental.
+type SubresourceWebBundleMetadataReceivedEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+	// A list of URLs of resources in the subresource Web Bundle.
+	Urls []string `json:"urls"`
+}
+
+// EventSubresourceWebBundleMetadataReceived is the method of SubresourceWebBundleMetadataReceivedEvent.
+const EventSubresourceWebBundleMetadataReceived = "Network.subresourceWebBundleMetadataReceived"
+
+// OnSubresourceWebBundleMetadataReceived calls handler for every SubresourceWebBundleMetadataReceivedEvent.
+//
+// The returned function removes the handler.
+func OnSubresourceWebBundleMetadataReceived(source cdp.EventSource, handler func(*SubresourceWebBundleMetadataReceivedEvent)) func() {
+	return cdp.On(source, EventSubresourceWebBundleMetadataReceived, handler)
+}
+
+// SubresourceWebBundleMetadataErrorEvent is sent on Network.subresourceWebBundleMetadataError.
+//
+// Fired once when parsing the .wbn file has failed.
+//
+// Experimental.
+type SubresourceWebBundleMetadataErrorEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+	// Error message.
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// EventSubresourceWebBundleMetadataError is the method of SubresourceWebBundleMetadataErrorEvent.
+const EventSubresourceWebBundleMetadataError = "Network.subresourceWebBundleMetadataError"
+
+// OnSubresourceWebBundleMetadataError calls handler for every SubresourceWebBundleMetadataErrorEvent.
+//
+// The returned function removes the handler.
+func OnSubresourceWebBundleMetadataError(source cdp.EventSource, handler func(*SubresourceWebBundleMetadataErrorEvent)) func() {
+	return cdp.On(source, EventSubresourceWebBundleMetadataError, handler)
+}
+
+// SubresourceWebBundleInnerResponseParsedEvent is sent on Network.subresourceWebBundleInnerResponseParsed.
+//
+// Fired when handling requests for resources within a .wbn file.
+// Note: this will only be fired for resources that are requested by the webpage.
+//
+// Experimental.
+type SubresourceWebBundleInnerResponseParsedEvent struct {
+	// Request identifier of the subresource request.
+	InnerRequestID RequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Bundle request identifier. Used to match this information to another event.
+	// This made be absent in case when the instrumentation was enabled only
+	// after webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+}
+
+// EventSubresourceWebBundleInnerResponseParsed is the method of SubresourceWebBundleInnerResponseParsedEvent.
+const EventSubresourceWebBundleInnerResponseParsed = "Network.subresourceWebBundleInnerResponseParsed"
+
+// OnSubresourceWebBundleInnerResponseParsed calls handler for every SubresourceWebBundleInnerResponseParsedEvent.
+//
+// The returned function removes the handler.
+func OnSubresourceWebBundleInnerResponseParsed(source cdp.EventSource, handler func(*SubresourceWebBundleInnerResponseParsedEvent)) func() {
+	return cdp.On(source, EventSubresourceWebBundleInnerResponseParsed, handler)
+}
+
+// SubresourceWebBundleInnerResponseErrorEvent is sent on Network.subresourceWebBundleInnerResponseError.
+//
+// Fired when request for resources within a .wbn file failed.
+//
+// Experimental.
+type SubresourceWebBundleInnerResponseErrorEvent struct {
+	// Request identifier of the subresource request.
+	InnerRequestID RequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Error message.
+	ErrorMessage string `json:"errorMessage"`
+	// Bundle request identifier. Used to match this information to another event.
+	// This made be absent in case when the instrumentation was enabled only
+	// after webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+}
+
+// EventSubresourceWebBundleInnerResponseError is the method of SubresourceWebBundleInnerResponseErrorEvent.
+const EventSubresourceWebBundleInnerResponseError = "Network.subresourceWebBundleInnerResponseError"
+
+// OnSubresourceWebBundleInnerResponseError calls handler for every SubresourceWebBundleInnerResponseErrorEvent.
+//
+// The returned function removes the handler.
+func OnSubresourceWebBundleInnerResponseError(source cdp.EventSource, handler func(*SubresourceWebBundleInnerResponseErrorEvent)) func() {
+	return cdp.On(source, EventSubresourceWebBundleInnerResponseError, handler)
+}
+
+// ReportingAPIReportAddedEvent is sent on Network.reportingApiReportAdded.
+//
+// Is sent whenever a new report is added.
+// And after 'enableReportingApi' for all existing reports.
+//
+// Experimental.
+type ReportingAPIReportAddedEvent struct {
+	Report ReportingAPIReport `json:"report"`
+}
+
+// EventReportingAPIReportAdded is the method of ReportingAPIReportAddedEvent.
+const EventReportingAPIReportAdded = "Network.reportingApiReportAdded"
+
+// OnReportingAPIReportAdded calls handler for every ReportingAPIReportAddedEvent.
+//
+// The returned function removes the handler.
+func OnReportingAPIReportAdded(source cdp.EventSource, handler func(*ReportingAPIReportAddedEvent)) func() {
+	return cdp.On(source, EventReportingAPIReportAdded, handler)
+}
+
+// ReportingAPIReportUpdatedEvent is sent on Network.reportingApiReportUpdated.
+//
+// Experimental.
+type ReportingAPIReportUpdatedEvent struct {
+	Report ReportingAPIReport `json:"report"`
+}
+
+// EventReportingAPIReportUpdated is the method of ReportingAPIReportUpdatedEvent.
+const EventReportingAPIReportUpdated = "Network.reportingApiReportUpdated"
+
+// OnReportingAPIReportUpdated calls handler for every ReportingAPIReportUpdatedEvent.
+//
+// The returned function removes the handler.
+func OnReportingAPIReportUpdated(source cdp.EventSource, handler func(*ReportingAPIReportUpdatedEvent)) func() {
+	return cdp.On(source, EventReportingAPIReportUpdated, handler)
+}
+
+// ReportingAPIEndpointsChangedForOriginEvent is sent on Network.reportingApiEndpointsChangedForOrigin.
+//
+// Experimental.
+type ReportingAPIEndpointsChangedForOriginEvent struct {
+	// Origin of the document(s) which configured the endpoints.
+	Origin    string                 `json:"origin"`
+	Endpoints []ReportingAPIEndpoint `json:"endpoints"`
+}
+
+// EventReportingAPIEndpointsChangedForOrigin is the method of ReportingAPIEndpointsChangedForOriginEvent.
+const EventReportingAPIEndpointsChangedForOrigin = "Network.reportingApiEndpointsChangedForOrigin"
+
+// OnReportingAPIEndpointsChangedForOrigin calls handler for every ReportingAPIEndpointsChangedForOriginEvent.
+//
+// The returned function removes the handler.
+func OnReportingAPIEndpointsChangedForOrigin(source cdp.EventSource, handler func(*ReportingAPIEndpointsChangedForOriginEvent)) func() {
+	return cdp.On(source, EventReportingAPIEndpointsChangedForOrigin, handler)
+}
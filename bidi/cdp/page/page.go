@@ -0,0 +1,2708 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package page provides typed bindings for the CDP Page domain.
+//
+// Actions and events related to the inspected page belong to the page domain.
+package page
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// FrameID unique frame identifier.
+type FrameID = cdp.PageFrameID
+
+// AdFrameType indicates whether a frame has been identified as an ad.
+//
+// Experimental.
+type AdFrameType string
+
+const (
+	AdFrameTypeNone  AdFrameType = "none"
+	AdFrameTypeChild AdFrameType = "child"
+	AdFrameTypeRoot  AdFrameType = "root"
+)
+
+// AdFrameExplanation is the Page.AdFrameExplanation type.
+//
+// Experimental.
+type AdFrameExplanation string
+
+const (
+	AdFrameExplanationParentIsAd          AdFrameExplanation = "ParentIsAd"
+	AdFrameExplanationCreatedByAdScript   AdFrameExplanation = "CreatedByAdScript"
+	AdFrameExplanationMatchedBlockingRule AdFrameExplanation = "MatchedBlockingRule"
+)
+
+// AdFrameStatus indicates whether a frame has been identified as an ad and why.
+//
+// Experimental.
+type AdFrameStatus struct {
+	AdFrameType  AdFrameType          `json:"adFrameType"`
+	Explanations []AdFrameExplanation `json:"explanations,omitempty"`
+}
+
+// AdScriptID identifies the script which caused a script or frame to be labelled as an
+// ad.
+//
+// Experimental.
+type AdScriptID struct {
+	// Script Id of the script which caused a script or frame to be labelled as
+	// an ad.
+	ScriptID cdp.RuntimeScriptID `json:"scriptId"`
+	// Id of scriptId's debugger.
+	DebuggerID cdp.RuntimeUniqueDebuggerID `json:"debuggerId"`
+}
+
+// AdScriptAncestry encapsulates the script ancestry and the root script filterlist rule that
+// caused the frame to be labelled as an ad. Only created when `ancestryChain`
+// is not empty.
+//
+// Experimental.
+type AdScriptAncestry struct {
+	// A chain of `AdScriptId`s representing the ancestry of an ad script that
+	// led to the creation of a frame. The chain is ordered from the script
+	// itself (lower level) up to its root ancestor that was flagged by
+	// filterlist.
+	AncestryChain []AdScriptID `json:"ancestryChain"`
+	// The filterlist rule that caused the root (last) script in
+	// `ancestryChain` to be ad-tagged. Only populated if the rule is
+	// available.
+	RootScriptFilterlistRule string `json:"rootScriptFilterlistRule,omitempty"`
+}
+
+// SecureContextType indicates whether the frame is a secure context and why it is the case.
+//
+// Experimental.
+type SecureContextType string
+
+const (
+	SecureContextTypeSecure           SecureContextType = "Secure"
+	SecureContextTypeSecureLocalhost  SecureContextType = "SecureLocalhost"
+	SecureContextTypeInsecureScheme   SecureContextType = "InsecureScheme"
+	SecureContextTypeInsecureAncestor SecureContextType = "InsecureAncestor"
+)
+
+// CrossOriginIsolatedContextType indicates whether the frame is cross-origin isolated and why it is the case.
+//
+// Experimental.
+type CrossOriginIsolatedContextType string
+
+const (
+	CrossOriginIsolatedContextTypeIsolated                   CrossOriginIsolatedContextType = "Isolated"
+	CrossOriginIsolatedContextTypeNotIsolated                CrossOriginIsolatedContextType = "NotIsolated"
+	CrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled CrossOriginIsolatedContextType = "NotIsolatedFeatureDisabled"
+)
+
+// GatedAPIFeatures is the Page.GatedAPIFeatures type.
+//
+// Experimental.
+type GatedAPIFeatures string
+
+const (
+	GatedAPIFeaturesSharedArrayBuffers                GatedAPIFeatures = "SharedArrayBuffers"
+	GatedAPIFeaturesSharedArrayBuffersTransferAllowed GatedAPIFeatures = "SharedArrayBuffersTransferAllowed"
+	GatedAPIFeaturesPerformanceMeasureMemory          GatedAPIFeatures = "PerformanceMeasureMemory"
+	GatedAPIFeaturesPerformanceProfile                GatedAPIFeatures = "PerformanceProfile"
+)
+
+// PermissionsPolicyFeature all Permissions Policy features. This enum should match the one defined
+// in services/network/public/cpp/permissions_policy/permissions_policy_features.json5.
+// LINT.IfChange(PermissionsPolicyFeature).
+//
+// Experimental.
+type PermissionsPolicyFeature string
+
+const (
+	PermissionsPolicyFeatureAccelerometer                  PermissionsPolicyFeature = "accelerometer"
+	PermissionsPolicyFeatureAllScreensCapture              PermissionsPolicyFeature = "all-screens-capture"
+	PermissionsPolicyFeatureAmbientLightSensor             PermissionsPolicyFeature = "ambient-light-sensor"
+	PermissionsPolicyFeatureAriaNotify                     PermissionsPolicyFeature = "aria-notify"
+	PermissionsPolicyFeatureAttributionReporting           PermissionsPolicyFeature = "attribution-reporting"
+	PermissionsPolicyFeatureAutoplay                       PermissionsPolicyFeature = "autoplay"
+	PermissionsPolicyFeatureBluetooth                      PermissionsPolicyFeature = "bluetooth"
+	PermissionsPolicyFeatureBrowsingTopics                 PermissionsPolicyFeature = "browsing-topics"
+	PermissionsPolicyFeatureCamera                         PermissionsPolicyFeature = "camera"
+	PermissionsPolicyFeatureCapturedSurfaceControl         PermissionsPolicyFeature = "captured-surface-control"
+	PermissionsPolicyFeatureChDpr                          PermissionsPolicyFeature = "ch-dpr"
+	PermissionsPolicyFeatureChDeviceMemory                 PermissionsPolicyFeature = "ch-device-memory"
+	PermissionsPolicyFeatureChDownlink                     PermissionsPolicyFeature = "ch-downlink"
+	PermissionsPolicyFeatureChEct                          PermissionsPolicyFeature = "ch-ect"
+	PermissionsPolicyFeatureChPrefersColorScheme           PermissionsPolicyFeature = "ch-prefers-color-scheme"
+	PermissionsPolicyFeatureChPrefersReducedMotion         PermissionsPolicyFeature = "ch-prefers-reduced-motion"
+	PermissionsPolicyFeatureChPrefersReducedTransparency   PermissionsPolicyFeature = "ch-prefers-reduced-transparency"
+	PermissionsPolicyFeatureChRtt                          PermissionsPolicyFeature = "ch-rtt"
+	PermissionsPolicyFeatureChSaveData                     PermissionsPolicyFeature = "ch-save-data"
+	PermissionsPolicyFeatureChUa                           PermissionsPolicyFeature = "ch-ua"
+	PermissionsPolicyFeatureChUaArch                       PermissionsPolicyFeature = "ch-ua-arch"
+	PermissionsPolicyFeatureChUaBitness                    PermissionsPolicyFeature = "ch-ua-bitness"
+	PermissionsPolicyFeatureChUaHighEntropyValues          PermissionsPolicyFeature = "ch-ua-high-entropy-values"
+	PermissionsPolicyFeatureChUaPlatform                   PermissionsPolicyFeature = "ch-ua-platform"
+	PermissionsPolicyFeatureChUaModel                      PermissionsPolicyFeature = "ch-ua-model"
+	PermissionsPolicyFeatureChUaMobile                     PermissionsPolicyFeature = "ch-ua-mobile"
+	PermissionsPolicyFeatureChUaFormFactors                PermissionsPolicyFeature = "ch-ua-form-factors"
+	PermissionsPolicyFeatureChUaFullVersion                PermissionsPolicyFeature = "ch-ua-full-version"
+	PermissionsPolicyFeatureChUaFullVersionList            PermissionsPolicyFeature = "ch-ua-full-version-list"
+	PermissionsPolicyFeatureChUaPlatformVersion            PermissionsPolicyFeature = "ch-ua-platform-version"
+	PermissionsPolicyFeatureChUaWow64                      PermissionsPolicyFeature = "ch-ua-wow64"
+	PermissionsPolicyFeatureChViewportHeight               PermissionsPolicyFeature = "ch-viewport-height"
+	PermissionsPolicyFeatureChViewportWidth                PermissionsPolicyFeature = "ch-viewport-width"
+	PermissionsPolicyFeatureChWidth                        PermissionsPolicyFeature = "ch-width"
+	PermissionsPolicyFeatureClipboardRead                  PermissionsPolicyFeature = "clipboard-read"
+	PermissionsPolicyFeatureClipboardWrite                 PermissionsPolicyFeature = "clipboard-write"
+	PermissionsPolicyFeatureComputePressure                PermissionsPolicyFeature = "compute-pressure"
+	PermissionsPolicyFeatureControlledFrame                PermissionsPolicyFeature = "controlled-frame"
+	PermissionsPolicyFeatureCrossOriginIsolated            PermissionsPolicyFeature = "cross-origin-isolated"
+	PermissionsPolicyFeatureDeferredFetch                  PermissionsPolicyFeature = "deferred-fetch"
+	PermissionsPolicyFeatureDeferredFetchMinimal           PermissionsPolicyFeature = "deferred-fetch-minimal"
+	PermissionsPolicyFeatureDeviceAttributes               PermissionsPolicyFeature = "device-attributes"
+	PermissionsPolicyFeatureDigitalCredentialsGet          PermissionsPolicyFeature = "digital-credentials-get"
+	PermissionsPolicyFeatureDirectSockets                  PermissionsPolicyFeature = "direct-sockets"
+	PermissionsPolicyFeatureDirectSocketsPrivate           PermissionsPolicyFeature = "direct-sockets-private"
+	PermissionsPolicyFeatureDisplayCapture                 PermissionsPolicyFeature = "display-capture"
+	PermissionsPolicyFeatureDocumentDomain                 PermissionsPolicyFeature = "document-domain"
+	PermissionsPolicyFeatureEncryptedMedia                 PermissionsPolicyFeature = "encrypted-media"
+	PermissionsPolicyFeatureExecutionWhileOutOfViewport    PermissionsPolicyFeature = "execution-while-out-of-viewport"
+	PermissionsPolicyFeatureExecutionWhileNotRendered      PermissionsPolicyFeature = "execution-while-not-rendered"
+	PermissionsPolicyFeatureFencedUnpartitionedStorageRead PermissionsPolicyFeature = "fenced-unpartitioned-storage-read"
+	PermissionsPolicyFeatureFocusWithoutUserActivation     PermissionsPolicyFeature = "focus-without-user-activation"
+	PermissionsPolicyFeatureFullscreen                     PermissionsPolicyFeature = "fullscreen"
+	PermissionsPolicyFeatureFrobulate                      PermissionsPolicyFeature = "frobulate"
+	PermissionsPolicyFeatureGamepad                        PermissionsPolicyFeature = "gamepad"
+	PermissionsPolicyFeatureGeolocation                    PermissionsPolicyFeature = "geolocation"
+	PermissionsPolicyFeatureGyroscope                      PermissionsPolicyFeature = "gyroscope"
+	PermissionsPolicyFeatureHid                            PermissionsPolicyFeature = "hid"
+	PermissionsPolicyFeatureIdentityCredentialsGet         PermissionsPolicyFeature = "identity-credentials-get"
+	PermissionsPolicyFeatureIdleDetection                  PermissionsPolicyFeature = "idle-detection"
+	PermissionsPolicyFeatureInterestCohort                 PermissionsPolicyFeature = "interest-cohort"
+	PermissionsPolicyFeatureJoinAdInterestGroup            PermissionsPolicyFeature = "join-ad-interest-group"
+	PermissionsPolicyFeatureKeyboardMap                    PermissionsPolicyFeature = "keyboard-map"
+	PermissionsPolicyFeatureLanguageDetector               PermissionsPolicyFeature = "language-detector"
+	PermissionsPolicyFeatureLanguageModel                  PermissionsPolicyFeature = "language-model"
+	PermissionsPolicyFeatureLocalFonts                     PermissionsPolicyFeature = "local-fonts"
+	PermissionsPolicyFeatureLocalNetworkAccess             PermissionsPolicyFeature = "local-network-access"
+	PermissionsPolicyFeatureMagnetometer                   PermissionsPolicyFeature = "magnetometer"
+	PermissionsPolicyFeatureMediaPlaybackWhileNotVisible   PermissionsPolicyFeature = "media-playback-while-not-visible"
+	PermissionsPolicyFeatureMicrophone                     PermissionsPolicyFeature = "microphone"
+	PermissionsPolicyFeatureMidi                           PermissionsPolicyFeature = "midi"
+	PermissionsPolicyFeatureOnDeviceSpeechRecognition      PermissionsPolicyFeature = "on-device-speech-recognition"
+	PermissionsPolicyFeatureOtpCredentials                 PermissionsPolicyFeature = "otp-credentials"
+	PermissionsPolicyFeaturePayment                        PermissionsPolicyFeature = "payment"
+	PermissionsPolicyFeaturePictureInPicture               PermissionsPolicyFeature = "picture-in-picture"
+	PermissionsPolicyFeaturePopins                         PermissionsPolicyFeature = "popins"
+	PermissionsPolicyFeaturePrivateAggregation             PermissionsPolicyFeature = "private-aggregation"
+	PermissionsPolicyFeaturePrivateStateTokenIssuance      PermissionsPolicyFeature = "private-state-token-issuance"
+	PermissionsPolicyFeaturePrivateStateTokenRedemption    PermissionsPolicyFeature = "private-state-token-redemption"
+	PermissionsPolicyFeaturePublickeyCredentialsCreate     PermissionsPolicyFeature = "publickey-credentials-create"
+	PermissionsPolicyFeaturePublickeyCredentialsGet        PermissionsPolicyFeature = "publickey-credentials-get"
+	PermissionsPolicyFeatureRecordAdAuctionEvents          PermissionsPolicyFeature = "record-ad-auction-events"
+	PermissionsPolicyFeatureRewriter                       PermissionsPolicyFeature = "rewriter"
+	PermissionsPolicyFeatureRunAdAuction                   PermissionsPolicyFeature = "run-ad-auction"
+	PermissionsPolicyFeatureScreenWakeLock                 PermissionsPolicyFeature = "screen-wake-lock"
+	PermissionsPolicyFeatureSerial                         PermissionsPolicyFeature = "serial"
+	PermissionsPolicyFeatureSharedAutofill                 PermissionsPolicyFeature = "shared-autofill"
+	PermissionsPolicyFeatureSharedStorage                  PermissionsPolicyFeature = "shared-storage"
+	PermissionsPolicyFeatureSharedStorageSelectURL         PermissionsPolicyFeature = "shared-storage-select-url"
+	PermissionsPolicyFeatureSmartCard                      PermissionsPolicyFeature = "smart-card"
+	PermissionsPolicyFeatureSpeakerSelection               PermissionsPolicyFeature = "speaker-selection"
+	PermissionsPolicyFeatureStorageAccess                  PermissionsPolicyFeature = "storage-access"
+	PermissionsPolicyFeatureSubApps                        PermissionsPolicyFeature = "sub-apps"
+	PermissionsPolicyFeatureSummarizer                     PermissionsPolicyFeature = "summarizer"
+	PermissionsPolicyFeatureSyncXhr                        PermissionsPolicyFeature = "sync-xhr"
+	PermissionsPolicyFeatureTranslator                     PermissionsPolicyFeature = "translator"
+	PermissionsPolicyFeatureUnload                         PermissionsPolicyFeature = "unload"
+	PermissionsPolicyFeatureUsb                            PermissionsPolicyFeature = "usb"
+	PermissionsPolicyFeatureUsbUnrestricted                PermissionsPolicyFeature = "usb-unrestricted"
+	PermissionsPolicyFeatureVerticalScroll                 PermissionsPolicyFeature = "vertical-scroll"
+	PermissionsPolicyFeatureWebAppInstallation             PermissionsPolicyFeature = "web-app-installation"
+	PermissionsPolicyFeatureWebPrinting                    PermissionsPolicyFeature = "web-printing"
+	PermissionsPolicyFeatureWebShare                       PermissionsPolicyFeature = "web-share"
+	PermissionsPolicyFeatureWindowManagement               PermissionsPolicyFeature = "window-management"
+	PermissionsPolicyFeatureWriter                         PermissionsPolicyFeature = "writer"
+	PermissionsPolicyFeatureXrSpatialTracking              PermissionsPolicyFeature = "xr-spatial-tracking"
+)
+
+// PermissionsPolicyBlockReason reason for a permissions policy feature to be disabled.
+//
+// Experimental.
+type PermissionsPolicyBlockReason string
+
+const (
+	PermissionsPolicyBlockReasonHeader            PermissionsPolicyBlockReason = "Header"
+	PermissionsPolicyBlockReasonIframeAttribute   PermissionsPolicyBlockReason = "IframeAttribute"
+	PermissionsPolicyBlockReasonInFencedFrameTree PermissionsPolicyBlockReason = "InFencedFrameTree"
+	PermissionsPolicyBlockReasonInIsolatedApp     PermissionsPolicyBlockReason = "InIsolatedApp"
+)
+
+// PermissionsPolicyBlockLocator is the Page.PermissionsPolicyBlockLocator type.
+//
+// Experimental.
+type PermissionsPolicyBlockLocator struct {
+	FrameID     FrameID                      `json:"frameId"`
+	BlockReason PermissionsPolicyBlockReason `json:"blockReason"`
+}
+
+// PermissionsPolicyFeatureState is the Page.PermissionsPolicyFeatureState type.
+//
+// Experimental.
+type PermissionsPolicyFeatureState struct {
+	Feature PermissionsPolicyFeature       `json:"feature"`
+	Allowed bool                           `json:"allowed"`
+	Locator *PermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+// OriginTrialTokenStatus origin Trial(https://www.chromium.org/blink/origin-trials) support.
+// Status for an Origin Trial token.
+//
+// Experimental.
+type OriginTrialTokenStatus string
+
+const (
+	OriginTrialTokenStatusSuccess                OriginTrialTokenStatus = "Success"
+	OriginTrialTokenStatusNotSupported           OriginTrialTokenStatus = "NotSupported"
+	OriginTrialTokenStatusInsecure               OriginTrialTokenStatus = "Insecure"
+	OriginTrialTokenStatusExpired                OriginTrialTokenStatus = "Expired"
+	OriginTrialTokenStatusWrongOrigin            OriginTrialTokenStatus = "WrongOrigin"
+	OriginTrialTokenStatusInvalidSignature       OriginTrialTokenStatus = "InvalidSignature"
+	OriginTrialTokenStatusMalformed              OriginTrialTokenStatus = "Malformed"
+	OriginTrialTokenStatusWrongVersion           OriginTrialTokenStatus = "WrongVersion"
+	OriginTrialTokenStatusFeatureDisabled        OriginTrialTokenStatus = "FeatureDisabled"
+	OriginTrialTokenStatusTokenDisabled          OriginTrialTokenStatus = "TokenDisabled"
+	OriginTrialTokenStatusFeatureDisabledForUser OriginTrialTokenStatus = "FeatureDisabledForUser"
+	OriginTrialTokenStatusUnknownTrial           OriginTrialTokenStatus = "UnknownTrial"
+)
+
+// OriginTrialStatus status for an Origin Trial.
+//
+// Experimental.
+type OriginTrialStatus string
+
+const (
+	OriginTrialStatusEnabled               OriginTrialStatus = "Enabled"
+	OriginTrialStatusValidTokenNotProvided OriginTrialStatus = "ValidTokenNotProvided"
+	OriginTrialStatusOSNotSupported        OriginTrialStatus = "OSNotSupported"
+	OriginTrialStatusTrialNotAllowed       OriginTrialStatus = "TrialNotAllowed"
+)
+
+// OriginTrialUsageRestriction is the Page.OriginTrialUsageRestriction type.
+//
+// Experimental.
+type OriginTrialUsageRestriction string
+
+const (
+	OriginTrialUsageRestrictionNone   OriginTrialUsageRestriction = "None"
+	OriginTrialUsageRestrictionSubset OriginTrialUsageRestriction = "Subset"
+)
+
+// OriginTrialToken is the Page.OriginTrialToken type.
+//
+// Experimental.
+type OriginTrialToken struct {
+	Origin           string                      `json:"origin"`
+	MatchSubDomains  bool                        `json:"matchSubDomains"`
+	TrialName        string                      `json:"trialName"`
+	ExpiryTime       cdp.NetworkTimeSinceEpoch   `json:"expiryTime"`
+	IsThirdParty     bool                        `json:"isThirdParty"`
+	UsageRestriction OriginTrialUsageRestriction `json:"usageRestriction"`
+}
+
+// OriginTrialTokenWithStatus is the Page.OriginTrialTokenWithStatus type.
+//
+// Experimental.
+type OriginTrialTokenWithStatus struct {
+	RawTokenText string `json:"rawTokenText"`
+	// `parsedToken` is present only when the token is extractable and
+	// parsable.
+	ParsedToken *OriginTrialToken      `json:"parsedToken,omitempty"`
+	Status      OriginTrialTokenStatus `json:"status"`
+}
+
+// OriginTrial is the Page.OriginTrial type.
+//
+// Experimental.
+type OriginTrial struct {
+	TrialName        string                       `json:"trialName"`
+	Status           OriginTrialStatus            `json:"status"`
+	TokensWithStatus []OriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+// SecurityOriginDetails additional information about the frame document's security origin.
+//
+// Experimental.
+type SecurityOriginDetails struct {
+	// Indicates whether the frame document's security origin is one
+	// of the local hostnames (e.g. "localhost") or IP addresses (IPv4
+	// 127.0.0.0/8 or IPv6 ::1).
+	IsLocalhost bool `json:"isLocalhost"`
+}
+
+// Frame information about the Frame on the page.
+type Frame struct {
+	// Frame unique identifier.
+	ID FrameID `json:"id"`
+	// Parent frame identifier.
+	ParentID FrameID `json:"parentId,omitempty"`
+	// Identifier of the loader associated with this frame.
+	LoaderID cdp.NetworkLoaderID `json:"loaderId"`
+	// Frame's name as specified in the tag.
+	Name string `json:"name,omitempty"`
+	// Frame document's URL without fragment.
+	URL string `json:"url"`
+	// Frame document's URL fragment including the '#'.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// Frame document's registered domain, taking the public suffixes list into account.
+	// Extracted from the Frame's url.
+	// Example URLs: http://www.google.com/file.html -> "google.com"
+	//               http://a.b.co.uk/file.html      -> "b.co.uk".
+	DomainAndRegistry string `json:"domainAndRegistry"`
+	// Frame document's security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+	// Additional details about the frame document's security origin.
+	SecurityOriginDetails *SecurityOriginDetails `json:"securityOriginDetails,omitempty"`
+	// Frame document's mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// If the frame failed to load, this contains the URL that could not be loaded. Note that unlike url above, this URL may contain a fragment.
+	UnreachableURL string `json:"unreachableUrl,omitempty"`
+	// Indicates whether this frame was tagged as an ad and why.
+	AdFrameStatus *AdFrameStatus `json:"adFrameStatus,omitempty"`
+	// Indicates whether the main document is a secure context and explains why that is the case.
+	SecureContextType SecureContextType `json:"secureContextType"`
+	// Indicates whether this is a cross origin isolated context.
+	CrossOriginIsolatedContextType CrossOriginIsolatedContextType `json:"crossOriginIsolatedContextType"`
+	// Indicated which gated APIs / features are available.
+	GatedAPIFeatures []GatedAPIFeatures `json:"gatedAPIFeatures"`
+}
+
+// FrameResource information about the Resource on the page.
+//
+// Experimental.
+type FrameResource struct {
+	// Resource URL.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type cdp.NetworkResourceType `json:"type"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// last-modified timestamp as reported by server.
+	LastModified *cdp.NetworkTimeSinceEpoch `json:"lastModified,omitempty"`
+	// Resource content size.
+	ContentSize *float64 `json:"contentSize,omitempty"`
+	// True if the resource failed to load.
+	Failed *bool `json:"failed,omitempty"`
+	// True if the resource was canceled during loading.
+	Canceled *bool `json:"canceled,omitempty"`
+}
+
+// FrameResourceTree information about the Frame hierarchy along with their cached resources.
+//
+// Experimental.
+type FrameResourceTree struct {
+	// Frame information for this tree item.
+	Frame Frame `json:"frame"`
+	// Child frames.
+	ChildFrames []FrameResourceTree `json:"childFrames,omitempty"`
+	// Information about frame resources.
+	Resources []FrameResource `json:"resources"`
+}
+
+// FrameTree information about the Frame hierarchy.
+type FrameTree struct {
+	// Frame information for this tree item.
+	Frame Frame `json:"frame"`
+	// Child frames.
+	ChildFrames []FrameTree `json:"childFrames,omitempty"`
+}
+
+// ScriptIdentifier unique script identifier.
+type ScriptIdentifier string
+
+// TransitionType transition type.
+type TransitionType string
+
+const (
+	TransitionTypeLink             TransitionType = "link"
+	TransitionTypeTyped            TransitionType = "typed"
+	TransitionTypeAddressBar       TransitionType = "address_bar"
+	TransitionTypeAutoBookmark     TransitionType = "auto_bookmark"
+	TransitionTypeAutoSubframe     TransitionType = "auto_subframe"
+	TransitionTypeManualSubframe   TransitionType = "manual_subframe"
+	TransitionTypeGenerated        TransitionType = "generated"
+	TransitionTypeAutoToplevel     TransitionType = "auto_toplevel"
+	TransitionTypeFormSubmit       TransitionType = "form_submit"
+	TransitionTypeReload           TransitionType = "reload"
+	TransitionTypeKeyword          TransitionType = "keyword"
+	TransitionTypeKeywordGenerated TransitionType = "keyword_generated"
+	TransitionTypeOther            TransitionType = "other"
+)
+
+// NavigationEntry navigation history entry.
+type NavigationEntry struct {
+	// Unique id of the navigation history entry.
+	ID int64 `json:"id"`
+	// URL of the navigation history entry.
+	URL string `json:"url"`
+	// URL that the user typed in the url bar.
+	UserTypedURL string `json:"userTypedURL"`
+	// Title of the navigation history entry.
+	Title string `json:"title"`
+	// Transition type.
+	TransitionType TransitionType `json:"transitionType"`
+}
+
+// ScreencastFrameMetadata screencast frame metadata.
+//
+// Experimental.
+type ScreencastFrameMetadata struct {
+	// Top offset in DIP.
+	OffsetTop float64 `json:"offsetTop"`
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+	// Device screen width in DIP.
+	DeviceWidth float64 `json:"deviceWidth"`
+	// Device screen height in DIP.
+	DeviceHeight float64 `json:"deviceHeight"`
+	// Position of horizontal scroll in CSS pixels.
+	ScrollOffsetX float64 `json:"scrollOffsetX"`
+	// Position of vertical scroll in CSS pixels.
+	ScrollOffsetY float64 `json:"scrollOffsetY"`
+	// Frame swap timestamp.
+	Timestamp *cdp.NetworkTimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// DialogType javascript dialog type.
+type DialogType string
+
+const (
+	DialogTypeAlert        DialogType = "alert"
+	DialogTypeConfirm      DialogType = "confirm"
+	DialogTypePrompt       DialogType = "prompt"
+	DialogTypeBeforeunload DialogType = "beforeunload"
+)
+
+// AppManifestError error while paring app manifest.
+type AppManifestError struct {
+	// Error message.
+	Message string `json:"message"`
+	// If critical, this is a non-recoverable parse error.
+	Critical int64 `json:"critical"`
+	// Error line.
+	Line int64 `json:"line"`
+	// Error column.
+	Column int64 `json:"column"`
+}
+
+// AppManifestParsedProperties parsed app manifest properties.
+//
+// Experimental.
+type AppManifestParsedProperties struct {
+	// Computed scope value.
+	Scope string `json:"scope"`
+}
+
+// LayoutViewport layout viewport position and dimensions.
+type LayoutViewport struct {
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX int64 `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY int64 `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth int64 `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight int64 `json:"clientHeight"`
+}
+
+// VisualViewport visual viewport position, dimensions, and scale.
+type VisualViewport struct {
+	// Horizontal offset relative to the layout viewport (CSS pixels).
+	OffsetX float64 `json:"offsetX"`
+	// Vertical offset relative to the layout viewport (CSS pixels).
+	OffsetY float64 `json:"offsetY"`
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX float64 `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY float64 `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth float64 `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight float64 `json:"clientHeight"`
+	// Scale relative to the ideal viewport (size at width=device-width).
+	Scale float64 `json:"scale"`
+	// Page zoom factor (CSS to device independent pixels ratio).
+	Zoom *float64 `json:"zoom,omitempty"`
+}
+
+// Viewport viewport for capturing screenshot.
+type Viewport = cdp.PageViewport
+
+// FontFamilies generic font families collection.
+//
+// Experimental.
+type FontFamilies struct {
+	// The standard font-family.
+	Standard string `json:"standard,omitempty"`
+	// The fixed font-family.
+	Fixed string `json:"fixed,omitempty"`
+	// The serif font-family.
+	Serif string `json:"serif,omitempty"`
+	// The sansSerif font-family.
+	SansSerif string `json:"sansSerif,omitempty"`
+	// The cursive font-family.
+	Cursive string `json:"cursive,omitempty"`
+	// The fantasy font-family.
+	Fantasy string `json:"fantasy,omitempty"`
+	// The math font-family.
+	Math string `json:"math,omitempty"`
+}
+
+// ScriptFontFamilies font families collection for a script.
+//
+// Experimental.
+type ScriptFontFamilies struct {
+	// Name of the script which these font families are defined for.
+	Script string `json:"script"`
+	// Generic font families collection for the script.
+	FontFamilies FontFamilies `json:"fontFamilies"`
+}
+
+// FontSizes default font sizes.
+//
+// Experimental.
+type FontSizes struct {
+	// Default standard font size.
+	Standard *int64 `json:"standard,omitempty"`
+	// Default fixed font size.
+	Fixed *int64 `json:"fixed,omitempty"`
+}
+
+// ClientNavigationReason is the Page.ClientNavigationReason type.
+//
+// Experimental.
+type ClientNavigationReason string
+
+const (
+	ClientNavigationReasonAnchorClick            ClientNavigationReason = "anchorClick"
+	ClientNavigationReasonFormSubmissionGet      ClientNavigationReason = "formSubmissionGet"
+	ClientNavigationReasonFormSubmissionPost     ClientNavigationReason = "formSubmissionPost"
+	ClientNavigationReasonHTTPHeaderRefresh      ClientNavigationReason = "httpHeaderRefresh"
+	ClientNavigationReasonInitialFrameNavigation ClientNavigationReason = "initialFrameNavigation"
+	ClientNavigationReasonMetaTagRefresh         ClientNavigationReason = "metaTagRefresh"
+	ClientNavigationReasonOther                  ClientNavigationReason = "other"
+	ClientNavigationReasonPageBlockInterstitial  ClientNavigationReason = "pageBlockInterstitial"
+	ClientNavigationReasonReload                 ClientNavigationReason = "reload"
+	ClientNavigationReasonScriptInitiated        ClientNavigationReason = "scriptInitiated"
+)
+
+// ClientNavigationDisposition is the Page.ClientNavigationDisposition type.
+//
+// Experimental.
+type ClientNavigationDisposition string
+
+const (
+	ClientNavigationDispositionCurrentTab ClientNavigationDisposition = "currentTab"
+	ClientNavigationDispositionNewTab     ClientNavigationDisposition = "newTab"
+	ClientNavigationDispositionNewWindow  ClientNavigationDisposition = "newWindow"
+	ClientNavigationDispositionDownload   ClientNavigationDisposition = "download"
+)
+
+// InstallabilityErrorArgument is the Page.InstallabilityErrorArgument type.
+//
+// Experimental.
+type InstallabilityErrorArgument struct {
+	// Argument name (e.g. name:'minimum-icon-size-in-pixels').
+	Name string `json:"name"`
+	// Argument value (e.g. value:'64').
+	Value string `json:"value"`
+}
+
+// InstallabilityError the installability error.
+//
+// Experimental.
+type InstallabilityError struct {
+	// The error id (e.g. 'manifest-missing-suitable-icon').
+	ErrorID string `json:"errorId"`
+	// The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels', value:'64'}).
+	ErrorArguments []InstallabilityErrorArgument `json:"errorArguments"`
+}
+
+// ReferrerPolicy the referring-policy used for the navigation.
+//
+// Experimental.
+type ReferrerPolicy string
+
+const (
+	ReferrerPolicyNoReferrer                  ReferrerPolicy = "noReferrer"
+	ReferrerPolicyNoReferrerWhenDowngrade     ReferrerPolicy = "noReferrerWhenDowngrade"
+	ReferrerPolicyOrigin                      ReferrerPolicy = "origin"
+	ReferrerPolicyOriginWhenCrossOrigin       ReferrerPolicy = "originWhenCrossOrigin"
+	ReferrerPolicySameOrigin                  ReferrerPolicy = "sameOrigin"
+	ReferrerPolicyStrictOrigin                ReferrerPolicy = "strictOrigin"
+	ReferrerPolicyStrictOriginWhenCrossOrigin ReferrerPolicy = "strictOriginWhenCrossOrigin"
+	ReferrerPolicyUnsafeURL                   ReferrerPolicy = "unsafeUrl"
+)
+
+// CompilationCacheParams per-script compilation cache parameters for `Page.produceCompilationCache`.
+//
+// Experimental.
+type CompilationCacheParams struct {
+	// The URL of the script to produce a compilation cache entry for.
+	URL string `json:"url"`
+	// A hint to the backend whether eager compilation is recommended.
+	// (the actual compilation mode used is upon backend discretion).
+	Eager *bool `json:"eager,omitempty"`
+}
+
+// FileFilter is the Page.FileFilter type.
+//
+// Experimental.
+type FileFilter struct {
+	Name    string   `json:"name,omitempty"`
+	Accepts []string `json:"accepts,omitempty"`
+}
+
+// FileHandler is the Page.FileHandler type.
+//
+// Experimental.
+type FileHandler struct {
+	Action string          `json:"action"`
+	Name   string          `json:"name"`
+	Icons  []ImageResource `json:"icons,omitempty"`
+	// Mimic a map, name is the key, accepts is the value.
+	Accepts []FileFilter `json:"accepts,omitempty"`
+	// Won't repeat the enums, using string for easy comparison. Same as the
+	// other enums below.
+	LaunchType string `json:"launchType"`
+}
+
+// ImageResource the image definition used in both icon and screenshot.
+//
+// Experimental.
+type ImageResource struct {
+	// The src field in the definition, but changing to url in favor of
+	// consistency.
+	URL   string `json:"url"`
+	Sizes string `json:"sizes,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// LaunchHandler is the Page.LaunchHandler type.
+//
+// Experimental.
+type LaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+// ProtocolHandler is the Page.ProtocolHandler type.
+//
+// Experimental.
+type ProtocolHandler struct {
+	Protocol string `json:"protocol"`
+	URL      string `json:"url"`
+}
+
+// RelatedApplication is the Page.RelatedApplication type.
+//
+// Experimental.
+type RelatedApplication struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url"`
+}
+
+// ScopeExtension is the Page.ScopeExtension type.
+//
+// Experimental.
+type ScopeExtension struct {
+	// Instead of using tuple, this field always returns the serialized string
+	// for easy understanding and comparison.
+	Origin            string `json:"origin"`
+	HasOriginWildcard bool   `json:"hasOriginWildcard"`
+}
+
+// Screenshot is the Page.Screenshot type.
+//
+// Experimental.
+type Screenshot struct {
+	Image      ImageResource `json:"image"`
+	FormFactor string        `json:"formFactor"`
+	Label      string        `json:"label,omitempty"`
+}
+
+// ShareTarget is the Page.ShareTarget type.
+//
+// Experimental.
+type ShareTarget struct {
+	Action  string `json:"action"`
+	Method  string `json:"method"`
+	Enctype string `json:"enctype"`
+	// Embed the ShareTargetParams.
+	Title string       `json:"title,omitempty"`
+	Text  string       `json:"text,omitempty"`
+	URL   string       `json:"url,omitempty"`
+	Files []FileFilter `json:"files,omitempty"`
+}
+
+// Shortcut is the Page.Shortcut type.
+//
+// Experimental.
+type Shortcut struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// WebAppManifest is the Page.WebAppManifest type.
+//
+// Experimental.
+type WebAppManifest struct {
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// The extra description provided by the manifest.
+	Description string `json:"description,omitempty"`
+	Dir         string `json:"dir,omitempty"`
+	Display     string `json:"display,omitempty"`
+	// The overrided display mode controlled by the user.
+	DisplayOverrides []string `json:"displayOverrides,omitempty"`
+	// The handlers to open files.
+	FileHandlers []FileHandler   `json:"fileHandlers,omitempty"`
+	Icons        []ImageResource `json:"icons,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	Lang         string          `json:"lang,omitempty"`
+	// TODO(crbug.com/1231886): This field is non-standard and part of a Chrome
+	// experiment. See:
+	// https://github.com/WICG/web-app-launch/blob/main/launch_handler.md.
+	LaunchHandler             *LaunchHandler `json:"launchHandler,omitempty"`
+	Name                      string         `json:"name,omitempty"`
+	Orientation               string         `json:"orientation,omitempty"`
+	PreferRelatedApplications *bool          `json:"preferRelatedApplications,omitempty"`
+	// The handlers to open protocols.
+	ProtocolHandlers    []ProtocolHandler    `json:"protocolHandlers,omitempty"`
+	RelatedApplications []RelatedApplication `json:"relatedApplications,omitempty"`
+	Scope               string               `json:"scope,omitempty"`
+	// Non-standard, see
+	// https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md.
+	ScopeExtensions []ScopeExtension `json:"scopeExtensions,omitempty"`
+	// The screenshots used by chromium.
+	Screenshots []Screenshot `json:"screenshots,omitempty"`
+	ShareTarget *ShareTarget `json:"shareTarget,omitempty"`
+	ShortName   string       `json:"shortName,omitempty"`
+	Shortcuts   []Shortcut   `json:"shortcuts,omitempty"`
+	StartURL    string       `json:"startUrl,omitempty"`
+	ThemeColor  string       `json:"themeColor,omitempty"`
+}
+
+// NavigationType the type of a frameNavigated event.
+//
+// Experimental.
+type NavigationType string
+
+const (
+	NavigationTypeNavigation              NavigationType = "Navigation"
+	NavigationTypeBackForwardCacheRestore NavigationType = "BackForwardCacheRestore"
+)
+
+// BackForwardCacheNotRestoredReason list of not restored reasons for back-forward cache.
+//
+// Experimental.
+type BackForwardCacheNotRestoredReason string
+
+const (
+	BackForwardCacheNotRestoredReasonNotPrimaryMainFrame                                      BackForwardCacheNotRestoredReason = "NotPrimaryMainFrame"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabled                                 BackForwardCacheNotRestoredReason = "BackForwardCacheDisabled"
+	BackForwardCacheNotRestoredReasonRelatedActiveContentsExist                               BackForwardCacheNotRestoredReason = "RelatedActiveContentsExist"
+	BackForwardCacheNotRestoredReasonHTTPStatusNotOK                                          BackForwardCacheNotRestoredReason = "HTTPStatusNotOK"
+	BackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS                                     BackForwardCacheNotRestoredReason = "SchemeNotHTTPOrHTTPS"
+	BackForwardCacheNotRestoredReasonLoading                                                  BackForwardCacheNotRestoredReason = "Loading"
+	BackForwardCacheNotRestoredReasonWasGrantedMediaAccess                                    BackForwardCacheNotRestoredReason = "WasGrantedMediaAccess"
+	BackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled                          BackForwardCacheNotRestoredReason = "DisableForRenderFrameHostCalled"
+	BackForwardCacheNotRestoredReasonDomainNotAllowed                                         BackForwardCacheNotRestoredReason = "DomainNotAllowed"
+	BackForwardCacheNotRestoredReasonHTTPMethodNotGET                                         BackForwardCacheNotRestoredReason = "HTTPMethodNotGET"
+	BackForwardCacheNotRestoredReasonSubframeIsNavigating                                     BackForwardCacheNotRestoredReason = "SubframeIsNavigating"
+	BackForwardCacheNotRestoredReasonTimeout                                                  BackForwardCacheNotRestoredReason = "Timeout"
+	BackForwardCacheNotRestoredReasonCacheLimit                                               BackForwardCacheNotRestoredReason = "CacheLimit"
+	BackForwardCacheNotRestoredReasonJavaScriptExecution                                      BackForwardCacheNotRestoredReason = "JavaScriptExecution"
+	BackForwardCacheNotRestoredReasonRendererProcessKilled                                    BackForwardCacheNotRestoredReason = "RendererProcessKilled"
+	BackForwardCacheNotRestoredReasonRendererProcessCrashed                                   BackForwardCacheNotRestoredReason = "RendererProcessCrashed"
+	BackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed                              BackForwardCacheNotRestoredReason = "SchedulerTrackedFeatureUsed"
+	BackForwardCacheNotRestoredReasonConflictingBrowsingInstance                              BackForwardCacheNotRestoredReason = "ConflictingBrowsingInstance"
+	BackForwardCacheNotRestoredReasonCacheFlushed                                             BackForwardCacheNotRestoredReason = "CacheFlushed"
+	BackForwardCacheNotRestoredReasonServiceWorkerVersionActivation                           BackForwardCacheNotRestoredReason = "ServiceWorkerVersionActivation"
+	BackForwardCacheNotRestoredReasonSessionRestored                                          BackForwardCacheNotRestoredReason = "SessionRestored"
+	BackForwardCacheNotRestoredReasonServiceWorkerPostMessage                                 BackForwardCacheNotRestoredReason = "ServiceWorkerPostMessage"
+	BackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded      BackForwardCacheNotRestoredReason = "EnteredBackForwardCacheBeforeServiceWorkerHostAdded"
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite                            BackForwardCacheNotRestoredReason = "RenderFrameHostReused_SameSite"
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite                           BackForwardCacheNotRestoredReason = "RenderFrameHostReused_CrossSite"
+	BackForwardCacheNotRestoredReasonServiceWorkerClaim                                       BackForwardCacheNotRestoredReason = "ServiceWorkerClaim"
+	BackForwardCacheNotRestoredReasonIgnoreEventAndEvict                                      BackForwardCacheNotRestoredReason = "IgnoreEventAndEvict"
+	BackForwardCacheNotRestoredReasonHaveInnerContents                                        BackForwardCacheNotRestoredReason = "HaveInnerContents"
+	BackForwardCacheNotRestoredReasonTimeoutPuttingInCache                                    BackForwardCacheNotRestoredReason = "TimeoutPuttingInCache"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory                      BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByLowMemory"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine                    BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByCommandLine"
+	BackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer             BackForwardCacheNotRestoredReason = "NetworkRequestDatapipeDrainedAsBytesConsumer"
+	BackForwardCacheNotRestoredReasonNetworkRequestRedirected                                 BackForwardCacheNotRestoredReason = "NetworkRequestRedirected"
+	BackForwardCacheNotRestoredReasonNetworkRequestTimeout                                    BackForwardCacheNotRestoredReason = "NetworkRequestTimeout"
+	BackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit                                BackForwardCacheNotRestoredReason = "NetworkExceedsBufferLimit"
+	BackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring                        BackForwardCacheNotRestoredReason = "NavigationCancelledWhileRestoring"
+	BackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry                             BackForwardCacheNotRestoredReason = "NotMostRecentNavigationEntry"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender                     BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForPrerender"
+	BackForwardCacheNotRestoredReasonUserAgentOverrideDiffers                                 BackForwardCacheNotRestoredReason = "UserAgentOverrideDiffers"
+	BackForwardCacheNotRestoredReasonForegroundCacheLimit                                     BackForwardCacheNotRestoredReason = "ForegroundCacheLimit"
+	BackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped                               BackForwardCacheNotRestoredReason = "BrowsingInstanceNotSwapped"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate                      BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForDelegate"
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame                           BackForwardCacheNotRestoredReason = "UnloadHandlerExistsInMainFrame"
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame                            BackForwardCacheNotRestoredReason = "UnloadHandlerExistsInSubFrame"
+	BackForwardCacheNotRestoredReasonServiceWorkerUnregistration                              BackForwardCacheNotRestoredReason = "ServiceWorkerUnregistration"
+	BackForwardCacheNotRestoredReasonCacheControlNoStore                                      BackForwardCacheNotRestoredReason = "CacheControlNoStore"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified                        BackForwardCacheNotRestoredReason = "CacheControlNoStoreCookieModified"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified                BackForwardCacheNotRestoredReason = "CacheControlNoStoreHTTPOnlyCookieModified"
+	BackForwardCacheNotRestoredReasonNoResponseHead                                           BackForwardCacheNotRestoredReason = "NoResponseHead"
+	BackForwardCacheNotRestoredReasonUnknown                                                  BackForwardCacheNotRestoredReason = "Unknown"
+	BackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857             BackForwardCacheNotRestoredReason = "ActivationNavigationsDisallowedForBug1234857"
+	BackForwardCacheNotRestoredReasonErrorDocument                                            BackForwardCacheNotRestoredReason = "ErrorDocument"
+	BackForwardCacheNotRestoredReasonFencedFramesEmbedder                                     BackForwardCacheNotRestoredReason = "FencedFramesEmbedder"
+	BackForwardCacheNotRestoredReasonCookieDisabled                                           BackForwardCacheNotRestoredReason = "CookieDisabled"
+	BackForwardCacheNotRestoredReasonHTTPAuthRequired                                         BackForwardCacheNotRestoredReason = "HTTPAuthRequired"
+	BackForwardCacheNotRestoredReasonCookieFlushed                                            BackForwardCacheNotRestoredReason = "CookieFlushed"
+	BackForwardCacheNotRestoredReasonBroadcastChannelOnMessage                                BackForwardCacheNotRestoredReason = "BroadcastChannelOnMessage"
+	BackForwardCacheNotRestoredReasonWebViewSettingsChanged                                   BackForwardCacheNotRestoredReason = "WebViewSettingsChanged"
+	BackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged                           BackForwardCacheNotRestoredReason = "WebViewJavaScriptObjectChanged"
+	BackForwardCacheNotRestoredReasonWebViewMessageListenerInjected                           BackForwardCacheNotRestoredReason = "WebViewMessageListenerInjected"
+	BackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged                      BackForwardCacheNotRestoredReason = "WebViewSafeBrowsingAllowlistChanged"
+	BackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged                    BackForwardCacheNotRestoredReason = "WebViewDocumentStartJavascriptChanged"
+	BackForwardCacheNotRestoredReasonWebSocket                                                BackForwardCacheNotRestoredReason = "WebSocket"
+	BackForwardCacheNotRestoredReasonWebTransport                                             BackForwardCacheNotRestoredReason = "WebTransport"
+	BackForwardCacheNotRestoredReasonWebRTC                                                   BackForwardCacheNotRestoredReason = "WebRTC"
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore                       BackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoStore"
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache                       BackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoCache"
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore                        BackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoStore"
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache                        BackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoCache"
+	BackForwardCacheNotRestoredReasonContainsPlugins                                          BackForwardCacheNotRestoredReason = "ContainsPlugins"
+	BackForwardCacheNotRestoredReasonDocumentLoaded                                           BackForwardCacheNotRestoredReason = "DocumentLoaded"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers                          BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestOthers"
+	BackForwardCacheNotRestoredReasonRequestedMIDIPermission                                  BackForwardCacheNotRestoredReason = "RequestedMIDIPermission"
+	BackForwardCacheNotRestoredReasonRequestedAudioCapturePermission                          BackForwardCacheNotRestoredReason = "RequestedAudioCapturePermission"
+	BackForwardCacheNotRestoredReasonRequestedVideoCapturePermission                          BackForwardCacheNotRestoredReason = "RequestedVideoCapturePermission"
+	BackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors                  BackForwardCacheNotRestoredReason = "RequestedBackForwardCacheBlockedSensors"
+	BackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission                        BackForwardCacheNotRestoredReason = "RequestedBackgroundWorkPermission"
+	BackForwardCacheNotRestoredReasonBroadcastChannel                                         BackForwardCacheNotRestoredReason = "BroadcastChannel"
+	BackForwardCacheNotRestoredReasonWebXR                                                    BackForwardCacheNotRestoredReason = "WebXR"
+	BackForwardCacheNotRestoredReasonSharedWorker                                             BackForwardCacheNotRestoredReason = "SharedWorker"
+	BackForwardCacheNotRestoredReasonSharedWorkerMessage                                      BackForwardCacheNotRestoredReason = "SharedWorkerMessage"
+	BackForwardCacheNotRestoredReasonWebLocks                                                 BackForwardCacheNotRestoredReason = "WebLocks"
+	BackForwardCacheNotRestoredReasonWebHID                                                   BackForwardCacheNotRestoredReason = "WebHID"
+	BackForwardCacheNotRestoredReasonWebShare                                                 BackForwardCacheNotRestoredReason = "WebShare"
+	BackForwardCacheNotRestoredReasonRequestedStorageAccessGrant                              BackForwardCacheNotRestoredReason = "RequestedStorageAccessGrant"
+	BackForwardCacheNotRestoredReasonWebNfc                                                   BackForwardCacheNotRestoredReason = "WebNfc"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch                           BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestFetch"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR                             BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestXHR"
+	BackForwardCacheNotRestoredReasonAppBanner                                                BackForwardCacheNotRestoredReason = "AppBanner"
+	BackForwardCacheNotRestoredReasonPrinting                                                 BackForwardCacheNotRestoredReason = "Printing"
+	BackForwardCacheNotRestoredReasonWebDatabase                                              BackForwardCacheNotRestoredReason = "WebDatabase"
+	BackForwardCacheNotRestoredReasonPictureInPicture                                         BackForwardCacheNotRestoredReason = "PictureInPicture"
+	BackForwardCacheNotRestoredReasonSpeechRecognizer                                         BackForwardCacheNotRestoredReason = "SpeechRecognizer"
+	BackForwardCacheNotRestoredReasonIdleManager                                              BackForwardCacheNotRestoredReason = "IdleManager"
+	BackForwardCacheNotRestoredReasonPaymentManager                                           BackForwardCacheNotRestoredReason = "PaymentManager"
+	BackForwardCacheNotRestoredReasonSpeechSynthesis                                          BackForwardCacheNotRestoredReason = "SpeechSynthesis"
+	BackForwardCacheNotRestoredReasonKeyboardLock                                             BackForwardCacheNotRestoredReason = "KeyboardLock"
+	BackForwardCacheNotRestoredReasonWebOTPService                                            BackForwardCacheNotRestoredReason = "WebOTPService"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket                    BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestDirectSocket"
+	BackForwardCacheNotRestoredReasonInjectedJavascript                                       BackForwardCacheNotRestoredReason = "InjectedJavascript"
+	BackForwardCacheNotRestoredReasonInjectedStyleSheet                                       BackForwardCacheNotRestoredReason = "InjectedStyleSheet"
+	BackForwardCacheNotRestoredReasonKeepaliveRequest                                         BackForwardCacheNotRestoredReason = "KeepaliveRequest"
+	BackForwardCacheNotRestoredReasonIndexedDBEvent                                           BackForwardCacheNotRestoredReason = "IndexedDBEvent"
+	BackForwardCacheNotRestoredReasonDummy                                                    BackForwardCacheNotRestoredReason = "Dummy"
+	BackForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource      BackForwardCacheNotRestoredReason = "JsNetworkRequestReceivedCacheControlNoStoreResource"
+	BackForwardCacheNotRestoredReasonWebRTCSticky                                             BackForwardCacheNotRestoredReason = "WebRTCSticky"
+	BackForwardCacheNotRestoredReasonWebTransportSticky                                       BackForwardCacheNotRestoredReason = "WebTransportSticky"
+	BackForwardCacheNotRestoredReasonWebSocketSticky                                          BackForwardCacheNotRestoredReason = "WebSocketSticky"
+	BackForwardCacheNotRestoredReasonSmartCard                                                BackForwardCacheNotRestoredReason = "SmartCard"
+	BackForwardCacheNotRestoredReasonLiveMediaStreamTrack                                     BackForwardCacheNotRestoredReason = "LiveMediaStreamTrack"
+	BackForwardCacheNotRestoredReasonUnloadHandler                                            BackForwardCacheNotRestoredReason = "UnloadHandler"
+	BackForwardCacheNotRestoredReasonParserAborted                                            BackForwardCacheNotRestoredReason = "ParserAborted"
+	BackForwardCacheNotRestoredReasonContentSecurityHandler                                   BackForwardCacheNotRestoredReason = "ContentSecurityHandler"
+	BackForwardCacheNotRestoredReasonContentWebAuthenticationAPI                              BackForwardCacheNotRestoredReason = "ContentWebAuthenticationAPI"
+	BackForwardCacheNotRestoredReasonContentFileChooser                                       BackForwardCacheNotRestoredReason = "ContentFileChooser"
+	BackForwardCacheNotRestoredReasonContentSerial                                            BackForwardCacheNotRestoredReason = "ContentSerial"
+	BackForwardCacheNotRestoredReasonContentFileSystemAccess                                  BackForwardCacheNotRestoredReason = "ContentFileSystemAccess"
+	BackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost                        BackForwardCacheNotRestoredReason = "ContentMediaDevicesDispatcherHost"
+	BackForwardCacheNotRestoredReasonContentWebBluetooth                                      BackForwardCacheNotRestoredReason = "ContentWebBluetooth"
+	BackForwardCacheNotRestoredReasonContentWebUSB                                            BackForwardCacheNotRestoredReason = "ContentWebUSB"
+	BackForwardCacheNotRestoredReasonContentMediaSessionService                               BackForwardCacheNotRestoredReason = "ContentMediaSessionService"
+	BackForwardCacheNotRestoredReasonContentScreenReader                                      BackForwardCacheNotRestoredReason = "ContentScreenReader"
+	BackForwardCacheNotRestoredReasonContentDiscarded                                         BackForwardCacheNotRestoredReason = "ContentDiscarded"
+	BackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper                            BackForwardCacheNotRestoredReason = "EmbedderPopupBlockerTabHelper"
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker                BackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingTriggeredPopupBlocker"
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails                        BackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingThreatDetails"
+	BackForwardCacheNotRestoredReasonEmbedderAppBannerManager                                 BackForwardCacheNotRestoredReason = "EmbedderAppBannerManager"
+	BackForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource                         BackForwardCacheNotRestoredReason = "EmbedderDomDistillerViewerSource"
+	BackForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate          BackForwardCacheNotRestoredReason = "EmbedderDomDistillerSelfDeletingRequestDelegate"
+	BackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper                         BackForwardCacheNotRestoredReason = "EmbedderOomInterventionTabHelper"
+	BackForwardCacheNotRestoredReasonEmbedderOfflinePage                                      BackForwardCacheNotRestoredReason = "EmbedderOfflinePage"
+	BackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager BackForwardCacheNotRestoredReason = "EmbedderChromePasswordManagerClientBindCredentialManager"
+	BackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager                         BackForwardCacheNotRestoredReason = "EmbedderPermissionRequestManager"
+	BackForwardCacheNotRestoredReasonEmbedderModalDialog                                      BackForwardCacheNotRestoredReason = "EmbedderModalDialog"
+	BackForwardCacheNotRestoredReasonEmbedderExtensions                                       BackForwardCacheNotRestoredReason = "EmbedderExtensions"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessaging                               BackForwardCacheNotRestoredReason = "EmbedderExtensionMessaging"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort                    BackForwardCacheNotRestoredReason = "EmbedderExtensionMessagingForOpenPort"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame                BackForwardCacheNotRestoredReason = "EmbedderExtensionSentMessageToCachedFrame"
+	BackForwardCacheNotRestoredReasonRequestedByWebViewClient                                 BackForwardCacheNotRestoredReason = "RequestedByWebViewClient"
+	BackForwardCacheNotRestoredReasonPostMessageByWebViewClient                               BackForwardCacheNotRestoredReason = "PostMessageByWebViewClient"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated          BackForwardCacheNotRestoredReason = "CacheControlNoStoreDeviceBoundSessionTerminated"
+	BackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure                 BackForwardCacheNotRestoredReason = "CacheLimitPrunedOnModerateMemoryPressure"
+	BackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure                 BackForwardCacheNotRestoredReason = "CacheLimitPrunedOnCriticalMemoryPressure"
+)
+
+// BackForwardCacheNotRestoredReasonType types of not restored reasons for back-forward cache.
+//
+// Experimental.
+type BackForwardCacheNotRestoredReasonType string
+
+const (
+	BackForwardCacheNotRestoredReasonTypeSupportPending    BackForwardCacheNotRestoredReasonType = "SupportPending"
+	BackForwardCacheNotRestoredReasonTypePageSupportNeeded BackForwardCacheNotRestoredReasonType = "PageSupportNeeded"
+	BackForwardCacheNotRestoredReasonTypeCircumstantial    BackForwardCacheNotRestoredReasonType = "Circumstantial"
+)
+
+// BackForwardCacheBlockingDetails is the Page.BackForwardCacheBlockingDetails type.
+//
+// Experimental.
+type BackForwardCacheBlockingDetails struct {
+	// Url of the file where blockage happened. Optional because of tests.
+	URL string `json:"url,omitempty"`
+	// Function name where blockage happened. Optional because of anonymous functions and tests.
+	Function string `json:"function,omitempty"`
+	// Line number in the script (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// BackForwardCacheNotRestoredExplanation is the Page.BackForwardCacheNotRestoredExplanation type.
+//
+// Experimental.
+type BackForwardCacheNotRestoredExplanation struct {
+	// Type of the reason.
+	Type BackForwardCacheNotRestoredReasonType `json:"type"`
+	// Not restored reason.
+	Reason BackForwardCacheNotRestoredReason `json:"reason"`
+	// Context associated with the reason. The meaning of this context is
+	// dependent on the reason:
+	// - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Context string                            `json:"context,omitempty"`
+	Details []BackForwardCacheBlockingDetails `json:"details,omitempty"`
+}
+
+// BackForwardCacheNotRestoredExplanationTree is the Page.BackForwardCacheNotRestoredExplanationTree type.
+//
+// Experimental.
+type BackForwardCacheNotRestoredExplanationTree struct {
+	// URL of each frame.
+	URL string `json:"url"`
+	// Not restored reasons of each frame.
+	Explanations []BackForwardCacheNotRestoredExplanation `json:"explanations"`
+	// Array of children frame.
+	Children []BackForwardCacheNotRestoredExplanationTree `json:"children"`
+}
+
+// AddScriptToEvaluateOnLoadParams are the parameters of AddScriptToEvaluateOnLoad.
+type AddScriptToEvaluateOnLoadParams struct {
+	ScriptSource string `json:"scriptSource"`
+}
+
+// AddScriptToEvaluateOnLoadResult is the result of AddScriptToEvaluateOnLoad.
+type AddScriptToEvaluateOnLoadResult struct {
+	// Identifier of the added script.
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// AddScriptToEvaluateOnLoad deprecated, please use addScriptToEvaluateOnNewDocument instead.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func AddScriptToEvaluateOnLoad(ctx context.Context, session bidi.Transport, params AddScriptToEvaluateOnLoadParams) (*AddScriptToEvaluateOnLoadResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[AddScriptToEvaluateOnLoadResult]("Page.addScriptToEvaluateOnLoad", params))
+}
+
+// AddScriptToEvaluateOnNewDocumentParams are the parameters of AddScriptToEvaluateOnNewDocument.
+type AddScriptToEvaluateOnNewDocumentParams struct {
+	Source string `json:"source"`
+	// If specified, creates an isolated world with the given name and evaluates given script in it.
+	// This world name will be used as the ExecutionContextDescription::name when the corresponding
+	// event is emitted.
+	WorldName string `json:"worldName,omitempty"`
+	// Specifies whether command line API should be available to the script, defaults
+	// to false.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	// If true, runs the script immediately on existing execution contexts or worlds.
+	// Default: false.
+	RunImmediately *bool `json:"runImmediately,omitempty"`
+}
+
+// AddScriptToEvaluateOnNewDocumentResult is the result of AddScriptToEvaluateOnNewDocument.
+type AddScriptToEvaluateOnNewDocumentResult struct {
+	// Identifier of the added script.
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// AddScriptToEvaluateOnNewDocument evaluates given script in every frame upon creation (before loading frame's scripts).
+func AddScriptToEvaluateOnNewDocument(ctx context.Context, session bidi.Transport, params AddScriptToEvaluateOnNewDocumentParams) (*AddScriptToEvaluateOnNewDocumentResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[AddScriptToEvaluateOnNewDocumentResult]("Page.addScriptToEvaluateOnNewDocument", params))
+}
+
+// BringToFront brings page to front (activates tab).
+func BringToFront(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.bringToFront", nil))
+
+	return err
+}
+
+// CaptureScreenshotParams are the parameters of CaptureScreenshot.
+type CaptureScreenshotParams struct {
+	// Image compression format (defaults to png).
+	//
+	// Values: jpeg, png, webp.
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100] (jpeg only).
+	Quality *int64 `json:"quality,omitempty"`
+	// Capture the screenshot of a given region only.
+	Clip *Viewport `json:"clip,omitempty"`
+	// Capture the screenshot from the surface, rather than the view. Defaults to true.
+	FromSurface *bool `json:"fromSurface,omitempty"`
+	// Capture the screenshot beyond the viewport. Defaults to false.
+	CaptureBeyondViewport *bool `json:"captureBeyondViewport,omitempty"`
+	// Optimize image encoding for speed, not for resulting size (defaults to false).
+	OptimizeForSpeed *bool `json:"optimizeForSpeed,omitempty"`
+}
+
+// CaptureScreenshotResult is the result of CaptureScreenshot.
+type CaptureScreenshotResult struct {
+	// Base64-encoded image data. (Encoded as a base64 string when passed over JSON).
+	Data string `json:"data"`
+}
+
+// CaptureScreenshot capture page screenshot.
+func CaptureScreenshot(ctx context.Context, session bidi.Transport, params CaptureScreenshotParams) (*CaptureScreenshotResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CaptureScreenshotResult]("Page.captureScreenshot", params))
+}
+
+// CaptureSnapshotParams are the parameters of CaptureSnapshot.
+type CaptureSnapshotParams struct {
+	// Format (defaults to mhtml).
+	//
+	// Values: mhtml.
+	Format string `json:"format,omitempty"`
+}
+
+// CaptureSnapshotResult is the result of CaptureSnapshot.
+type CaptureSnapshotResult struct {
+	// Serialized page data.
+	Data string `json:"data"`
+}
+
+// CaptureSnapshot returns a snapshot of the page as a string. For MHTML format, the serialization includes
+// iframes, shadow DOM, external resources, and element-inline styles.
+//
+// Experimental.
+func CaptureSnapshot(ctx context.Context, session bidi.Transport, params CaptureSnapshotParams) (*CaptureSnapshotResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CaptureSnapshotResult]("Page.captureSnapshot", params))
+}
+
+// ClearDeviceMetricsOverride clears the overridden device metrics.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func ClearDeviceMetricsOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.clearDeviceMetricsOverride", nil))
+
+	return err
+}
+
+// ClearDeviceOrientationOverride clears the overridden Device Orientation.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func ClearDeviceOrientationOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.clearDeviceOrientationOverride", nil))
+
+	return err
+}
+
+// ClearGeolocationOverride clears the overridden Geolocation Position and Error.
+//
+// Deprecated: deprecated in the protocol.
+func ClearGeolocationOverride(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.clearGeolocationOverride", nil))
+
+	return err
+}
+
+// CreateIsolatedWorldParams are the parameters of CreateIsolatedWorld.
+type CreateIsolatedWorldParams struct {
+	// Id of the frame in which the isolated world should be created.
+	FrameID FrameID `json:"frameId"`
+	// An optional name which is reported in the Execution Context.
+	WorldName string `json:"worldName,omitempty"`
+	// Whether or not universal access should be granted to the isolated world. This is a powerful
+	// option, use with caution.
+	GrantUniveralAccess *bool `json:"grantUniveralAccess,omitempty"`
+}
+
+// CreateIsolatedWorldResult is the result of CreateIsolatedWorld.
+type CreateIsolatedWorldResult struct {
+	// Execution context of the isolated world.
+	ExecutionContextID cdp.RuntimeExecutionContextID `json:"executionContextId"`
+}
+
+// CreateIsolatedWorld creates an isolated world for the given frame.
+func CreateIsolatedWorld(ctx context.Context, session bidi.Transport, params CreateIsolatedWorldParams) (*CreateIsolatedWorldResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CreateIsolatedWorldResult]("Page.createIsolatedWorld", params))
+}
+
+// DeleteCookieParams are the parameters of DeleteCookie.
+type DeleteCookieParams struct {
+	// Name of the cookie to remove.
+	CookieName string `json:"cookieName"`
+	// URL to match cooke domain and path.
+	URL string `json:"url"`
+}
+
+// DeleteCookie deletes browser cookie with given name, domain and path.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func DeleteCookie(ctx context.Context, session bidi.Transport, params DeleteCookieParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.deleteCookie", params))
+
+	return err
+}
+
+// Disable disables page domain notifications.
+func Disable(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.disable", nil))
+
+	return err
+}
+
+// EnableParams are the parameters of Enable.
+type EnableParams struct {
+	// If true, the `Page.fileChooserOpened` event will be emitted regardless of the state set by
+	// `Page.setInterceptFileChooserDialog` command (default: false).
+	EnableFileChooserOpenedEvent *bool `json:"enableFileChooserOpenedEvent,omitempty"`
+}
+
+// Enable enables page domain notifications.
+func Enable(ctx context.Context, session bidi.Transport, params EnableParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.enable", params))
+
+	return err
+}
+
+// GetAppManifestParams are the parameters of GetAppManifest.
+type GetAppManifestParams struct {
+	ManifestID string `json:"manifestId,omitempty"`
+}
+
+// GetAppManifestResult is the result of GetAppManifest.
+type GetAppManifestResult struct {
+	// Manifest location.
+	URL    string             `json:"url"`
+	Errors []AppManifestError `json:"errors"`
+	// Manifest content.
+	Data string `json:"data,omitempty"`
+	// Parsed manifest properties. Deprecated, use manifest instead.
+	//
+	// Deprecated: deprecated in the protocol.
+	Parsed   *AppManifestParsedProperties `json:"parsed,omitempty"`
+	Manifest WebAppManifest               `json:"manifest"`
+}
+
+// GetAppManifest gets the processed manifest for this current document.
+//
+//	This API always waits for the manifest to be loaded.
+//	If manifestId is provided, and it does not match the manifest of the
+//	  current document, this API errors out.
+//	If there is not a loaded page, this API errors out immediately.
+func GetAppManifest(ctx context.Context, session bidi.Transport, params GetAppManifestParams) (*GetAppManifestResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetAppManifestResult]("Page.getAppManifest", params))
+}
+
+// GetInstallabilityErrorsResult is the result of GetInstallabilityErrors.
+type GetInstallabilityErrorsResult struct {
+	InstallabilityErrors []InstallabilityError `json:"installabilityErrors"`
+}
+
+// GetInstallabilityErrors runs Page.getInstallabilityErrors.
+//
+// Experimental.
+func GetInstallabilityErrors(ctx context.Context, session bidi.Transport) (*GetInstallabilityErrorsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetInstallabilityErrorsResult]("Page.getInstallabilityErrors", nil))
+}
+
+// GetManifestIconsResult is the result of GetManifestIcons.
+type GetManifestIconsResult struct {
+	PrimaryIcon string `json:"primaryIcon,omitempty"`
+}
+
+// GetManifestIcons deprecated because it's not guaranteed that the returned icon is in fact the one used for PWA installation.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func GetManifestIcons(ctx context.Context, session bidi.Transport) (*GetManifestIconsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetManifestIconsResult]("Page.getManifestIcons", nil))
+}
+
+// GetAppIDResult is the result of GetAppID.
+type GetAppIDResult struct {
+	// App id, either from manifest's id attribute or computed from start_url.
+	AppID string `json:"appId,omitempty"`
+	// Recommendation for manifest's id attribute to match current id computed from start_url.
+	RecommendedID string `json:"recommendedId,omitempty"`
+}
+
+// GetAppID returns the unique (PWA) app id.
+// Only returns values if the feature flag 'WebAppEnableManifestId' is enabled.
+//
+// Experimental.
+func GetAppID(ctx context.Context, session bidi.Transport) (*GetAppIDResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetAppIDResult]("Page.getAppId", nil))
+}
+
+// GetAdScriptAncestryParams are the parameters of GetAdScriptAncestry.
+type GetAdScriptAncestryParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// GetAdScriptAncestryResult is the result of GetAdScriptAncestry.
+type GetAdScriptAncestryResult struct {
+	// The ancestry chain of ad script identifiers leading to this frame's
+	// creation, along with the root script's filterlist rule. The ancestry
+	// chain is ordered from the most immediate script (in the frame creation
+	// stack) to more distant ancestors (that created the immediately preceding
+	// script). Only sent if frame is labelled as an ad and ids are available.
+	AdScriptAncestry *AdScriptAncestry `json:"adScriptAncestry,omitempty"`
+}
+
+// GetAdScriptAncestry runs Page.getAdScriptAncestry.
+//
+// Experimental.
+func GetAdScriptAncestry(ctx context.Context, session bidi.Transport, params GetAdScriptAncestryParams) (*GetAdScriptAncestryResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetAdScriptAncestryResult]("Page.getAdScriptAncestry", params))
+}
+
+// GetFrameTreeResult is the result of GetFrameTree.
+type GetFrameTreeResult struct {
+	// Present frame tree structure.
+	FrameTree FrameTree `json:"frameTree"`
+}
+
+// GetFrameTree returns present frame tree structure.
+func GetFrameTree(ctx context.Context, session bidi.Transport) (*GetFrameTreeResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetFrameTreeResult]("Page.getFrameTree", nil))
+}
+
+// GetLayoutMetricsResult is the result of GetLayoutMetrics.
+type GetLayoutMetricsResult struct {
+	// Deprecated metrics relating to the layout viewport. Is in device pixels. Use `cssLayoutViewport` instead.
+	//
+	// Deprecated: deprecated in the protocol.
+	LayoutViewport LayoutViewport `json:"layoutViewport"`
+	// Deprecated metrics relating to the visual viewport. Is in device pixels. Use `cssVisualViewport` instead.
+	//
+	// Deprecated: deprecated in the protocol.
+	VisualViewport VisualViewport `json:"visualViewport"`
+	// Deprecated size of scrollable area. Is in DP. Use `cssContentSize` instead.
+	//
+	// Deprecated: deprecated in the protocol.
+	ContentSize cdp.DOMRect `json:"contentSize"`
+	// Metrics relating to the layout viewport in CSS pixels.
+	CSSLayoutViewport LayoutViewport `json:"cssLayoutViewport"`
+	// Metrics relating to the visual viewport in CSS pixels.
+	CSSVisualViewport VisualViewport `json:"cssVisualViewport"`
+	// Size of scrollable area in CSS pixels.
+	CSSContentSize cdp.DOMRect `json:"cssContentSize"`
+}
+
+// GetLayoutMetrics returns metrics relating to the layouting of the page, such as viewport bounds/scale.
+func GetLayoutMetrics(ctx context.Context, session bidi.Transport) (*GetLayoutMetricsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetLayoutMetricsResult]("Page.getLayoutMetrics", nil))
+}
+
+// GetNavigationHistoryResult is the result of GetNavigationHistory.
+type GetNavigationHistoryResult struct {
+	// Index of the current navigation history entry.
+	CurrentIndex int64 `json:"currentIndex"`
+	// Array of navigation history entries.
+	Entries []NavigationEntry `json:"entries"`
+}
+
+// GetNavigationHistory returns navigation history for the current page.
+func GetNavigationHistory(ctx context.Context, session bidi.Transport) (*GetNavigationHistoryResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetNavigationHistoryResult]("Page.getNavigationHistory", nil))
+}
+
+// ResetNavigationHistory resets navigation history for the current page.
+func ResetNavigationHistory(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.resetNavigationHistory", nil))
+
+	return err
+}
+
+// GetResourceContentParams are the parameters of GetResourceContent.
+type GetResourceContentParams struct {
+	// Frame id to get resource for.
+	FrameID FrameID `json:"frameId"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+}
+
+// GetResourceContentResult is the result of GetResourceContent.
+type GetResourceContentResult struct {
+	// Resource content.
+	Content string `json:"content"`
+	// True, if content was served as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// GetResourceContent returns content of the given resource.
+//
+// Experimental.
+func GetResourceContent(ctx context.Context, session bidi.Transport, params GetResourceContentParams) (*GetResourceContentResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetResourceContentResult]("Page.getResourceContent", params))
+}
+
+// GetResourceTreeResult is the result of GetResourceTree.
+type GetResourceTreeResult struct {
+	// Present frame / resource tree structure.
+	FrameTree FrameResourceTree `json:"frameTree"`
+}
+
+// GetResourceTree returns present frame / resource tree structure.
+//
+// Experimental.
+func GetResourceTree(ctx context.Context, session bidi.Transport) (*GetResourceTreeResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetResourceTreeResult]("Page.getResourceTree", nil))
+}
+
+// HandleJavaScriptDialogParams are the parameters of HandleJavaScriptDialog.
+type HandleJavaScriptDialogParams struct {
+	// Whether to accept or dismiss the dialog.
+	Accept bool `json:"accept"`
+	// The text to enter into the dialog prompt before accepting. Used only if this is a prompt
+	// dialog.
+	PromptText string `json:"promptText,omitempty"`
+}
+
+// HandleJavaScriptDialog accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).
+func HandleJavaScriptDialog(ctx context.Context, session bidi.Transport, params HandleJavaScriptDialogParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.handleJavaScriptDialog", params))
+
+	return err
+}
+
+// NavigateParams are the parameters of Navigate.
+type NavigateParams struct {
+	// URL to navigate the page to.
+	URL string `json:"url"`
+	// Referrer URL.
+	Referrer string `json:"referrer,omitempty"`
+	// Intended transition type.
+	TransitionType TransitionType `json:"transitionType,omitempty"`
+	// Frame id to navigate, if not specified navigates the top frame.
+	FrameID FrameID `json:"frameId,omitempty"`
+	// Referrer-policy used for the navigation.
+	ReferrerPolicy ReferrerPolicy `json:"referrerPolicy,omitempty"`
+}
+
+// NavigateResult is the result of Navigate.
+type NavigateResult struct {
+	// Frame id that has navigated (or failed to navigate).
+	FrameID FrameID `json:"frameId"`
+	// Loader identifier. This is omitted in case of same-document navigation,
+	// as the previously committed loaderId would not change.
+	LoaderID cdp.NetworkLoaderID `json:"loaderId,omitempty"`
+	// User friendly error message, present if and only if navigation has failed.
+	ErrorText string `json:"errorText,omitempty"`
+	// Whether the navigation resulted in a download.
+	IsDownload *bool `json:"isDownload,omitempty"`
+}
+
+// Navigate navigates current page to the given URL.
+func Navigate(ctx context.Context, session bidi.Transport, params NavigateParams) (*NavigateResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[NavigateResult]("Page.navigate", params))
+}
+
+// NavigateToHistoryEntryParams are the parameters of NavigateToHistoryEntry.
+type NavigateToHistoryEntryParams struct {
+	// Unique id of the entry to navigate to.
+	EntryID int64 `json:"entryId"`
+}
+
+// NavigateToHistoryEntry navigates current page to the given history entry.
+func NavigateToHistoryEntry(ctx context.Context, session bidi.Transport, params NavigateToHistoryEntryParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.navigateToHistoryEntry", params))
+
+	return err
+}
+
+// PrintToPDFParams are the parameters of PrintToPDF.
+type PrintToPDFParams struct {
+	// Paper orientation. Defaults to false.
+	Landscape *bool `json:"landscape,omitempty"`
+	// Display header and footer. Defaults to false.
+	DisplayHeaderFooter *bool `json:"displayHeaderFooter,omitempty"`
+	// Print background graphics. Defaults to false.
+	PrintBackground *bool `json:"printBackground,omitempty"`
+	// Scale of the webpage rendering. Defaults to 1.
+	Scale *float64 `json:"scale,omitempty"`
+	// Paper width in inches. Defaults to 8.5 inches.
+	PaperWidth *float64 `json:"paperWidth,omitempty"`
+	// Paper height in inches. Defaults to 11 inches.
+	PaperHeight *float64 `json:"paperHeight,omitempty"`
+	// Top margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginTop *float64 `json:"marginTop,omitempty"`
+	// Bottom margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginBottom *float64 `json:"marginBottom,omitempty"`
+	// Left margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginLeft *float64 `json:"marginLeft,omitempty"`
+	// Right margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginRight *float64 `json:"marginRight,omitempty"`
+	// Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are
+	// printed in the document order, not in the order specified, and no
+	// more than once.
+	// Defaults to empty string, which implies the entire document is printed.
+	// The page numbers are quietly capped to actual page count of the
+	// document, and ranges beyond the end of the document are ignored.
+	// If this results in no pages to print, an error is reported.
+	// It is an error to specify a range with start greater than end.
+	PageRanges string `json:"pageRanges,omitempty"`
+	// HTML template for the print header. Should be valid HTML markup with following
+	// classes used to inject printing values into them:
+	// - `date`: formatted print date
+	// - `title`: document title
+	// - `url`: document location
+	// - `pageNumber`: current page number
+	// - `totalPages`: total pages in the document
+	//
+	// For example, `<span class=title></span>` would generate span containing the title.
+	HeaderTemplate string `json:"headerTemplate,omitempty"`
+	// HTML template for the print footer. Should use the same format as the `headerTemplate`.
+	FooterTemplate string `json:"footerTemplate,omitempty"`
+	// Whether or not to prefer page size as defined by css. Defaults to false,
+	// in which case the content will be scaled to fit the paper size.
+	PreferCSSPageSize *bool `json:"preferCSSPageSize,omitempty"`
+	// return as stream.
+	//
+	// Values: ReturnAsBase64, ReturnAsStream.
+	TransferMode string `json:"transferMode,omitempty"`
+	// Whether or not to generate tagged (accessible) PDF. Defaults to embedder choice.
+	GenerateTaggedPDF *bool `json:"generateTaggedPDF,omitempty"`
+	// Whether or not to embed the document outline into the PDF.
+	GenerateDocumentOutline *bool `json:"generateDocumentOutline,omitempty"`
+}
+
+// PrintToPDFResult is the result of PrintToPDF.
+type PrintToPDFResult struct {
+	// Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as a base64 string when passed over JSON).
+	Data string `json:"data"`
+	// A handle of the stream that holds resulting PDF data.
+	Stream cdp.IOStreamHandle `json:"stream,omitempty"`
+}
+
+// PrintToPDF print page as PDF.
+func PrintToPDF(ctx context.Context, session bidi.Transport, params PrintToPDFParams) (*PrintToPDFResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[PrintToPDFResult]("Page.printToPDF", params))
+}
+
+// ReloadParams are the parameters of Reload.
+type ReloadParams struct {
+	// If true, browser cache is ignored (as if the user pressed Shift+refresh).
+	IgnoreCache *bool `json:"ignoreCache,omitempty"`
+	// If set, the script will be injected into all frames of the inspected page after reload.
+	// Argument will be ignored if reloading dataURL origin.
+	ScriptToEvaluateOnLoad string `json:"scriptToEvaluateOnLoad,omitempty"`
+	// If set, an error will be thrown if the target page's main frame's
+	// loader id does not match the provided id. This prevents accidentally
+	// reloading an unintended target in case there's a racing navigation.
+	LoaderID cdp.NetworkLoaderID `json:"loaderId,omitempty"`
+}
+
+// Reload reloads given page optionally ignoring the cache.
+func Reload(ctx context.Context, session bidi.Transport, params ReloadParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.reload", params))
+
+	return err
+}
+
+// RemoveScriptToEvaluateOnLoadParams are the parameters of RemoveScriptToEvaluateOnLoad.
+type RemoveScriptToEvaluateOnLoadParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// RemoveScriptToEvaluateOnLoad deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func RemoveScriptToEvaluateOnLoad(ctx context.Context, session bidi.Transport, params RemoveScriptToEvaluateOnLoadParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.removeScriptToEvaluateOnLoad", params))
+
+	return err
+}
+
+// RemoveScriptToEvaluateOnNewDocumentParams are the parameters of RemoveScriptToEvaluateOnNewDocument.
+type RemoveScriptToEvaluateOnNewDocumentParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// RemoveScriptToEvaluateOnNewDocument removes given script from the list.
+func RemoveScriptToEvaluateOnNewDocument(ctx context.Context, session bidi.Transport, params RemoveScriptToEvaluateOnNewDocumentParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.removeScriptToEvaluateOnNewDocument", params))
+
+	return err
+}
+
+// ScreencastFrameAckParams are the parameters of ScreencastFrameAck.
+type ScreencastFrameAckParams struct {
+	// Frame number.
+	SessionID int64 `json:"sessionId"`
+}
+
+// ScreencastFrameAck acknowledges that a screencast frame has been received by the frontend.
+//
+// Experimental.
+func ScreencastFrameAck(ctx context.Context, session bidi.Transport, params ScreencastFrameAckParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.screencastFrameAck", params))
+
+	return err
+}
+
+// SearchInResourceParams are the parameters of SearchInResource.
+type SearchInResourceParams struct {
+	// Frame id for resource to search in.
+	FrameID FrameID `json:"frameId"`
+	// URL of the resource to search in.
+	URL string `json:"url"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// SearchInResourceResult is the result of SearchInResource.
+type SearchInResourceResult struct {
+	// List of search matches.
+	Result []cdp.DebuggerSearchMatch `json:"result"`
+}
+
+// SearchInResource searches for given string in resource content.
+//
+// Experimental.
+func SearchInResource(ctx context.Context, session bidi.Transport, params SearchInResourceParams) (*SearchInResourceResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[SearchInResourceResult]("Page.searchInResource", params))
+}
+
+// SetAdBlockingEnabledParams are the parameters of SetAdBlockingEnabled.
+type SetAdBlockingEnabledParams struct {
+	// Whether to block ads.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAdBlockingEnabled enable Chrome's experimental ad filter on all sites.
+//
+// Experimental.
+func SetAdBlockingEnabled(ctx context.Context, session bidi.Transport, params SetAdBlockingEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setAdBlockingEnabled", params))
+
+	return err
+}
+
+// SetBypassCSPParams are the parameters of SetBypassCSP.
+type SetBypassCSPParams struct {
+	// Whether to bypass page CSP.
+	Enabled bool `json:"enabled"`
+}
+
+// SetBypassCSP enable page Content Security Policy by-passing.
+func SetBypassCSP(ctx context.Context, session bidi.Transport, params SetBypassCSPParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setBypassCSP", params))
+
+	return err
+}
+
+// GetPermissionsPolicyStateParams are the parameters of GetPermissionsPolicyState.
+type GetPermissionsPolicyStateParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// GetPermissionsPolicyStateResult is the result of GetPermissionsPolicyState.
+type GetPermissionsPolicyStateResult struct {
+	States []PermissionsPolicyFeatureState `json:"states"`
+}
+
+// GetPermissionsPolicyState get Permissions Policy state on given frame.
+//
+// Experimental.
+func GetPermissionsPolicyState(ctx context.Context, session bidi.Transport, params GetPermissionsPolicyStateParams) (*GetPermissionsPolicyStateResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetPermissionsPolicyStateResult]("Page.getPermissionsPolicyState", params))
+}
+
+// GetOriginTrialsParams are the parameters of GetOriginTrials.
+type GetOriginTrialsParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+// GetOriginTrialsResult is the result of GetOriginTrials.
+type GetOriginTrialsResult struct {
+	OriginTrials []OriginTrial `json:"originTrials"`
+}
+
+// GetOriginTrials get Origin Trials on given frame.
+//
+// Experimental.
+func GetOriginTrials(ctx context.Context, session bidi.Transport, params GetOriginTrialsParams) (*GetOriginTrialsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetOriginTrialsResult]("Page.getOriginTrials", params))
+}
+
+// SetDeviceMetricsOverrideParams are the parameters of SetDeviceMetricsOverride.
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Width int64 `json:"width"`
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height int64 `json:"height"`
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text
+	// autosizing and more.
+	Mobile bool `json:"mobile"`
+	// Scale to apply to resulting view image.
+	Scale *float64 `json:"scale,omitempty"`
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	ScreenWidth *int64 `json:"screenWidth,omitempty"`
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	ScreenHeight *int64 `json:"screenHeight,omitempty"`
+	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+	PositionX *int64 `json:"positionX,omitempty"`
+	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+	PositionY *int64 `json:"positionY,omitempty"`
+	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
+	// Screen orientation override.
+	ScreenOrientation *cdp.EmulationScreenOrientation `json:"screenOrientation,omitempty"`
+	// The viewport dimensions and scale. If not set, the override is cleared.
+	Viewport *Viewport `json:"viewport,omitempty"`
+}
+
+// SetDeviceMetricsOverride overrides the values of device screen dimensions (window.screen.width, window.screen.height,
+// window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
+// query results).
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetDeviceMetricsOverride(ctx context.Context, session bidi.Transport, params SetDeviceMetricsOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setDeviceMetricsOverride", params))
+
+	return err
+}
+
+// SetDeviceOrientationOverrideParams are the parameters of SetDeviceOrientationOverride.
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha.
+	Alpha float64 `json:"alpha"`
+	// Mock beta.
+	Beta float64 `json:"beta"`
+	// Mock gamma.
+	Gamma float64 `json:"gamma"`
+}
+
+// SetDeviceOrientationOverride overrides the Device Orientation.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetDeviceOrientationOverride(ctx context.Context, session bidi.Transport, params SetDeviceOrientationOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setDeviceOrientationOverride", params))
+
+	return err
+}
+
+// SetFontFamiliesParams are the parameters of SetFontFamilies.
+type SetFontFamiliesParams struct {
+	// Specifies font families to set. If a font family is not specified, it won't be changed.
+	FontFamilies FontFamilies `json:"fontFamilies"`
+	// Specifies font families to set for individual scripts.
+	ForScripts []ScriptFontFamilies `json:"forScripts,omitempty"`
+}
+
+// SetFontFamilies set generic font families.
+//
+// Experimental.
+func SetFontFamilies(ctx context.Context, session bidi.Transport, params SetFontFamiliesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setFontFamilies", params))
+
+	return err
+}
+
+// SetFontSizesParams are the parameters of SetFontSizes.
+type SetFontSizesParams struct {
+	// Specifies font sizes to set. If a font size is not specified, it won't be changed.
+	FontSizes FontSizes `json:"fontSizes"`
+}
+
+// SetFontSizes set default font sizes.
+//
+// Experimental.
+func SetFontSizes(ctx context.Context, session bidi.Transport, params SetFontSizesParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setFontSizes", params))
+
+	return err
+}
+
+// SetDocumentContentParams are the parameters of SetDocumentContent.
+type SetDocumentContentParams struct {
+	// Frame id to set HTML for.
+	FrameID FrameID `json:"frameId"`
+	// HTML content to set.
+	HTML string `json:"html"`
+}
+
+// SetDocumentContent sets given markup as the document's HTML.
+func SetDocumentContent(ctx context.Context, session bidi.Transport, params SetDocumentContentParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setDocumentContent", params))
+
+	return err
+}
+
+// SetDownloadBehaviorParams are the parameters of SetDownloadBehavior.
+type SetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome behavior if
+	// available (otherwise deny).
+	//
+	// Values: deny, allow, default.
+	Behavior string `json:"behavior"`
+	// The default path to save downloaded files to. This is required if behavior is set to 'allow'.
+	DownloadPath string `json:"downloadPath,omitempty"`
+}
+
+// SetDownloadBehavior set the behavior when downloading a file.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetDownloadBehavior(ctx context.Context, session bidi.Transport, params SetDownloadBehaviorParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setDownloadBehavior", params))
+
+	return err
+}
+
+// SetGeolocationOverrideParams are the parameters of SetGeolocationOverride.
+type SetGeolocationOverrideParams struct {
+	// Mock latitude.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Mock longitude.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Mock accuracy.
+	Accuracy *float64 `json:"accuracy,omitempty"`
+}
+
+// SetGeolocationOverride overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
+// unavailable.
+//
+// Deprecated: deprecated in the protocol.
+func SetGeolocationOverride(ctx context.Context, session bidi.Transport, params SetGeolocationOverrideParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setGeolocationOverride", params))
+
+	return err
+}
+
+// SetLifecycleEventsEnabledParams are the parameters of SetLifecycleEventsEnabled.
+type SetLifecycleEventsEnabledParams struct {
+	// If true, starts emitting lifecycle events.
+	Enabled bool `json:"enabled"`
+}
+
+// SetLifecycleEventsEnabled controls whether page will emit lifecycle events.
+func SetLifecycleEventsEnabled(ctx context.Context, session bidi.Transport, params SetLifecycleEventsEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setLifecycleEventsEnabled", params))
+
+	return err
+}
+
+// SetTouchEmulationEnabledParams are the parameters of SetTouchEmulationEnabled.
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+	// Touch/gesture events configuration. Default: current platform.
+	//
+	// Values: mobile, desktop.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// SetTouchEmulationEnabled toggles mouse event-based touch event emulation.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetTouchEmulationEnabled(ctx context.Context, session bidi.Transport, params SetTouchEmulationEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setTouchEmulationEnabled", params))
+
+	return err
+}
+
+// StartScreencastParams are the parameters of StartScreencast.
+type StartScreencastParams struct {
+	// Image compression format.
+	//
+	// Values: jpeg, png.
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100].
+	Quality *int64 `json:"quality,omitempty"`
+	// Maximum screenshot width.
+	MaxWidth *int64 `json:"maxWidth,omitempty"`
+	// Maximum screenshot height.
+	MaxHeight *int64 `json:"maxHeight,omitempty"`
+	// Send every n-th frame.
+	EveryNthFrame *int64 `json:"everyNthFrame,omitempty"`
+}
+
+// StartScreencast starts sending each frame using the `screencastFrame` event.
+//
+// Experimental.
+func StartScreencast(ctx context.Context, session bidi.Transport, params StartScreencastParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.startScreencast", params))
+
+	return err
+}
+
+// StopLoading force the page stop all navigations and pending resource fetches.
+func StopLoading(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.stopLoading", nil))
+
+	return err
+}
+
+// Crash crashes renderer on the IO thread, generates minidumps.
+//
+// Experimental.
+func Crash(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.crash", nil))
+
+	return err
+}
+
+// Close tries to close page, running its beforeunload hooks, if any.
+func Close(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.close", nil))
+
+	return err
+}
+
+// SetWebLifecycleStateParams are the parameters of SetWebLifecycleState.
+type SetWebLifecycleStateParams struct {
+	// Target lifecycle state.
+	//
+	// Values: frozen, active.
+	State string `json:"state"`
+}
+
+// SetWebLifecycleState tries to update the web lifecycle state of the page.
+// It will transition the page to the given state according to:
+// https://github.com/WICG/web-lifecycle/.
+//
+// Experimental.
+func SetWebLifecycleState(ctx context.Context, session bidi.Transport, params SetWebLifecycleStateParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setWebLifecycleState", params))
+
+	return err
+}
+
+// StopScreencast stops sending each frame in the `screencastFrame`.
+//
+// Experimental.
+func StopScreencast(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.stopScreencast", nil))
+
+	return err
+}
+
+// ProduceCompilationCacheParams are the parameters of ProduceCompilationCache.
+type ProduceCompilationCacheParams struct {
+	Scripts []CompilationCacheParams `json:"scripts"`
+}
+
+// ProduceCompilationCache requests backend to produce compilation cache for the specified scripts.
+// `scripts` are appended to the list of scripts for which the cache
+// would be produced. The list may be reset during page navigation.
+// When script with a matching URL is encountered, the cache is optionally
+// produced upon backend discretion, based on internal heuristics.
+// See also: `Page.compilationCacheProduced`.
+//
+// Experimental.
+func ProduceCompilationCache(ctx context.Context, session bidi.Transport, params ProduceCompilationCacheParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.produceCompilationCache", params))
+
+	return err
+}
+
+// AddCompilationCacheParams are the parameters of AddCompilationCache.
+type AddCompilationCacheParams struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON).
+	Data string `json:"data"`
+}
+
+// AddCompilationCache seeds compilation cache for given url. Compilation cache does not survive
+// cross-process navigation.
+//
+// Experimental.
+func AddCompilationCache(ctx context.Context, session bidi.Transport, params AddCompilationCacheParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.addCompilationCache", params))
+
+	return err
+}
+
+// ClearCompilationCache clears seeded compilation cache.
+//
+// Experimental.
+func ClearCompilationCache(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.clearCompilationCache", nil))
+
+	return err
+}
+
+// SetSPCTransactionModeParams are the parameters of SetSPCTransactionMode.
+type SetSPCTransactionModeParams struct {
+	// Values: none, autoAccept, autoChooseToAuthAnotherWay, autoReject, autoOptOut.
+	Mode string `json:"mode"`
+}
+
+// SetSPCTransactionMode sets the Secure Payment Confirmation transaction mode.
+// https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode.
+//
+// Experimental.
+func SetSPCTransactionMode(ctx context.Context, session bidi.Transport, params SetSPCTransactionModeParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setSPCTransactionMode", params))
+
+	return err
+}
+
+// SetRPHRegistrationModeParams are the parameters of SetRPHRegistrationMode.
+type SetRPHRegistrationModeParams struct {
+	// Values: none, autoAccept, autoReject.
+	Mode string `json:"mode"`
+}
+
+// SetRPHRegistrationMode extensions for Custom Handlers API:
+// https://html.spec.whatwg.org/multipage/system-state.html#rph-automation.
+//
+// Experimental.
+func SetRPHRegistrationMode(ctx context.Context, session bidi.Transport, params SetRPHRegistrationModeParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setRPHRegistrationMode", params))
+
+	return err
+}
+
+// GenerateTestReportParams are the parameters of GenerateTestReport.
+type GenerateTestReportParams struct {
+	// Message to be displayed in the report.
+	Message string `json:"message"`
+	// Specifies the endpoint group to deliver the report to.
+	Group string `json:"group,omitempty"`
+}
+
+// GenerateTestReport generates a report for testing.
+//
+// Experimental.
+func GenerateTestReport(ctx context.Context, session bidi.Transport, params GenerateTestReportParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.generateTestReport", params))
+
+	return err
+}
+
+// WaitForDebugger pauses page execution. Can be resumed using generic Runtime.runIfWaitingForDebugger.
+//
+// Experimental.
+func WaitForDebugger(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.waitForDebugger", nil))
+
+	return err
+}
+
+// SetInterceptFileChooserDialogParams are the parameters of SetInterceptFileChooserDialog.
+type SetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+	// If true, cancels the dialog by emitting relevant events (if any)
+	// in addition to not showing it if the interception is enabled
+	// (default: false).
+	Cancel *bool `json:"cancel,omitempty"`
+}
+
+// SetInterceptFileChooserDialog intercept file chooser requests and transfer control to protocol clients.
+// When file chooser interception is enabled, native file chooser dialog is not shown.
+// Instead, a protocol event `Page.fileChooserOpened` is emitted.
+func SetInterceptFileChooserDialog(ctx context.Context, session bidi.Transport, params SetInterceptFileChooserDialogParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setInterceptFileChooserDialog", params))
+
+	return err
+}
+
+// SetPrerenderingAllowedParams are the parameters of SetPrerenderingAllowed.
+type SetPrerenderingAllowedParams struct {
+	IsAllowed bool `json:"isAllowed"`
+}
+
+// SetPrerenderingAllowed enable/disable prerendering manually.
+//
+// This command is a short-term solution for https://crbug.com/1440085.
+// See https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA
+// for more details.
+//
+// TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.
+//
+// Experimental.
+func SetPrerenderingAllowed(ctx context.Context, session bidi.Transport, params SetPrerenderingAllowedParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Page.setPrerenderingAllowed", params))
+
+	return err
+}
+
+// DOMContentEventFiredEvent is sent on Page.domContentEventFired.
+type DOMContentEventFiredEvent struct {
+	Timestamp cdp.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// EventDOMContentEventFired is the method of DOMContentEventFiredEvent.
+const EventDOMContentEventFired = "Page.domContentEventFired"
+
+// OnDOMContentEventFired calls handler for every DOMContentEventFiredEvent.
+//
+// The returned function removes the handler.
+func OnDOMContentEventFired(source cdp.EventSource, handler func(*DOMContentEventFiredEvent)) func() {
+	return cdp.On(source, EventDOMContentEventFired, handler)
+}
+
+// FileChooserOpenedEvent is sent on Page.fileChooserOpened.
+//
+// Emitted only when `page.interceptFileChooser` is enabled.
+type FileChooserOpenedEvent struct {
+	// Id of the frame containing input node.
+	FrameID FrameID `json:"frameId"`
+	// Input mode.
+	//
+	// Values: selectSingle, selectMultiple.
+	Mode string `json:"mode"`
+	// Input node id. Only present for file choosers opened via an `<input type="file">` element.
+	BackendNodeID *cdp.DOMBackendNodeID `json:"backendNodeId,omitempty"`
+}
+
+// EventFileChooserOpened is the method of FileChooserOpenedEvent.
+const EventFileChooserOpened = "Page.fileChooserOpened"
+
+// OnFileChooserOpened calls handler for every FileChooserOpenedEvent.
+//
+// The returned function removes the handler.
+func OnFileChooserOpened(source cdp.EventSource, handler func(*FileChooserOpenedEvent)) func() {
+	return cdp.On(source, EventFileChooserOpened, handler)
+}
+
+// FrameAttachedEvent is sent on Page.frameAttached.
+//
+// Fired when frame has been attached to its parent.
+type FrameAttachedEvent struct {
+	// Id of the frame that has been attached.
+	FrameID FrameID `json:"frameId"`
+	// Parent frame identifier.
+	ParentFrameID FrameID `json:"parentFrameId"`
+	// JavaScript stack trace of when frame was attached, only set if frame initiated from script.
+	Stack *cdp.RuntimeStackTrace `json:"stack,omitempty"`
+}
+
+// EventFrameAttached is the method of FrameAttachedEvent.
+const EventFrameAttached = "Page.frameAttached"
+
+// OnFrameAttached calls handler for every FrameAttachedEvent.
+//
+// The returned function removes the handler.
+func OnFrameAttached(source cdp.EventSource, handler func(*FrameAttachedEvent)) func() {
+	return cdp.On(source, EventFrameAttached, handler)
+}
+
+// FrameClearedScheduledNavigationEvent is sent on Page.frameClearedScheduledNavigation.
+//
+// Fired when frame no longer has a scheduled navigation.
+//
+// Deprecated: deprecated in the protocol.
+type FrameClearedScheduledNavigationEvent struct {
+	// Id of the frame that has cleared its scheduled navigation.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventFrameClearedScheduledNavigation is the method of FrameClearedScheduledNavigationEvent.
+const EventFrameClearedScheduledNavigation = "Page.frameClearedScheduledNavigation"
+
+// OnFrameClearedScheduledNavigation calls handler for every FrameClearedScheduledNavigationEvent.
+//
+// The returned function removes the handler.
+func OnFrameClearedScheduledNavigation(source cdp.EventSource, handler func(*FrameClearedScheduledNavigationEvent)) func() {
+	return cdp.On(source, EventFrameClearedScheduledNavigation, handler)
+}
+
+// FrameDetachedEvent is sent on Page.frameDetached.
+//
+// Fired when frame has been detached from its parent.
+type FrameDetachedEvent struct {
+	// Id of the frame that has been detached.
+	FrameID FrameID `json:"frameId"`
+	// Values: remove, swap.
+	Reason string `json:"reason"`
+}
+
+// EventFrameDetached is the method of FrameDetachedEvent.
+const EventFrameDetached = "Page.frameDetached"
+
+// OnFrameDetached calls handler for every FrameDetachedEvent.
+//
+// The returned function removes the handler.
+func OnFrameDetached(source cdp.EventSource, handler func(*FrameDetachedEvent)) func() {
+	return cdp.On(source, EventFrameDetached, handler)
+}
+
+// FrameSubtreeWillBeDetachedEvent is sent on Page.frameSubtreeWillBeDetached.
+//
+// Fired before frame subtree is detached. Emitted before any frame of the
+// subtree is actually detached.
+//
+// Experimental.
+type FrameSubtreeWillBeDetachedEvent struct {
+	// Id of the frame that is the root of the subtree that will be detached.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventFrameSubtreeWillBeDetached is the method of FrameSubtreeWillBeDetachedEvent.
+const EventFrameSubtreeWillBeDetached = "Page.frameSubtreeWillBeDetached"
+
+// OnFrameSubtreeWillBeDetached calls handler for every FrameSubtreeWillBeDetachedEvent.
+//
+// The returned function removes the handler.
+func OnFrameSubtreeWillBeDetached(source cdp.EventSource, handler func(*FrameSubtreeWillBeDetachedEvent)) func() {
+	return cdp.On(source, EventFrameSubtreeWillBeDetached, handler)
+}
+
+// FrameNavigatedEvent is sent on Page.frameNavigated.
+//
+// Fired once navigation of the frame has completed. Frame is now associated with the new loader.
+type FrameNavigatedEvent struct {
+	// Frame object.
+	Frame Frame          `json:"frame"`
+	Type  NavigationType `json:"type"`
+}
+
+// EventFrameNavigated is the method of FrameNavigatedEvent.
+const EventFrameNavigated = "Page.frameNavigated"
+
+// OnFrameNavigated calls handler for every FrameNavigatedEvent.
+//
+// The returned function removes the handler.
+func OnFrameNavigated(source cdp.EventSource, handler func(*FrameNavigatedEvent)) func() {
+	return cdp.On(source, EventFrameNavigated, handler)
+}
+
+// DocumentOpenedEvent is sent on Page.documentOpened.
+//
+// Fired when opening document to write to.
+//
+// Experimental.
+type DocumentOpenedEvent struct {
+	// Frame object.
+	Frame Frame `json:"frame"`
+}
+
+// EventDocumentOpened is the method of DocumentOpenedEvent.
+const EventDocumentOpened = "Page.documentOpened"
+
+// OnDocumentOpened calls handler for every DocumentOpenedEvent.
+//
+// The returned function removes the handler.
+func OnDocumentOpened(source cdp.EventSource, handler func(*DocumentOpenedEvent)) func() {
+	return cdp.On(source, EventDocumentOpened, handler)
+}
+
+// FrameResizedEvent is sent on Page.frameResized.
+//
+// Experimental.
+type FrameResizedEvent struct {
+}
+
+// EventFrameResized is the method of FrameResizedEvent.
+const EventFrameResized = "Page.frameResized"
+
+// OnFrameResized calls handler for every FrameResizedEvent.
+//
+// The returned function removes the handler.
+func OnFrameResized(source cdp.EventSource, handler func(*FrameResizedEvent)) func() {
+	return cdp.On(source, EventFrameResized, handler)
+}
+
+// FrameStartedNavigatingEvent is sent on Page.frameStartedNavigating.
+//
+// Fired when a navigation starts. This event is fired for both
+// renderer-initiated and browser-initiated navigations. For renderer-initiated
+// navigations, the event is fired after `frameRequestedNavigation`.
+// Navigation may still be cancelled after the event is issued. Multiple events
+// can be fired for a single navigation, for example, when a same-document
+// navigation becomes a cross-document navigation (such as in the case of a
+// frameset).
+//
+// Experimental.
+type FrameStartedNavigatingEvent struct {
+	// ID of the frame that is being navigated.
+	FrameID FrameID `json:"frameId"`
+	// The URL the navigation started with. The final URL can be different.
+	URL string `json:"url"`
+	// Loader identifier. Even though it is present in case of same-document
+	// navigation, the previously committed loaderId would not change unless
+	// the navigation changes from a same-document to a cross-document
+	// navigation.
+	LoaderID cdp.NetworkLoaderID `json:"loaderId"`
+	// Values: reload, reloadBypassingCache, restore, restoreWithPost, historySameDocument, historyDifferentDocument, sameDocument, differentDocument.
+	NavigationType string `json:"navigationType"`
+}
+
+// EventFrameStartedNavigating is the method of FrameStartedNavigatingEvent.
+const EventFrameStartedNavigating = "Page.frameStartedNavigating"
+
+// OnFrameStartedNavigating calls handler for every FrameStartedNavigatingEvent.
+//
+// The returned function removes the handler.
+func OnFrameStartedNavigating(source cdp.EventSource, handler func(*FrameStartedNavigatingEvent)) func() {
+	return cdp.On(source, EventFrameStartedNavigating, handler)
+}
+
+// FrameRequestedNavigationEvent is sent on Page.frameRequestedNavigation.
+//
+// Fired when a renderer-initiated navigation is requested.
+// Navigation may still be cancelled after the event is issued.
+//
+// Experimental.
+type FrameRequestedNavigationEvent struct {
+	// Id of the frame that is being navigated.
+	FrameID FrameID `json:"frameId"`
+	// The reason for the navigation.
+	Reason ClientNavigationReason `json:"reason"`
+	// The destination URL for the requested navigation.
+	URL string `json:"url"`
+	// The disposition for the navigation.
+	Disposition ClientNavigationDisposition `json:"disposition"`
+}
+
+// EventFrameRequestedNavigation is the method of FrameRequestedNavigationEvent.
+const EventFrameRequestedNavigation = "Page.frameRequestedNavigation"
+
+// OnFrameRequestedNavigation calls handler for every FrameRequestedNavigationEvent.
+//
+// The returned function removes the handler.
+func OnFrameRequestedNavigation(source cdp.EventSource, handler func(*FrameRequestedNavigationEvent)) func() {
+	return cdp.On(source, EventFrameRequestedNavigation, handler)
+}
+
+// FrameScheduledNavigationEvent is sent on Page.frameScheduledNavigation.
+//
+// Fired when frame schedules a potential navigation.
+//
+// Deprecated: deprecated in the protocol.
+type FrameScheduledNavigationEvent struct {
+	// Id of the frame that has scheduled a navigation.
+	FrameID FrameID `json:"frameId"`
+	// Delay (in seconds) until the navigation is scheduled to begin. The navigation is not
+	// guaranteed to start.
+	Delay float64 `json:"delay"`
+	// The reason for the navigation.
+	Reason ClientNavigationReason `json:"reason"`
+	// The destination URL for the scheduled navigation.
+	URL string `json:"url"`
+}
+
+// EventFrameScheduledNavigation is the method of FrameScheduledNavigationEvent.
+const EventFrameScheduledNavigation = "Page.frameScheduledNavigation"
+
+// OnFrameScheduledNavigation calls handler for every FrameScheduledNavigationEvent.
+//
+// The returned function removes the handler.
+func OnFrameScheduledNavigation(source cdp.EventSource, handler func(*FrameScheduledNavigationEvent)) func() {
+	return cdp.On(source, EventFrameScheduledNavigation, handler)
+}
+
+// FrameStartedLoadingEvent is sent on Page.frameStartedLoading.
+//
+// Fired when frame has started loading.
+//
+// Experimental.
+type FrameStartedLoadingEvent struct {
+	// Id of the frame that has started loading.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventFrameStartedLoading is the method of FrameStartedLoadingEvent.
+const EventFrameStartedLoading = "Page.frameStartedLoading"
+
+// OnFrameStartedLoading calls handler for every FrameStartedLoadingEvent.
+//
+// The returned function removes the handler.
+func OnFrameStartedLoading(source cdp.EventSource, handler func(*FrameStartedLoadingEvent)) func() {
+	return cdp.On(source, EventFrameStartedLoading, handler)
+}
+
+// FrameStoppedLoadingEvent is sent on Page.frameStoppedLoading.
+//
+// Fired when frame has stopped loading.
+//
+// Experimental.
+type FrameStoppedLoadingEvent struct {
+	// Id of the frame that has stopped loading.
+	FrameID FrameID `json:"frameId"`
+}
+
+// EventFrameStoppedLoading is the method of FrameStoppedLoadingEvent.
+const EventFrameStoppedLoading = "Page.frameStoppedLoading"
+
+// OnFrameStoppedLoading calls handler for every FrameStoppedLoadingEvent.
+//
+// The returned function removes the handler.
+func OnFrameStoppedLoading(source cdp.EventSource, handler func(*FrameStoppedLoadingEvent)) func() {
+	return cdp.On(source, EventFrameStoppedLoading, handler)
+}
+
+// DownloadWillBeginEvent is sent on Page.downloadWillBegin.
+//
+// Fired when page is about to start a download.
+// Deprecated. Use Browser.downloadWillBegin instead.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+type DownloadWillBeginEvent struct {
+	// Id of the frame that caused download to begin.
+	FrameID FrameID `json:"frameId"`
+	// Global unique identifier of the download.
+	GUID string `json:"guid"`
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+	// Suggested file name of the resource (the actual name of the file saved on disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// EventDownloadWillBegin is the method of DownloadWillBeginEvent.
+const EventDownloadWillBegin = "Page.downloadWillBegin"
+
+// OnDownloadWillBegin calls handler for every DownloadWillBeginEvent.
+//
+// The returned function removes the handler.
+func OnDownloadWillBegin(source cdp.EventSource, handler func(*DownloadWillBeginEvent)) func() {
+	return cdp.On(source, EventDownloadWillBegin, handler)
+}
+
+// DownloadProgressEvent is sent on Page.downloadProgress.
+//
+// Fired when download makes progress. Last call has |done| == true.
+// Deprecated. Use Browser.downloadProgress instead.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+type DownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	GUID string `json:"guid"`
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+	// Download status.
+	//
+	// Values: inProgress, completed, canceled.
+	State string `json:"state"`
+}
+
+// EventDownloadProgress is the method of DownloadProgressEvent.
+const EventDownloadProgress = "Page.downloadProgress"
+
+// OnDownloadProgress calls handler for every DownloadProgressEvent.
+//
+// The returned function removes the handler.
+func OnDownloadProgress(source cdp.EventSource, handler func(*DownloadProgressEvent)) func() {
+	return cdp.On(source, EventDownloadProgress, handler)
+}
+
+// InterstitialHiddenEvent is sent on Page.interstitialHidden.
+//
+// Fired when interstitial page was hidden.
+type InterstitialHiddenEvent struct {
+}
+
+// EventInterstitialHidden is the method of InterstitialHiddenEvent.
+const EventInterstitialHidden = "Page.interstitialHidden"
+
+// OnInterstitialHidden calls handler for every InterstitialHiddenEvent.
+//
+// The returned function removes the handler.
+func OnInterstitialHidden(source cdp.EventSource, handler func(*InterstitialHiddenEvent)) func() {
+	return cdp.On(source, EventInterstitialHidden, handler)
+}
+
+// InterstitialShownEvent is sent on Page.interstitialShown.
+//
+// Fired when interstitial page was shown.
+type InterstitialShownEvent struct {
+}
+
+// EventInterstitialShown is the method of InterstitialShownEvent.
+const EventInterstitialShown = "Page.interstitialShown"
+
+// OnInterstitialShown calls handler for every InterstitialShownEvent.
+//
+// The returned function removes the handler.
+func OnInterstitialShown(source cdp.EventSource, handler func(*InterstitialShownEvent)) func() {
+	return cdp.On(source, EventInterstitialShown, handler)
+}
+
+// JavascriptDialogClosedEvent is sent on Page.javascriptDialogClosed.
+//
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been
+// closed.
+type JavascriptDialogClosedEvent struct {
+	// Frame id.
+	FrameID FrameID `json:"frameId"`
+	// Whether dialog was confirmed.
+	Result bool `json:"result"`
+	// User input in case of prompt.
+	UserInput string `json:"userInput"`
+}
+
+// EventJavascriptDialogClosed is the method of JavascriptDialogClosedEvent.
+const EventJavascriptDialogClosed = "Page.javascriptDialogClosed"
+
+// OnJavascriptDialogClosed calls handler for every JavascriptDialogClosedEvent.
+//
+// The returned function removes the handler.
+func OnJavascriptDialogClosed(source cdp.EventSource, handler func(*JavascriptDialogClosedEvent)) func() {
+	return cdp.On(source, EventJavascriptDialogClosed, handler)
+}
+
+// JavascriptDialogOpeningEvent is sent on Page.javascriptDialogOpening.
+//
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to
+// open.
+type JavascriptDialogOpeningEvent struct {
+	// Frame url.
+	URL string `json:"url"`
+	// Frame id.
+	FrameID FrameID `json:"frameId"`
+	// Message that will be displayed by the dialog.
+	Message string `json:"message"`
+	// Dialog type.
+	Type DialogType `json:"type"`
+	// True iff browser is capable showing or acting on the given dialog. When browser has no
+	// dialog handler for given target, calling alert while Page domain is engaged will stall
+	// the page execution. Execution can be resumed via calling Page.handleJavaScriptDialog.
+	HasBrowserHandler bool `json:"hasBrowserHandler"`
+	// Default dialog prompt.
+	DefaultPrompt string `json:"defaultPrompt,omitempty"`
+}
+
+// EventJavascriptDialogOpening is the method of JavascriptDialogOpeningEvent.
+const EventJavascriptDialogOpening = "Page.javascriptDialogOpening"
+
+// OnJavascriptDialogOpening calls handler for every JavascriptDialogOpeningEvent.
+//
+// The returned function removes the handler.
+func OnJavascriptDialogOpening(source cdp.EventSource, handler func(*JavascriptDialogOpeningEvent)) func() {
+	return cdp.On(source, EventJavascriptDialogOpening, handler)
+}
+
+// LifecycleEventEvent is sent on Page.lifecycleEvent.
+//
+// Fired for lifecycle events (navigation, load, paint, etc) in the current
+// target (including local frames).
+type LifecycleEventEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID  cdp.NetworkLoaderID      `json:"loaderId"`
+	Name      string                   `json:"name"`
+	Timestamp cdp.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// EventLifecycleEvent is the method of LifecycleEventEvent.
+const EventLifecycleEvent = "Page.lifecycleEvent"
+
+// OnLifecycleEvent calls handler for every LifecycleEventEvent.
+//
+// The returned function removes the handler.
+func OnLifecycleEvent(source cdp.EventSource, handler func(*LifecycleEventEvent)) func() {
+	return cdp.On(source, EventLifecycleEvent, handler)
+}
+
+// BackForwardCacheNotUsedEvent is sent on Page.backForwardCacheNotUsed.
+//
+// Fired for failed bfcache history navigations if BackForwardCache feature is enabled. Do
+// not assume any ordering with the Page.frameNavigated event. This event is fired only for
+// main-frame history navigation where the document changes (non-same-document navigations),
+// when bfcache navigation fails.
+//
+// Experimental.
+type BackForwardCacheNotUsedEvent struct {
+	// The loader id for the associated navigation.
+	LoaderID cdp.NetworkLoaderID `json:"loaderId"`
+	// The frame id of the associated frame.
+	FrameID FrameID `json:"frameId"`
+	// Array of reasons why the page could not be cached. This must not be empty.
+	NotRestoredExplanations []BackForwardCacheNotRestoredExplanation `json:"notRestoredExplanations"`
+	// Tree structure of reasons why the page could not be cached for each frame.
+	NotRestoredExplanationsTree *BackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"`
+}
+
+// EventBackForwardCacheNotUsed is the method of BackForwardCacheNotUsedEvent.
+const EventBackForwardCacheNotUsed = "Page.backForwardCacheNotUsed"
+
+// OnBackForwardCacheNotUsed calls handler for every BackForwardCacheNotUsedEvent.
+//
+// The returned function removes the handler.
+func OnBackForwardCacheNotUsed(source cdp.EventSource, handler func(*BackForwardCacheNotUsedEvent)) func() {
+	return cdp.On(source, EventBackForwardCacheNotUsed, handler)
+}
+
+// LoadEventFiredEvent is sent on Page.loadEventFired.
+type LoadEventFiredEvent struct {
+	Timestamp cdp.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// EventLoadEventFired is the method of LoadEventFiredEvent.
+const EventLoadEventFired = "Page.loadEventFired"
+
+// OnLoadEventFired calls handler for every LoadEventFiredEvent.
+//
+// The returned function removes the handler.
+func OnLoadEventFired(source cdp.EventSource, handler func(*LoadEventFiredEvent)) func() {
+	return cdp.On(source, EventLoadEventFired, handler)
+}
+
+// NavigatedWithinDocumentEvent is sent on Page.navigatedWithinDocument.
+//
+// Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.
+//
+// Experimental.
+type NavigatedWithinDocumentEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+	// Frame's new url.
+	URL string `json:"url"`
+	// Navigation type.
+	//
+	// Values: fragment, historyApi, other.
+	NavigationType string `json:"navigationType"`
+}
+
+// EventNavigatedWithinDocument is the method of NavigatedWithinDocumentEvent.
+const EventNavigatedWithinDocument = "Page.navigatedWithinDocument"
+
+// OnNavigatedWithinDocument calls handler for every NavigatedWithinDocumentEvent.
+//
+// The returned function removes the handler.
+func OnNavigatedWithinDocument(source cdp.EventSource, handler func(*NavigatedWithinDocumentEvent)) func() {
+	return cdp.On(source, EventNavigatedWithinDocument, handler)
+}
+
+// ScreencastFrameEvent is sent on Page.screencastFrame.
+//
+// Compressed image data requested by the `startScreencast`.
+//
+// Experimental.
+type ScreencastFrameEvent struct {
+	// Base64-encoded compressed image. (Encoded as a base64 string when passed over JSON).
+	Data string `json:"data"`
+	// Screencast frame metadata.
+	Metadata ScreencastFrameMetadata `json:"metadata"`
+	// Frame number.
+	SessionID int64 `json:"sessionId"`
+}
+
+// EventScreencastFrame is the method of ScreencastFrameEvent.
+const EventScreencastFrame = "Page.screencastFrame"
+
+// OnScreencastFrame calls handler for every ScreencastFrameEvent.
+//
+// The returned function removes the handler.
+func OnScreencastFrame(source cdp.EventSource, handler func(*ScreencastFrameEvent)) func() {
+	return cdp.On(source, EventScreencastFrame, handler)
+}
+
+// ScreencastVisibilityChangedEvent is sent on Page.screencastVisibilityChanged.
+//
+// Fired when the page with currently enabled screencast was shown or hidden `.
+//
+// Experimental.
+type ScreencastVisibilityChangedEvent struct {
+	// True if the page is visible.
+	Visible bool `json:"visible"`
+}
+
+// EventScreencastVisibilityChanged is the method of ScreencastVisibilityChangedEvent.
+const EventScreencastVisibilityChanged = "Page.screencastVisibilityChanged"
+
+// OnScreencastVisibilityChanged calls handler for every ScreencastVisibilityChangedEvent.
+//
+// The returned function removes the handler.
+func OnScreencastVisibilityChanged(source cdp.EventSource, handler func(*ScreencastVisibilityChangedEvent)) func() {
+	return cdp.On(source, EventScreencastVisibilityChanged, handler)
+}
+
+// WindowOpenEvent is sent on Page.windowOpen.
+//
+// Fired when a new window is going to be opened, via window.open(), link click, form submission,
+// etc.
+type WindowOpenEvent struct {
+	// The URL for the new window.
+	URL string `json:"url"`
+	// Window name.
+	WindowName string `json:"windowName"`
+	// An array of enabled window features.
+	WindowFeatures []string `json:"windowFeatures"`
+	// Whether or not it was triggered by user gesture.
+	UserGesture bool `json:"userGesture"`
+}
+
+// EventWindowOpen is the method of WindowOpenEvent.
+const EventWindowOpen = "Page.windowOpen"
+
+// OnWindowOpen calls handler for every WindowOpenEvent.
+//
+// The returned function removes the handler.
+func OnWindowOpen(source cdp.EventSource, handler func(*WindowOpenEvent)) func() {
+	return cdp.On(source, EventWindowOpen, handler)
+}
+
+// CompilationCacheProducedEvent is sent on Page.compilationCacheProduced.
+//
+// Issued for every compilation cache generated.
+//
+// Experimental.
+type CompilationCacheProducedEvent struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON).
+	Data string `json:"data"`
+}
+
+// EventCompilationCacheProduced is the method of CompilationCacheProducedEvent.
+const EventCompilationCacheProduced = "Page.compilationCacheProduced"
+
+// OnCompilationCacheProduced calls handler for every CompilationCacheProducedEvent.
+//
+// The returned function removes the handler.
+func OnCompilationCacheProduced(source cdp.EventSource, handler func(*CompilationCacheProducedEvent)) func() {
+	return cdp.On(source, EventCompilationCacheProduced, handler)
+}
@@ -0,0 +1,93 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package performance provides typed bindings for the CDP Performance domain.
+package performance
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// Metric run-time execution metric.
+type Metric struct {
+	// Metric name.
+	Name string `json:"name"`
+	// Metric value.
+	Value float64 `json:"value"`
+}
+
+// Disable disable collecting and reporting metrics.
+func Disable(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Performance.disable", nil))
+
+	return err
+}
+
+// EnableParams are the parameters of Enable.
+type EnableParams struct {
+	// Time domain to use for collecting and reporting duration metrics.
+	//
+	// Values: timeTicks, threadTicks.
+	TimeDomain string `json:"timeDomain,omitempty"`
+}
+
+// Enable enable collecting and reporting metrics.
+func Enable(ctx context.Context, session bidi.Transport, params EnableParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Performance.enable", params))
+
+	return err
+}
+
+// SetTimeDomainParams are the parameters of SetTimeDomain.
+type SetTimeDomainParams struct {
+	// Time domain.
+	//
+	// Values: timeTicks, threadTicks.
+	TimeDomain string `json:"timeDomain"`
+}
+
+// SetTimeDomain sets time domain to use for collecting and reporting duration metrics.
+// Note that this must be called before enabling metrics collection. Calling
+// this method while metrics collection is enabled returns an error.
+//
+// Experimental.
+//
+// Deprecated: deprecated in the protocol.
+func SetTimeDomain(ctx context.Context, session bidi.Transport, params SetTimeDomainParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Performance.setTimeDomain", params))
+
+	return err
+}
+
+// GetMetricsResult is the result of GetMetrics.
+type GetMetricsResult struct {
+	// Current values for run-time metrics.
+	Metrics []Metric `json:"metrics"`
+}
+
+// GetMetrics retrieve current values of run-time metrics.
+func GetMetrics(ctx context.Context, session bidi.Transport) (*GetMetricsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetMetricsResult]("Performance.getMetrics", nil))
+}
+
+// MetricsEvent is sent on Performance.metrics.
+//
+// Current values of the metrics.
+type MetricsEvent struct {
+	// Current values of the metrics.
+	Metrics []Metric `json:"metrics"`
+	// Timestamp title.
+	Title string `json:"title"`
+}
+
+// EventMetrics is the method of MetricsEvent.
+const EventMetrics = "Performance.metrics"
+
+// OnMetrics calls handler for every MetricsEvent.
+//
+// The returned function removes the handler.
+func OnMetrics(source cdp.EventSource, handler func(*MetricsEvent)) func() {
+	return cdp.On(source, EventMetrics, handler)
+}
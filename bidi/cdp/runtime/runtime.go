@@ -0,0 +1,858 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package runtime provides typed bindings for the CDP Runtime domain.
+//
+// Runtime domain exposes JavaScript runtime by means of remote evaluation and mirror objects.
+// Evaluation results are returned as mirror object that expose object type, string representation
+// and unique identifier that can be used for further object reference. Original objects are
+// maintained in memory unless they are either explicitly released or are released along with the
+// other objects in their object group.
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// ScriptID unique script identifier.
+type ScriptID = cdp.RuntimeScriptID
+
+// SerializationOptions represents options for serialization. Overrides `generatePreview` and `returnByValue`.
+type SerializationOptions struct {
+	// Values: deep, json, idOnly.
+	Serialization string `json:"serialization"`
+	// Deep serialization depth. Default is full depth. Respected only in `deep` serialization mode.
+	MaxDepth *int64 `json:"maxDepth,omitempty"`
+	// Embedder-specific parameters. For example if connected to V8 in Chrome these control DOM
+	// serialization via `maxNodeDepth: integer` and `includeShadowTree: "none" | "open" | "all"`.
+	// Values can be only of type string or integer.
+	AdditionalParameters map[string]interface{} `json:"additionalParameters,omitempty"`
+}
+
+// DeepSerializedValue represents deep serialized value.
+type DeepSerializedValue = cdp.RuntimeDeepSerializedValue
+
+// RemoteObjectID unique object identifier.
+type RemoteObjectID = cdp.RuntimeRemoteObjectID
+
+// UnserializableValue primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`, `Infinity`,
+// `-Infinity`, and bigint literals.
+type UnserializableValue = cdp.RuntimeUnserializableValue
+
+// RemoteObject mirror object referencing original JavaScript object.
+type RemoteObject = cdp.RuntimeRemoteObject
+
+// CustomPreview is the Runtime.CustomPreview type.
+//
+// Experimental.
+type CustomPreview = cdp.RuntimeCustomPreview
+
+// ObjectPreview object containing abbreviated remote object value.
+//
+// Experimental.
+type ObjectPreview = cdp.RuntimeObjectPreview
+
+// PropertyPreview is the Runtime.PropertyPreview type.
+//
+// Experimental.
+type PropertyPreview = cdp.RuntimePropertyPreview
+
+// EntryPreview is the Runtime.EntryPreview type.
+//
+// Experimental.
+type EntryPreview = cdp.RuntimeEntryPreview
+
+// PropertyDescriptor object property descriptor.
+type PropertyDescriptor struct {
+	// Property name or symbol description.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RemoteObject `json:"value,omitempty"`
+	// True if the value associated with the property may be changed (data descriptors only).
+	Writable *bool `json:"writable,omitempty"`
+	// A function which serves as a getter for the property, or `undefined` if there is no getter
+	// (accessor descriptors only).
+	Get *RemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the property, or `undefined` if there is no setter
+	// (accessor descriptors only).
+	Set *RemoteObject `json:"set,omitempty"`
+	// True if the type of this property descriptor may be changed and if the property may be
+	// deleted from the corresponding object.
+	Configurable bool `json:"configurable"`
+	// True if this property shows up during enumeration of the properties on the corresponding
+	// object.
+	Enumerable bool `json:"enumerable"`
+	// True if the result was thrown during the evaluation.
+	WasThrown *bool `json:"wasThrown,omitempty"`
+	// True if the property is owned for the object.
+	IsOwn *bool `json:"isOwn,omitempty"`
+	// Property symbol object, if the property is of the `symbol` type.
+	Symbol *RemoteObject `json:"symbol,omitempty"`
+}
+
+// InternalPropertyDescriptor object internal property descriptor. This property isn't normally visible in JavaScript code.
+type InternalPropertyDescriptor struct {
+	// Conventional property name.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RemoteObject `json:"value,omitempty"`
+}
+
+// PrivatePropertyDescriptor object private field descriptor.
+//
+// Experimental.
+type PrivatePropertyDescriptor struct {
+	// Private property name.
+	Name string `json:"name"`
+	// The value associated with the private property.
+	Value *RemoteObject `json:"value,omitempty"`
+	// A function which serves as a getter for the private property,
+	// or `undefined` if there is no getter (accessor descriptors only).
+	Get *RemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the private property,
+	// or `undefined` if there is no setter (accessor descriptors only).
+	Set *RemoteObject `json:"set,omitempty"`
+}
+
+// CallArgument represents function call argument. Either remote object id `objectId`, primitive `value`,
+// unserializable primitive value or neither of (for undefined) them should be specified.
+type CallArgument struct {
+	// Primitive value or serializable javascript object.
+	Value json.RawMessage `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified.
+	UnserializableValue UnserializableValue `json:"unserializableValue,omitempty"`
+	// Remote object handle.
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+}
+
+// ExecutionContextID id of an execution context.
+type ExecutionContextID = cdp.RuntimeExecutionContextID
+
+// ExecutionContextDescription description of an isolated world.
+type ExecutionContextDescription struct {
+	// Unique id of the execution context. It can be used to specify in which execution context
+	// script evaluation should be performed.
+	ID ExecutionContextID `json:"id"`
+	// Execution context origin.
+	Origin string `json:"origin"`
+	// Human readable name describing given context.
+	Name string `json:"name"`
+	// A system-unique execution context identifier. Unlike the id, this is unique across
+	// multiple processes, so can be reliably used to identify specific context while backend
+	// performs a cross-process navigation.
+	UniqueID string `json:"uniqueId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}.
+	AuxData map[string]interface{} `json:"auxData,omitempty"`
+}
+
+// ExceptionDetails detailed information about exception (or error) that was thrown during script compilation or
+// execution.
+type ExceptionDetails struct {
+	// Exception id.
+	ExceptionID int64 `json:"exceptionId"`
+	// Exception text, which should be used together with exception object when available.
+	Text string `json:"text"`
+	// Line number of the exception location (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// Column number of the exception location (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+	// Script ID of the exception location.
+	ScriptID ScriptID `json:"scriptId,omitempty"`
+	// URL of the exception location, to be used when the script was not reported.
+	URL string `json:"url,omitempty"`
+	// JavaScript stack trace if available.
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+	// Exception object if available.
+	Exception *RemoteObject `json:"exception,omitempty"`
+	// Identifier of the context where exception happened.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// Dictionary with entries of meta data that the client associated
+	// with this exception, such as information about associated network
+	// requests, etc.
+	ExceptionMetaData map[string]interface{} `json:"exceptionMetaData,omitempty"`
+}
+
+// Timestamp number of milliseconds since epoch.
+type Timestamp float64
+
+// TimeDelta number of milliseconds.
+type TimeDelta float64
+
+// CallFrame stack entry for runtime errors and assertions.
+type CallFrame = cdp.RuntimeCallFrame
+
+// StackTrace call frames for assertions or error messages.
+type StackTrace = cdp.RuntimeStackTrace
+
+// UniqueDebuggerID unique identifier of current debugger.
+//
+// Experimental.
+type UniqueDebuggerID = cdp.RuntimeUniqueDebuggerID
+
+// StackTraceID if `debuggerId` is set stack trace comes from another debugger and can be resolved there. This
+// allows to track cross-debugger calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// Experimental.
+type StackTraceID = cdp.RuntimeStackTraceID
+
+// AwaitPromiseParams are the parameters of AwaitPromise.
+type AwaitPromiseParams struct {
+	// Identifier of the promise.
+	PromiseObjectID RemoteObjectID `json:"promiseObjectId"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+}
+
+// AwaitPromiseResult is the result of AwaitPromise.
+type AwaitPromiseResult struct {
+	// Promise result. Will contain rejected value if promise was rejected.
+	Result RemoteObject `json:"result"`
+	// Exception details if stack strace is available.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// AwaitPromise add handler to promise with given promise object id.
+func AwaitPromise(ctx context.Context, session bidi.Transport, params AwaitPromiseParams) (*AwaitPromiseResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[AwaitPromiseResult]("Runtime.awaitPromise", params))
+}
+
+// CallFunctionOnParams are the parameters of CallFunctionOn.
+type CallFunctionOnParams struct {
+	// Declaration of the function to call.
+	FunctionDeclaration string `json:"functionDeclaration"`
+	// Identifier of the object to call function on. Either objectId or executionContextId should
+	// be specified.
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+	// Call arguments. All call arguments must belong to the same JavaScript world as the target
+	// object.
+	Arguments []CallArgument `json:"arguments,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause
+	// execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by value.
+	// Can be overriden by `serializationOptions`.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture *bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is
+	// resolved.
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+	// Specifies execution context which global object will be used to call function on. Either
+	// executionContextId or objectId should be specified.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects. If objectGroup is not
+	// specified and objectId is, objectGroup will be inherited from object.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	// An alternative way to specify the execution context to call function on.
+	// Compared to contextId that may be reused across processes, this is guaranteed to be
+	// system-unique, so it can be used to prevent accidental function call
+	// in context different than intended (e.g. as a result of navigation across process
+	// boundaries).
+	// This is mutually exclusive with `executionContextId`.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides
+	// `generatePreview` and `returnByValue`.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// CallFunctionOnResult is the result of CallFunctionOn.
+type CallFunctionOnResult struct {
+	// Call result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// CallFunctionOn calls function with given declaration on the given object. Object group of the result is
+// inherited from the target object.
+func CallFunctionOn(ctx context.Context, session bidi.Transport, params CallFunctionOnParams) (*CallFunctionOnResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CallFunctionOnResult]("Runtime.callFunctionOn", params))
+}
+
+// CompileScriptParams are the parameters of CompileScript.
+type CompileScriptParams struct {
+	// Expression to compile.
+	Expression string `json:"expression"`
+	// Source url to be set for the script.
+	SourceURL string `json:"sourceURL"`
+	// Specifies whether the compiled script should be persisted.
+	PersistScript bool `json:"persistScript"`
+	// Specifies in which execution context to perform script run. If the parameter is omitted the
+	// evaluation will be performed in the context of the inspected page.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// CompileScriptResult is the result of CompileScript.
+type CompileScriptResult struct {
+	// Id of the script.
+	ScriptID ScriptID `json:"scriptId,omitempty"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// CompileScript compiles expression.
+func CompileScript(ctx context.Context, session bidi.Transport, params CompileScriptParams) (*CompileScriptResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CompileScriptResult]("Runtime.compileScript", params))
+}
+
+// Disable disables reporting of execution contexts creation.
+func Disable(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.disable", nil))
+
+	return err
+}
+
+// DiscardConsoleEntries discards collected exceptions and console API calls.
+func DiscardConsoleEntries(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.discardConsoleEntries", nil))
+
+	return err
+}
+
+// Enable enables reporting of execution contexts creation by means of `executionContextCreated` event.
+// When the reporting gets enabled the event will be sent immediately for each existing execution
+// context.
+func Enable(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.enable", nil))
+
+	return err
+}
+
+// EvaluateParams are the parameters of Evaluate.
+type EvaluateParams struct {
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Determines whether Command Line API should be available during the evaluation.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause
+	// execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
+	// Specifies in which execution context to perform evaluation. If the parameter is omitted the
+	// evaluation will be performed in the context of the inspected page.
+	// This is mutually exclusive with `uniqueContextId`, which offers an
+	// alternative way to identify the execution context that is more reliable
+	// in a multi-process environment.
+	ContextID *ExecutionContextID `json:"contextId,omitempty"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture *bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is
+	// resolved.
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	// This implies `disableBreaks` below.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	// Terminate execution after timing out (number of milliseconds).
+	Timeout *TimeDelta `json:"timeout,omitempty"`
+	// Disable breakpoints during execution.
+	DisableBreaks *bool `json:"disableBreaks,omitempty"`
+	// Setting this flag to true enables `let` re-declaration and top-level `await`.
+	// Note that `let` variables can only be re-declared if they originate from
+	// `replMode` themselves.
+	ReplMode *bool `json:"replMode,omitempty"`
+	// The Content Security Policy (CSP) for the target might block 'unsafe-eval'
+	// which includes eval(), Function(), setTimeout() and setInterval()
+	// when called with non-callable arguments. This flag bypasses CSP for this
+	// evaluation and allows unsafe-eval. Defaults to true.
+	AllowUnsafeEvalBlockedByCSP *bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+	// An alternative way to specify the execution context to evaluate in.
+	// Compared to contextId that may be reused across processes, this is guaranteed to be
+	// system-unique, so it can be used to prevent accidental evaluation of the expression
+	// in context different than intended (e.g. as a result of navigation across process
+	// boundaries).
+	// This is mutually exclusive with `contextId`.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides
+	// `generatePreview` and `returnByValue`.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// EvaluateResult is the result of Evaluate.
+type EvaluateResult struct {
+	// Evaluation result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Evaluate evaluates expression on global object.
+func Evaluate(ctx context.Context, session bidi.Transport, params EvaluateParams) (*EvaluateResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[EvaluateResult]("Runtime.evaluate", params))
+}
+
+// GetIsolateIDResult is the result of GetIsolateID.
+type GetIsolateIDResult struct {
+	// The isolate id.
+	ID string `json:"id"`
+}
+
+// GetIsolateID returns the isolate id.
+//
+// Experimental.
+func GetIsolateID(ctx context.Context, session bidi.Transport) (*GetIsolateIDResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetIsolateIDResult]("Runtime.getIsolateId", nil))
+}
+
+// GetHeapUsageResult is the result of GetHeapUsage.
+type GetHeapUsageResult struct {
+	// Used JavaScript heap size in bytes.
+	UsedSize float64 `json:"usedSize"`
+	// Allocated JavaScript heap size in bytes.
+	TotalSize float64 `json:"totalSize"`
+	// Used size in bytes in the embedder's garbage-collected heap.
+	EmbedderHeapUsedSize float64 `json:"embedderHeapUsedSize"`
+	// Size in bytes of backing storage for array buffers and external strings.
+	BackingStorageSize float64 `json:"backingStorageSize"`
+}
+
+// GetHeapUsage returns the JavaScript heap usage.
+// It is the total usage of the corresponding isolate not scoped to a particular Runtime.
+//
+// Experimental.
+func GetHeapUsage(ctx context.Context, session bidi.Transport) (*GetHeapUsageResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetHeapUsageResult]("Runtime.getHeapUsage", nil))
+}
+
+// GetPropertiesParams are the parameters of GetProperties.
+type GetPropertiesParams struct {
+	// Identifier of the object to return properties for.
+	ObjectID RemoteObjectID `json:"objectId"`
+	// If true, returns properties belonging only to the element itself, not to its prototype
+	// chain.
+	OwnProperties *bool `json:"ownProperties,omitempty"`
+	// If true, returns accessor properties (with getter/setter) only; internal properties are not
+	// returned either.
+	AccessorPropertiesOnly *bool `json:"accessorPropertiesOnly,omitempty"`
+	// Whether preview should be generated for the results.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// If true, returns non-indexed properties only.
+	NonIndexedPropertiesOnly *bool `json:"nonIndexedPropertiesOnly,omitempty"`
+}
+
+// GetPropertiesResult is the result of GetProperties.
+type GetPropertiesResult struct {
+	// Object properties.
+	Result []PropertyDescriptor `json:"result"`
+	// Internal object properties (only of the element itself).
+	InternalProperties []InternalPropertyDescriptor `json:"internalProperties,omitempty"`
+	// Object private properties.
+	PrivateProperties []PrivatePropertyDescriptor `json:"privateProperties,omitempty"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// GetProperties returns properties of a given object. Object group of the result is inherited from the target
+// object.
+func GetProperties(ctx context.Context, session bidi.Transport, params GetPropertiesParams) (*GetPropertiesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetPropertiesResult]("Runtime.getProperties", params))
+}
+
+// GlobalLexicalScopeNamesParams are the parameters of GlobalLexicalScopeNames.
+type GlobalLexicalScopeNamesParams struct {
+	// Specifies in which execution context to lookup global scope variables.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// GlobalLexicalScopeNamesResult is the result of GlobalLexicalScopeNames.
+type GlobalLexicalScopeNamesResult struct {
+	Names []string `json:"names"`
+}
+
+// GlobalLexicalScopeNames returns all let, const and class variables from global scope.
+func GlobalLexicalScopeNames(ctx context.Context, session bidi.Transport, params GlobalLexicalScopeNamesParams) (*GlobalLexicalScopeNamesResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GlobalLexicalScopeNamesResult]("Runtime.globalLexicalScopeNames", params))
+}
+
+// QueryObjectsParams are the parameters of QueryObjects.
+type QueryObjectsParams struct {
+	// Identifier of the prototype to return objects for.
+	PrototypeObjectID RemoteObjectID `json:"prototypeObjectId"`
+	// Symbolic group name that can be used to release the results.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+}
+
+// QueryObjectsResult is the result of QueryObjects.
+type QueryObjectsResult struct {
+	// Array with objects.
+	Objects RemoteObject `json:"objects"`
+}
+
+// QueryObjects runs Runtime.queryObjects.
+func QueryObjects(ctx context.Context, session bidi.Transport, params QueryObjectsParams) (*QueryObjectsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[QueryObjectsResult]("Runtime.queryObjects", params))
+}
+
+// ReleaseObjectParams are the parameters of ReleaseObject.
+type ReleaseObjectParams struct {
+	// Identifier of the object to release.
+	ObjectID RemoteObjectID `json:"objectId"`
+}
+
+// ReleaseObject releases remote object with given id.
+func ReleaseObject(ctx context.Context, session bidi.Transport, params ReleaseObjectParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.releaseObject", params))
+
+	return err
+}
+
+// ReleaseObjectGroupParams are the parameters of ReleaseObjectGroup.
+type ReleaseObjectGroupParams struct {
+	// Symbolic object group name.
+	ObjectGroup string `json:"objectGroup"`
+}
+
+// ReleaseObjectGroup releases all remote objects that belong to a given group.
+func ReleaseObjectGroup(ctx context.Context, session bidi.Transport, params ReleaseObjectGroupParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.releaseObjectGroup", params))
+
+	return err
+}
+
+// RunIfWaitingForDebugger tells inspected instance to run if it was waiting for debugger to attach.
+func RunIfWaitingForDebugger(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.runIfWaitingForDebugger", nil))
+
+	return err
+}
+
+// RunScriptParams are the parameters of RunScript.
+type RunScriptParams struct {
+	// Id of the script to run.
+	ScriptID ScriptID `json:"scriptId"`
+	// Specifies in which execution context to perform script run. If the parameter is omitted the
+	// evaluation will be performed in the context of the inspected page.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause
+	// execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
+	// Determines whether Command Line API should be available during the evaluation.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is
+	// resolved.
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+}
+
+// RunScriptResult is the result of RunScript.
+type RunScriptResult struct {
+	// Run result.
+	Result RemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RunScript runs script with given id in a given context.
+func RunScript(ctx context.Context, session bidi.Transport, params RunScriptParams) (*RunScriptResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[RunScriptResult]("Runtime.runScript", params))
+}
+
+// SetAsyncCallStackDepthParams are the parameters of SetAsyncCallStackDepth.
+type SetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async
+	// call stacks (default).
+	MaxDepth int64 `json:"maxDepth"`
+}
+
+// SetAsyncCallStackDepth enables or disables async call stacks tracking.
+func SetAsyncCallStackDepth(ctx context.Context, session bidi.Transport, params SetAsyncCallStackDepthParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.setAsyncCallStackDepth", params))
+
+	return err
+}
+
+// SetCustomObjectFormatterEnabledParams are the parameters of SetCustomObjectFormatterEnabled.
+type SetCustomObjectFormatterEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SetCustomObjectFormatterEnabled runs Runtime.setCustomObjectFormatterEnabled.
+//
+// Experimental.
+func SetCustomObjectFormatterEnabled(ctx context.Context, session bidi.Transport, params SetCustomObjectFormatterEnabledParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.setCustomObjectFormatterEnabled", params))
+
+	return err
+}
+
+// SetMaxCallStackSizeToCaptureParams are the parameters of SetMaxCallStackSizeToCapture.
+type SetMaxCallStackSizeToCaptureParams struct {
+	Size int64 `json:"size"`
+}
+
+// SetMaxCallStackSizeToCapture runs Runtime.setMaxCallStackSizeToCapture.
+//
+// Experimental.
+func SetMaxCallStackSizeToCapture(ctx context.Context, session bidi.Transport, params SetMaxCallStackSizeToCaptureParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.setMaxCallStackSizeToCapture", params))
+
+	return err
+}
+
+// TerminateExecution terminate current or next JavaScript execution.
+// Will cancel the termination when the outer-most script execution ends.
+//
+// Experimental.
+func TerminateExecution(ctx context.Context, session bidi.Transport) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.terminateExecution", nil))
+
+	return err
+}
+
+// AddBindingParams are the parameters of AddBinding.
+type AddBindingParams struct {
+	Name string `json:"name"`
+	// If specified, the binding would only be exposed to the specified
+	// execution context. If omitted and `executionContextName` is not set,
+	// the binding is exposed to all execution contexts of the target.
+	// This parameter is mutually exclusive with `executionContextName`.
+	// Deprecated in favor of `executionContextName` due to an unclear use case
+	// and bugs in implementation (crbug.com/1169639). `executionContextId` will be
+	// removed in the future.
+	//
+	// Deprecated: deprecated in the protocol.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+	// If specified, the binding is exposed to the executionContext with
+	// matching name, even for contexts created after the binding is added.
+	// See also `ExecutionContext.name` and `worldName` parameter to
+	// `Page.addScriptToEvaluateOnNewDocument`.
+	// This parameter is mutually exclusive with `executionContextId`.
+	ExecutionContextName string `json:"executionContextName,omitempty"`
+}
+
+// AddBinding if executionContextId is empty, adds binding with the given name on the
+// global objects of all inspected contexts, including those created later,
+// bindings survive reloads.
+// Binding function takes exactly one argument, this argument should be string,
+// in case of any other input, function throws an exception.
+// Each binding function call produces Runtime.bindingCalled notification.
+func AddBinding(ctx context.Context, session bidi.Transport, params AddBindingParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.addBinding", params))
+
+	return err
+}
+
+// RemoveBindingParams are the parameters of RemoveBinding.
+type RemoveBindingParams struct {
+	Name string `json:"name"`
+}
+
+// RemoveBinding this method does not remove binding function from global object but
+// unsubscribes current runtime agent from Runtime.bindingCalled notifications.
+func RemoveBinding(ctx context.Context, session bidi.Transport, params RemoveBindingParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Runtime.removeBinding", params))
+
+	return err
+}
+
+// GetExceptionDetailsParams are the parameters of GetExceptionDetails.
+type GetExceptionDetailsParams struct {
+	// The error object for which to resolve the exception details.
+	ErrorObjectID RemoteObjectID `json:"errorObjectId"`
+}
+
+// GetExceptionDetailsResult is the result of GetExceptionDetails.
+type GetExceptionDetailsResult struct {
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// GetExceptionDetails this method tries to lookup and populate exception details for a
+// JavaScript Error object.
+// Note that the stackTrace portion of the resulting exceptionDetails will
+// only be populated if the Runtime domain was enabled at the time when the
+// Error was thrown.
+//
+// Experimental.
+func GetExceptionDetails(ctx context.Context, session bidi.Transport, params GetExceptionDetailsParams) (*GetExceptionDetailsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetExceptionDetailsResult]("Runtime.getExceptionDetails", params))
+}
+
+// BindingCalledEvent is sent on Runtime.bindingCalled.
+//
+// Notification is issued every time when binding is called.
+//
+// Experimental.
+type BindingCalledEvent struct {
+	Name    string `json:"name"`
+	Payload string `json:"payload"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+}
+
+// EventBindingCalled is the method of BindingCalledEvent.
+const EventBindingCalled = "Runtime.bindingCalled"
+
+// OnBindingCalled calls handler for every BindingCalledEvent.
+//
+// The returned function removes the handler.
+func OnBindingCalled(source cdp.EventSource, handler func(*BindingCalledEvent)) func() {
+	return cdp.On(source, EventBindingCalled, handler)
+}
+
+// ConsoleAPICalledEvent is sent on Runtime.consoleAPICalled.
+//
+// Issued when console API was called.
+type ConsoleAPICalledEvent struct {
+	// Type of the call.
+	//
+	// Values: log, debug, info, error, warning, dir, dirxml, table, trace, clear, startGroup, startGroupCollapsed, endGroup, assert, profile, profileEnd, count, timeEnd.
+	Type string `json:"type"`
+	// Call arguments.
+	Args []RemoteObject `json:"args"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+	// Call timestamp.
+	Timestamp Timestamp `json:"timestamp"`
+	// Stack trace captured when the call was made. The async stack chain is automatically reported for
+	// the following call types: `assert`, `error`, `trace`, `warning`. For other types the async call
+	// chain can be retrieved using `Debugger.getStackTrace` and `stackTrace.parentId` field.
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+	// Console context descriptor for calls on non-default console context (not console.*):
+	// 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call
+	// on named context.
+	Context string `json:"context,omitempty"`
+}
+
+// EventConsoleAPICalled is the method of ConsoleAPICalledEvent.
+const EventConsoleAPICalled = "Runtime.consoleAPICalled"
+
+// OnConsoleAPICalled calls handler for every ConsoleAPICalledEvent.
+//
+// The returned function removes the handler.
+func OnConsoleAPICalled(source cdp.EventSource, handler func(*ConsoleAPICalledEvent)) func() {
+	return cdp.On(source, EventConsoleAPICalled, handler)
+}
+
+// ExceptionRevokedEvent is sent on Runtime.exceptionRevoked.
+//
+// Issued when unhandled exception was revoked.
+type ExceptionRevokedEvent struct {
+	// Reason describing why exception was revoked.
+	Reason string `json:"reason"`
+	// The id of revoked exception, as reported in `exceptionThrown`.
+	ExceptionID int64 `json:"exceptionId"`
+}
+
+// EventExceptionRevoked is the method of ExceptionRevokedEvent.
+const EventExceptionRevoked = "Runtime.exceptionRevoked"
+
+// OnExceptionRevoked calls handler for every ExceptionRevokedEvent.
+//
+// The returned function removes the handler.
+func OnExceptionRevoked(source cdp.EventSource, handler func(*ExceptionRevokedEvent)) func() {
+	return cdp.On(source, EventExceptionRevoked, handler)
+}
+
+// ExceptionThrownEvent is sent on Runtime.exceptionThrown.
+//
+// Issued when exception was thrown and unhandled.
+type ExceptionThrownEvent struct {
+	// Timestamp of the exception.
+	Timestamp        Timestamp        `json:"timestamp"`
+	ExceptionDetails ExceptionDetails `json:"exceptionDetails"`
+}
+
+// EventExceptionThrown is the method of ExceptionThrownEvent.
+const EventExceptionThrown = "Runtime.exceptionThrown"
+
+// OnExceptionThrown calls handler for every ExceptionThrownEvent.
+//
+// The returned function removes the handler.
+func OnExceptionThrown(source cdp.EventSource, handler func(*ExceptionThrownEvent)) func() {
+	return cdp.On(source, EventExceptionThrown, handler)
+}
+
+// ExecutionContextCreatedEvent is sent on Runtime.executionContextCreated.
+//
+// Issued when new execution context is created.
+type ExecutionContextCreatedEvent struct {
+	// A newly created execution context.
+	Context ExecutionContextDescription `json:"context"`
+}
+
+// EventExecutionContextCreated is the method of ExecutionContextCreatedEvent.
+const EventExecutionContextCreated = "Runtime.executionContextCreated"
+
+// OnExecutionContextCreated calls handler for every ExecutionContextCreatedEvent.
+//
+// The returned function removes the handler.
+func OnExecutionContextCreated(source cdp.EventSource, handler func(*ExecutionContextCreatedEvent)) func() {
+	return cdp.On(source, EventExecutionContextCreated, handler)
+}
+
+// ExecutionContextDestroyedEvent is sent on Runtime.executionContextDestroyed.
+//
+// Issued when execution context is destroyed.
+type ExecutionContextDestroyedEvent struct {
+	// Id of the destroyed context.
+	//
+	// Deprecated: deprecated in the protocol.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+	// Unique Id of the destroyed context.
+	ExecutionContextUniqueID string `json:"executionContextUniqueId"`
+}
+
+// EventExecutionContextDestroyed is the method of ExecutionContextDestroyedEvent.
+const EventExecutionContextDestroyed = "Runtime.executionContextDestroyed"
+
+// OnExecutionContextDestroyed calls handler for every ExecutionContextDestroyedEvent.
+//
+// The returned function removes the handler.
+func OnExecutionContextDestroyed(source cdp.EventSource, handler func(*ExecutionContextDestroyedEvent)) func() {
+	return cdp.On(source, EventExecutionContextDestroyed, handler)
+}
+
+// ExecutionContextsClearedEvent is sent on Runtime.executionContextsCleared.
+//
+// Issued when all executionContexts were cleared in browser.
+type ExecutionContextsClearedEvent struct {
+}
+
+// EventExecutionContextsCleared is the method of ExecutionContextsClearedEvent.
+const EventExecutionContextsCleared = "Runtime.executionContextsCleared"
+
+// OnExecutionContextsCleared calls handler for every ExecutionContextsClearedEvent.
+//
+// The returned function removes the handler.
+func OnExecutionContextsCleared(source cdp.EventSource, handler func(*ExecutionContextsClearedEvent)) func() {
+	return cdp.On(source, EventExecutionContextsCleared, handler)
+}
+
+// InspectRequestedEvent is sent on Runtime.inspectRequested.
+//
+// Issued when object should be inspected (for example, as a result of inspect() command line API
+// call).
+type InspectRequestedEvent struct {
+	Object RemoteObject           `json:"object"`
+	Hints  map[string]interface{} `json:"hints"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// EventInspectRequested is the method of InspectRequestedEvent.
+const EventInspectRequested = "Runtime.inspectRequested"
+
+// OnInspectRequested calls handler for every InspectRequestedEvent.
+//
+// The returned function removes the handler.
+func OnInspectRequested(source cdp.EventSource, handler func(*InspectRequestedEvent)) func() {
+	return cdp.On(source, EventInspectRequested, handler)
+}
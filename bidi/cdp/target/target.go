@@ -0,0 +1,584 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+// Package target provides typed bindings for the CDP Target domain.
+//
+// Supports additional targets discovery and allows to attach to them.
+package target
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+)
+
+// TargetID is the Target.TargetID type.
+type TargetID string
+
+// SessionID unique identifier of attached debugging session.
+type SessionID string
+
+// TargetInfo is the Target.TargetInfo type.
+type TargetInfo struct {
+	TargetID TargetID `json:"targetId"`
+	// List of types: https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22.
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	// Whether the target has an attached client.
+	Attached bool `json:"attached"`
+	// Opener target Id.
+	OpenerID TargetID `json:"openerId,omitempty"`
+	// Whether the target has access to the originating window.
+	CanAccessOpener bool `json:"canAccessOpener"`
+	// Frame id of originating window (is only set if target has an opener).
+	OpenerFrameID    cdp.PageFrameID      `json:"openerFrameId,omitempty"`
+	BrowserContextID cdp.BrowserContextID `json:"browserContextId,omitempty"`
+	// Provides additional details for specific target types. For example, for
+	// the type of "page", this may be set to "prerender".
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// FilterEntry a filter used by target query/discovery/auto-attach operations.
+//
+// Experimental.
+type FilterEntry struct {
+	// If set, causes exclusion of matching targets from the list.
+	Exclude *bool `json:"exclude,omitempty"`
+	// If not present, matches any type.
+	Type string `json:"type,omitempty"`
+}
+
+// TargetFilter the entries in TargetFilter are matched sequentially against targets and
+// the first entry that matches determines if the target is included or not,
+// depending on the value of `exclude` field in the entry.
+// If filter is not specified, the one assumed is
+// [{type: "browser", exclude: true}, {type: "tab", exclude: true}, {}]
+// (i.e. include everything but `browser` and `tab`).
+//
+// Experimental.
+type TargetFilter []FilterEntry
+
+// RemoteLocation is the Target.RemoteLocation type.
+//
+// Experimental.
+type RemoteLocation struct {
+	Host string `json:"host"`
+	Port int64  `json:"port"`
+}
+
+// WindowState the state of the target window.
+//
+// Experimental.
+type WindowState string
+
+const (
+	WindowStateNormal     WindowState = "normal"
+	WindowStateMinimized  WindowState = "minimized"
+	WindowStateMaximized  WindowState = "maximized"
+	WindowStateFullscreen WindowState = "fullscreen"
+)
+
+// ActivateTargetParams are the parameters of ActivateTarget.
+type ActivateTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// ActivateTarget activates (focuses) the target.
+func ActivateTarget(ctx context.Context, session bidi.Transport, params ActivateTargetParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.activateTarget", params))
+
+	return err
+}
+
+// AttachToTargetParams are the parameters of AttachToTarget.
+type AttachToTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Enables "flat" access to the session via specifying sessionId attribute in the commands.
+	// We plan to make this the default, deprecate non-flattened mode,
+	// and eventually retire it. See crbug.com/991325.
+	Flatten *bool `json:"flatten,omitempty"`
+}
+
+// AttachToTargetResult is the result of AttachToTarget.
+type AttachToTargetResult struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+}
+
+// AttachToTarget attaches to the target with given id.
+func AttachToTarget(ctx context.Context, session bidi.Transport, params AttachToTargetParams) (*AttachToTargetResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[AttachToTargetResult]("Target.attachToTarget", params))
+}
+
+// AttachToBrowserTargetResult is the result of AttachToBrowserTarget.
+type AttachToBrowserTargetResult struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+}
+
+// AttachToBrowserTarget attaches to the browser target, only uses flat sessionId mode.
+//
+// Experimental.
+func AttachToBrowserTarget(ctx context.Context, session bidi.Transport) (*AttachToBrowserTargetResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[AttachToBrowserTargetResult]("Target.attachToBrowserTarget", nil))
+}
+
+// CloseTargetParams are the parameters of CloseTarget.
+type CloseTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// CloseTargetResult is the result of CloseTarget.
+type CloseTargetResult struct {
+	// Always set to true. If an error occurs, the response indicates protocol error.
+	//
+	// Deprecated: deprecated in the protocol.
+	Success bool `json:"success"`
+}
+
+// CloseTarget closes the target. If the target is a page that gets closed too.
+func CloseTarget(ctx context.Context, session bidi.Transport, params CloseTargetParams) (*CloseTargetResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CloseTargetResult]("Target.closeTarget", params))
+}
+
+// ExposeDevToolsProtocolParams are the parameters of ExposeDevToolsProtocol.
+type ExposeDevToolsProtocolParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Binding name, 'cdp' if not specified.
+	BindingName string `json:"bindingName,omitempty"`
+	// If true, inherits the current root session's permissions (default: false).
+	InheritPermissions *bool `json:"inheritPermissions,omitempty"`
+}
+
+// ExposeDevToolsProtocol inject object to the target's main frame that provides a communication
+// channel with browser target.
+//
+// Injected object will be available as `window[bindingName]`.
+//
+// The object has the following API:
+// - `binding.send(json)` - a method to send messages over the remote debugging protocol
+// - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+//
+// Experimental.
+func ExposeDevToolsProtocol(ctx context.Context, session bidi.Transport, params ExposeDevToolsProtocolParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.exposeDevToolsProtocol", params))
+
+	return err
+}
+
+// CreateBrowserContextParams are the parameters of CreateBrowserContext.
+type CreateBrowserContextParams struct {
+	// If specified, disposes this context when debugging session disconnects.
+	DisposeOnDetach *bool `json:"disposeOnDetach,omitempty"`
+	// Proxy server, similar to the one passed to --proxy-server.
+	ProxyServer string `json:"proxyServer,omitempty"`
+	// Proxy bypass list, similar to the one passed to --proxy-bypass-list.
+	ProxyBypassList string `json:"proxyBypassList,omitempty"`
+	// An optional list of origins to grant unlimited cross-origin access to.
+	// Parts of the URL other than those constituting origin are ignored.
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
+}
+
+// CreateBrowserContextResult is the result of CreateBrowserContext.
+type CreateBrowserContextResult struct {
+	// The id of the context created.
+	BrowserContextID cdp.BrowserContextID `json:"browserContextId"`
+}
+
+// CreateBrowserContext creates a new empty BrowserContext. Similar to an incognito profile but you can have more than
+// one.
+func CreateBrowserContext(ctx context.Context, session bidi.Transport, params CreateBrowserContextParams) (*CreateBrowserContextResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CreateBrowserContextResult]("Target.createBrowserContext", params))
+}
+
+// GetBrowserContextsResult is the result of GetBrowserContexts.
+type GetBrowserContextsResult struct {
+	// An array of browser context ids.
+	BrowserContextIds []cdp.BrowserContextID `json:"browserContextIds"`
+}
+
+// GetBrowserContexts returns all browser contexts created with `Target.createBrowserContext` method.
+func GetBrowserContexts(ctx context.Context, session bidi.Transport) (*GetBrowserContextsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetBrowserContextsResult]("Target.getBrowserContexts", nil))
+}
+
+// CreateTargetParams are the parameters of CreateTarget.
+type CreateTargetParams struct {
+	// The initial URL the page will be navigated to. An empty string indicates about:blank.
+	URL string `json:"url"`
+	// Frame left origin in DIP (requires newWindow to be true or headless shell).
+	Left *int64 `json:"left,omitempty"`
+	// Frame top origin in DIP (requires newWindow to be true or headless shell).
+	Top *int64 `json:"top,omitempty"`
+	// Frame width in DIP (requires newWindow to be true or headless shell).
+	Width *int64 `json:"width,omitempty"`
+	// Frame height in DIP (requires newWindow to be true or headless shell).
+	Height *int64 `json:"height,omitempty"`
+	// Frame window state (requires newWindow to be true or headless shell).
+	// Default is normal.
+	WindowState WindowState `json:"windowState,omitempty"`
+	// The browser context to create the page in.
+	BrowserContextID cdp.BrowserContextID `json:"browserContextId,omitempty"`
+	// Whether BeginFrames for this target will be controlled via DevTools (headless shell only,
+	// not supported on MacOS yet, false by default).
+	EnableBeginFrameControl *bool `json:"enableBeginFrameControl,omitempty"`
+	// Whether to create a new Window or Tab (false by default, not supported by headless shell).
+	NewWindow *bool `json:"newWindow,omitempty"`
+	// Whether to create the target in background or foreground (false by default, not supported
+	// by headless shell).
+	Background *bool `json:"background,omitempty"`
+	// Whether to create the target of type "tab".
+	ForTab *bool `json:"forTab,omitempty"`
+	// Whether to create a hidden target. The hidden target is observable via protocol, but not
+	// present in the tab UI strip. Cannot be created with `forTab: true`, `newWindow: true` or
+	// `background: false`. The life-time of the tab is limited to the life-time of the session.
+	Hidden *bool `json:"hidden,omitempty"`
+}
+
+// CreateTargetResult is the result of CreateTarget.
+type CreateTargetResult struct {
+	// The id of the page opened.
+	TargetID TargetID `json:"targetId"`
+}
+
+// CreateTarget creates a new page.
+func CreateTarget(ctx context.Context, session bidi.Transport, params CreateTargetParams) (*CreateTargetResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[CreateTargetResult]("Target.createTarget", params))
+}
+
+// DetachFromTargetParams are the parameters of DetachFromTarget.
+type DetachFromTargetParams struct {
+	// Session to detach.
+	SessionID SessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	//
+	// Deprecated: deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// DetachFromTarget detaches session with given id.
+func DetachFromTarget(ctx context.Context, session bidi.Transport, params DetachFromTargetParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.detachFromTarget", params))
+
+	return err
+}
+
+// DisposeBrowserContextParams are the parameters of DisposeBrowserContext.
+type DisposeBrowserContextParams struct {
+	BrowserContextID cdp.BrowserContextID `json:"browserContextId"`
+}
+
+// DisposeBrowserContext deletes a BrowserContext. All the belonging pages will be closed without calling their
+// beforeunload hooks.
+func DisposeBrowserContext(ctx context.Context, session bidi.Transport, params DisposeBrowserContextParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.disposeBrowserContext", params))
+
+	return err
+}
+
+// GetTargetInfoParams are the parameters of GetTargetInfo.
+type GetTargetInfoParams struct {
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// GetTargetInfoResult is the result of GetTargetInfo.
+type GetTargetInfoResult struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// GetTargetInfo returns information about a target.
+//
+// Experimental.
+func GetTargetInfo(ctx context.Context, session bidi.Transport, params GetTargetInfoParams) (*GetTargetInfoResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetTargetInfoResult]("Target.getTargetInfo", params))
+}
+
+// GetTargetsParams are the parameters of GetTargets.
+type GetTargetsParams struct {
+	// Only targets matching filter will be reported. If filter is not specified
+	// and target discovery is currently enabled, a filter used for target discovery
+	// is used for consistency.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// GetTargetsResult is the result of GetTargets.
+type GetTargetsResult struct {
+	// The list of targets.
+	TargetInfos []TargetInfo `json:"targetInfos"`
+}
+
+// GetTargets retrieves a list of available targets.
+func GetTargets(ctx context.Context, session bidi.Transport, params GetTargetsParams) (*GetTargetsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[GetTargetsResult]("Target.getTargets", params))
+}
+
+// SendMessageToTargetParams are the parameters of SendMessageToTarget.
+type SendMessageToTargetParams struct {
+	Message string `json:"message"`
+	// Identifier of the session.
+	SessionID SessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	//
+	// Deprecated: deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// SendMessageToTarget sends protocol message over session with given id.
+// Consider using flat mode instead; see commands attachToTarget, setAutoAttach,
+// and crbug.com/991325.
+//
+// Deprecated: deprecated in the protocol.
+func SendMessageToTarget(ctx context.Context, session bidi.Transport, params SendMessageToTargetParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.sendMessageToTarget", params))
+
+	return err
+}
+
+// SetAutoAttachParams are the parameters of SetAutoAttach.
+type SetAutoAttachParams struct {
+	// Whether to auto-attach to related targets.
+	AutoAttach bool `json:"autoAttach"`
+	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger`
+	// to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Enables "flat" access to the session via specifying sessionId attribute in the commands.
+	// We plan to make this the default, deprecate non-flattened mode,
+	// and eventually retire it. See crbug.com/991325.
+	Flatten *bool `json:"flatten,omitempty"`
+	// Only targets matching filter will be attached.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// SetAutoAttach controls whether to automatically attach to new targets which are considered
+// to be directly related to this one (for example, iframes or workers).
+// When turned on, attaches to all existing related targets as well. When turned off,
+// automatically detaches from all currently attached targets.
+// This also clears all targets added by `autoAttachRelated` from the list of targets to watch
+// for creation of related targets.
+// You might want to call this recursively for auto-attached targets to attach
+// to all available targets.
+func SetAutoAttach(ctx context.Context, session bidi.Transport, params SetAutoAttachParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.setAutoAttach", params))
+
+	return err
+}
+
+// AutoAttachRelatedParams are the parameters of AutoAttachRelated.
+type AutoAttachRelatedParams struct {
+	TargetID TargetID `json:"targetId"`
+	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger`
+	// to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Only targets matching filter will be attached.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// AutoAttachRelated adds the specified target to the list of targets that will be monitored for any related target
+// creation (such as child frames, child workers and new versions of service worker) and reported
+// through `attachedToTarget`. The specified target is also auto-attached.
+// This cancels the effect of any previous `setAutoAttach` and is also cancelled by subsequent
+// `setAutoAttach`. Only available at the Browser target.
+//
+// Experimental.
+func AutoAttachRelated(ctx context.Context, session bidi.Transport, params AutoAttachRelatedParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.autoAttachRelated", params))
+
+	return err
+}
+
+// SetDiscoverTargetsParams are the parameters of SetDiscoverTargets.
+type SetDiscoverTargetsParams struct {
+	// Whether to discover available targets.
+	Discover bool `json:"discover"`
+	// Only targets matching filter will be attached. If `discover` is false,
+	// `filter` must be omitted or empty.
+	Filter TargetFilter `json:"filter,omitempty"`
+}
+
+// SetDiscoverTargets controls whether to discover available targets and notify via
+// `targetCreated/targetInfoChanged/targetDestroyed` events.
+func SetDiscoverTargets(ctx context.Context, session bidi.Transport, params SetDiscoverTargetsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.setDiscoverTargets", params))
+
+	return err
+}
+
+// SetRemoteLocationsParams are the parameters of SetRemoteLocations.
+type SetRemoteLocationsParams struct {
+	// List of remote locations.
+	Locations []RemoteLocation `json:"locations"`
+}
+
+// SetRemoteLocations enables target discovery for the specified locations, when `setDiscoverTargets` was set to
+// `true`.
+//
+// Experimental.
+func SetRemoteLocations(ctx context.Context, session bidi.Transport, params SetRemoteLocationsParams) error {
+	_, err := bidi.Run(ctx, session, bidi.NewCDPCommand[struct{}]("Target.setRemoteLocations", params))
+
+	return err
+}
+
+// OpenDevToolsParams are the parameters of OpenDevTools.
+type OpenDevToolsParams struct {
+	// This can be the page or tab target ID.
+	TargetID TargetID `json:"targetId"`
+}
+
+// OpenDevToolsResult is the result of OpenDevTools.
+type OpenDevToolsResult struct {
+	// The targetId of DevTools page target.
+	TargetID TargetID `json:"targetId"`
+}
+
+// OpenDevTools opens a DevTools window for the target.
+//
+// Experimental.
+func OpenDevTools(ctx context.Context, session bidi.Transport, params OpenDevToolsParams) (*OpenDevToolsResult, error) {
+	return bidi.Run(ctx, session, bidi.NewCDPCommand[OpenDevToolsResult]("Target.openDevTools", params))
+}
+
+// AttachedToTargetEvent is sent on Target.attachedToTarget.
+//
+// Issued when attached to target because of auto-attach or `attachToTarget` command.
+//
+// Experimental.
+type AttachedToTargetEvent struct {
+	// Identifier assigned to the session used to send/receive messages.
+	SessionID          SessionID  `json:"sessionId"`
+	TargetInfo         TargetInfo `json:"targetInfo"`
+	WaitingForDebugger bool       `json:"waitingForDebugger"`
+}
+
+// EventAttachedToTarget is the method of AttachedToTargetEvent.
+const EventAttachedToTarget = "Target.attachedToTarget"
+
+// OnAttachedToTarget calls handler for every AttachedToTargetEvent.
+//
+// The returned function removes the handler.
+func OnAttachedToTarget(source cdp.EventSource, handler func(*AttachedToTargetEvent)) func() {
+	return cdp.On(source, EventAttachedToTarget, handler)
+}
+
+// DetachedFromTargetEvent is sent on Target.detachedFromTarget.
+//
+// Issued when detached from target for any reason (including `detachFromTarget` command). Can be
+// issued multiple times per target if multiple sessions have been attached to it.
+//
+// Experimental.
+type DetachedFromTargetEvent struct {
+	// Detached session identifier.
+	SessionID SessionID `json:"sessionId"`
+	// Deprecated.
+	//
+	// Deprecated: deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// EventDetachedFromTarget is the method of DetachedFromTargetEvent.
+const EventDetachedFromTarget = "Target.detachedFromTarget"
+
+// OnDetachedFromTarget calls handler for every DetachedFromTargetEvent.
+//
+// The returned function removes the handler.
+func OnDetachedFromTarget(source cdp.EventSource, handler func(*DetachedFromTargetEvent)) func() {
+	return cdp.On(source, EventDetachedFromTarget, handler)
+}
+
+// ReceivedMessageFromTargetEvent is sent on Target.receivedMessageFromTarget.
+//
+// Notifies about a new protocol message received from the session (as reported in
+// `attachedToTarget` event).
+type ReceivedMessageFromTargetEvent struct {
+	// Identifier of a session which sends a message.
+	SessionID SessionID `json:"sessionId"`
+	Message   string    `json:"message"`
+	// Deprecated.
+	//
+	// Deprecated: deprecated in the protocol.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+// EventReceivedMessageFromTarget is the method of ReceivedMessageFromTargetEvent.
+const EventReceivedMessageFromTarget = "Target.receivedMessageFromTarget"
+
+// OnReceivedMessageFromTarget calls handler for every ReceivedMessageFromTargetEvent.
+//
+// The returned function removes the handler.
+func OnReceivedMessageFromTarget(source cdp.EventSource, handler func(*ReceivedMessageFromTargetEvent)) func() {
+	return cdp.On(source, EventReceivedMessageFromTarget, handler)
+}
+
+// TargetCreatedEvent is sent on Target.targetCreated.
+//
+// Issued when a possible inspection target is created.
+type TargetCreatedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// EventTargetCreated is the method of TargetCreatedEvent.
+const EventTargetCreated = "Target.targetCreated"
+
+// OnTargetCreated calls handler for every TargetCreatedEvent.
+//
+// The returned function removes the handler.
+func OnTargetCreated(source cdp.EventSource, handler func(*TargetCreatedEvent)) func() {
+	return cdp.On(source, EventTargetCreated, handler)
+}
+
+// TargetDestroyedEvent is sent on Target.targetDestroyed.
+//
+// Issued when a target is destroyed.
+type TargetDestroyedEvent struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+// EventTargetDestroyed is the method of TargetDestroyedEvent.
+const EventTargetDestroyed = "Target.targetDestroyed"
+
+// OnTargetDestroyed calls handler for every TargetDestroyedEvent.
+//
+// The returned function removes the handler.
+func OnTargetDestroyed(source cdp.EventSource, handler func(*TargetDestroyedEvent)) func() {
+	return cdp.On(source, EventTargetDestroyed, handler)
+}
+
+// TargetCrashedEvent is sent on Target.targetCrashed.
+//
+// Issued when a target has crashed.
+type TargetCrashedEvent struct {
+	TargetID TargetID `json:"targetId"`
+	// Termination status type.
+	Status string `json:"status"`
+	// Termination error code.
+	ErrorCode int64 `json:"errorCode"`
+}
+
+// EventTargetCrashed is the method of TargetCrashedEvent.
+const EventTargetCrashed = "Target.targetCrashed"
+
+// OnTargetCrashed calls handler for every TargetCrashedEvent.
+//
+// The returned function removes the handler.
+func OnTargetCrashed(source cdp.EventSource, handler func(*TargetCrashedEvent)) func() {
+	return cdp.On(source, EventTargetCrashed, handler)
+}
+
+// TargetInfoChangedEvent is sent on Target.targetInfoChanged.
+//
+// Issued when some information about a target has changed. This only happens between
+// `targetCreated` and `targetDestroyed`.
+type TargetInfoChangedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// EventTargetInfoChanged is the method of TargetInfoChangedEvent.
+const EventTargetInfoChanged = "Target.targetInfoChanged"
+
+// OnTargetInfoChanged calls handler for every TargetInfoChangedEvent.
+//
+// The returned function removes the handler.
+func OnTargetInfoChanged(source cdp.EventSource, handler func(*TargetInfoChangedEvent)) func() {
+	return cdp.On(source, EventTargetInfoChanged, handler)
+}
@@ -0,0 +1,439 @@
+// Code generated by cdpgen from protocol version 1.3. DO NOT EDIT.
+
+package cdp
+
+import (
+	"encoding/json"
+)
+
+// BrowserContextID is the Browser.BrowserContextID type.
+//
+// Experimental.
+type BrowserContextID string
+
+// DOMBackendNodeID unique DOM node identifier used to reference a node that may not have been pushed to the
+// front-end.
+type DOMBackendNodeID int64
+
+// DOMRGBA a structure holding an RGBA color.
+type DOMRGBA struct {
+	// The red component, in the [0-255] range.
+	R int64 `json:"r"`
+	// The green component, in the [0-255] range.
+	G int64 `json:"g"`
+	// The blue component, in the [0-255] range.
+	B int64 `json:"b"`
+	// The alpha component, in the [0-1] range (default: 1).
+	A *float64 `json:"a,omitempty"`
+}
+
+// DOMRect rectangle.
+type DOMRect struct {
+	// X coordinate.
+	X float64 `json:"x"`
+	// Y coordinate.
+	Y float64 `json:"y"`
+	// Rectangle width.
+	Width float64 `json:"width"`
+	// Rectangle height.
+	Height float64 `json:"height"`
+}
+
+// DebuggerSearchMatch search match for resource.
+type DebuggerSearchMatch struct {
+	// Line number in resource content.
+	LineNumber float64 `json:"lineNumber"`
+	// Line with match content.
+	LineContent string `json:"lineContent"`
+}
+
+// EmulationScreenOrientation screen orientation.
+type EmulationScreenOrientation struct {
+	// Orientation type.
+	//
+	// Values: portraitPrimary, portraitSecondary, landscapePrimary, landscapeSecondary.
+	Type string `json:"type"`
+	// Orientation angle.
+	Angle int64 `json:"angle"`
+}
+
+// EmulationUserAgentBrandVersion used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints.
+//
+// Experimental.
+type EmulationUserAgentBrandVersion struct {
+	Brand   string `json:"brand"`
+	Version string `json:"version"`
+}
+
+// EmulationUserAgentMetadata used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints
+// Missing optional values will be filled in by the target with what it would normally use.
+//
+// Experimental.
+type EmulationUserAgentMetadata struct {
+	// Brands appearing in Sec-CH-UA.
+	Brands []EmulationUserAgentBrandVersion `json:"brands,omitempty"`
+	// Brands appearing in Sec-CH-UA-Full-Version-List.
+	FullVersionList []EmulationUserAgentBrandVersion `json:"fullVersionList,omitempty"`
+	// Deprecated: deprecated in the protocol.
+	FullVersion     string `json:"fullVersion,omitempty"`
+	Platform        string `json:"platform"`
+	PlatformVersion string `json:"platformVersion"`
+	Architecture    string `json:"architecture"`
+	Model           string `json:"model"`
+	Mobile          bool   `json:"mobile"`
+	Bitness         string `json:"bitness,omitempty"`
+	Wow64           *bool  `json:"wow64,omitempty"`
+	// Used to specify User Agent form-factor values.
+	// See https://wicg.github.io/ua-client-hints/#sec-ch-ua-form-factors.
+	FormFactors []string `json:"formFactors,omitempty"`
+}
+
+// IOStreamHandle this is either obtained from another method or specified as `blob:<uuid>` where
+// `<uuid>` is an UUID of a Blob.
+type IOStreamHandle string
+
+// NetworkErrorReason network level fetch failure reason.
+type NetworkErrorReason string
+
+const (
+	NetworkErrorReasonFailed               NetworkErrorReason = "Failed"
+	NetworkErrorReasonAborted              NetworkErrorReason = "Aborted"
+	NetworkErrorReasonTimedOut             NetworkErrorReason = "TimedOut"
+	NetworkErrorReasonAccessDenied         NetworkErrorReason = "AccessDenied"
+	NetworkErrorReasonConnectionClosed     NetworkErrorReason = "ConnectionClosed"
+	NetworkErrorReasonConnectionReset      NetworkErrorReason = "ConnectionReset"
+	NetworkErrorReasonConnectionRefused    NetworkErrorReason = "ConnectionRefused"
+	NetworkErrorReasonConnectionAborted    NetworkErrorReason = "ConnectionAborted"
+	NetworkErrorReasonConnectionFailed     NetworkErrorReason = "ConnectionFailed"
+	NetworkErrorReasonNameNotResolved      NetworkErrorReason = "NameNotResolved"
+	NetworkErrorReasonInternetDisconnected NetworkErrorReason = "InternetDisconnected"
+	NetworkErrorReasonAddressUnreachable   NetworkErrorReason = "AddressUnreachable"
+	NetworkErrorReasonBlockedByClient      NetworkErrorReason = "BlockedByClient"
+	NetworkErrorReasonBlockedByResponse    NetworkErrorReason = "BlockedByResponse"
+)
+
+// NetworkHeaders request / response headers as keys / values of JSON object.
+type NetworkHeaders map[string]interface{}
+
+// NetworkLoaderID unique loader identifier.
+type NetworkLoaderID string
+
+// NetworkMonotonicTime monotonically increasing time in seconds since an arbitrary point in the past.
+type NetworkMonotonicTime float64
+
+// NetworkPostDataEntry post data entry for HTTP request.
+type NetworkPostDataEntry struct {
+	Bytes string `json:"bytes,omitempty"`
+}
+
+// NetworkRequest HTTP request data.
+type NetworkRequest struct {
+	// Request URL (without fragment).
+	URL string `json:"url"`
+	// Fragment of the requested URL starting with hash, if present.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// HTTP request method.
+	Method string `json:"method"`
+	// HTTP request headers.
+	Headers NetworkHeaders `json:"headers"`
+	// HTTP POST request data.
+	// Use postDataEntries instead.
+	//
+	// Deprecated: deprecated in the protocol.
+	PostData string `json:"postData,omitempty"`
+	// True when the request has POST data. Note that postData might still be omitted when this flag is true when the data is too long.
+	HasPostData *bool `json:"hasPostData,omitempty"`
+	// Request body elements (post data broken into individual entries).
+	PostDataEntries []NetworkPostDataEntry `json:"postDataEntries,omitempty"`
+	// The mixed content type of the request.
+	MixedContentType SecurityMixedContentType `json:"mixedContentType,omitempty"`
+	// Priority of the resource request at the time request is sent.
+	InitialPriority NetworkResourcePriority `json:"initialPriority"`
+	// The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/.
+	//
+	// Values: unsafe-url, no-referrer-when-downgrade, no-referrer, origin, origin-when-cross-origin, same-origin, strict-origin, strict-origin-when-cross-origin.
+	ReferrerPolicy string `json:"referrerPolicy"`
+	// Whether is loaded via link preload.
+	IsLinkPreload *bool `json:"isLinkPreload,omitempty"`
+	// Set for requests when the TrustToken API is used. Contains the parameters
+	// passed by the developer (e.g. via "fetch") as understood by the backend.
+	TrustTokenParams *NetworkTrustTokenParams `json:"trustTokenParams,omitempty"`
+	// True if this resource request is considered to be the 'same site' as the
+	// request corresponding to the main frame.
+	IsSameSite *bool `json:"isSameSite,omitempty"`
+}
+
+// NetworkRequestID unique network request identifier.
+// Note that this does not identify individual HTTP requests that are part of
+// a network request.
+type NetworkRequestID string
+
+// NetworkResourcePriority loading priority of a resource request.
+type NetworkResourcePriority string
+
+const (
+	NetworkResourcePriorityVeryLow  NetworkResourcePriority = "VeryLow"
+	NetworkResourcePriorityLow      NetworkResourcePriority = "Low"
+	NetworkResourcePriorityMedium   NetworkResourcePriority = "Medium"
+	NetworkResourcePriorityHigh     NetworkResourcePriority = "High"
+	NetworkResourcePriorityVeryHigh NetworkResourcePriority = "VeryHigh"
+)
+
+// NetworkResourceType resource type as it was perceived by the rendering engine.
+type NetworkResourceType string
+
+const (
+	NetworkResourceTypeDocument           NetworkResourceType = "Document"
+	NetworkResourceTypeStylesheet         NetworkResourceType = "Stylesheet"
+	NetworkResourceTypeImage              NetworkResourceType = "Image"
+	NetworkResourceTypeMedia              NetworkResourceType = "Media"
+	NetworkResourceTypeFont               NetworkResourceType = "Font"
+	NetworkResourceTypeScript             NetworkResourceType = "Script"
+	NetworkResourceTypeTextTrack          NetworkResourceType = "TextTrack"
+	NetworkResourceTypeXHR                NetworkResourceType = "XHR"
+	NetworkResourceTypeFetch              NetworkResourceType = "Fetch"
+	NetworkResourceTypePrefetch           NetworkResourceType = "Prefetch"
+	NetworkResourceTypeEventSource        NetworkResourceType = "EventSource"
+	NetworkResourceTypeWebSocket          NetworkResourceType = "WebSocket"
+	NetworkResourceTypeManifest           NetworkResourceType = "Manifest"
+	NetworkResourceTypeSignedExchange     NetworkResourceType = "SignedExchange"
+	NetworkResourceTypePing               NetworkResourceType = "Ping"
+	NetworkResourceTypeCSPViolationReport NetworkResourceType = "CSPViolationReport"
+	NetworkResourceTypePreflight          NetworkResourceType = "Preflight"
+	NetworkResourceTypeFedCM              NetworkResourceType = "FedCM"
+	NetworkResourceTypeOther              NetworkResourceType = "Other"
+)
+
+// NetworkTimeSinceEpoch UTC time in seconds, counted from January 1, 1970.
+type NetworkTimeSinceEpoch float64
+
+// NetworkTrustTokenOperationType is the Network.TrustTokenOperationType type.
+//
+// Experimental.
+type NetworkTrustTokenOperationType string
+
+const (
+	NetworkTrustTokenOperationTypeIssuance   NetworkTrustTokenOperationType = "Issuance"
+	NetworkTrustTokenOperationTypeRedemption NetworkTrustTokenOperationType = "Redemption"
+	NetworkTrustTokenOperationTypeSigning    NetworkTrustTokenOperationType = "Signing"
+)
+
+// NetworkTrustTokenParams determines what type of Trust Token operation is executed and
+// depending on the type, some additional parameters. The values
+// are specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+//
+// Experimental.
+type NetworkTrustTokenParams struct {
+	Operation NetworkTrustTokenOperationType `json:"operation"`
+	// Only set for "token-redemption" operation and determine whether
+	// to request a fresh SRR or use a still valid cached SRR.
+	//
+	// Values: UseCached, Refresh.
+	RefreshPolicy string `json:"refreshPolicy"`
+	// Origins of issuers from whom to request tokens or redemption
+	// records.
+	Issuers []string `json:"issuers,omitempty"`
+}
+
+// PageFrameID unique frame identifier.
+type PageFrameID string
+
+// PageViewport viewport for capturing screenshot.
+type PageViewport struct {
+	// X offset in device independent pixels (dip).
+	X float64 `json:"x"`
+	// Y offset in device independent pixels (dip).
+	Y float64 `json:"y"`
+	// Rectangle width in device independent pixels (dip).
+	Width float64 `json:"width"`
+	// Rectangle height in device independent pixels (dip).
+	Height float64 `json:"height"`
+	// Page scale factor.
+	Scale float64 `json:"scale"`
+}
+
+// RuntimeCallFrame stack entry for runtime errors and assertions.
+type RuntimeCallFrame struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+	// JavaScript script id.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// JavaScript script name or url.
+	URL string `json:"url"`
+	// JavaScript script line number (0-based).
+	LineNumber int64 `json:"lineNumber"`
+	// JavaScript script column number (0-based).
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// RuntimeCustomPreview is the Runtime.CustomPreview type.
+//
+// Experimental.
+type RuntimeCustomPreview struct {
+	// The JSON-stringified result of formatter.header(object, config) call.
+	// It contains json ML array that represents RemoteObject.
+	Header string `json:"header"`
+	// If formatter returns true as a result of formatter.hasBody call then bodyGetterId will
+	// contain RemoteObjectId for the function that returns result of formatter.body(object, config) call.
+	// The result value is json ML array.
+	BodyGetterID RuntimeRemoteObjectID `json:"bodyGetterId,omitempty"`
+}
+
+// RuntimeDeepSerializedValue represents deep serialized value.
+type RuntimeDeepSerializedValue struct {
+	// Values: undefined, null, string, number, boolean, bigint, regexp, date, symbol, array, object, function, map, set, weakmap, weakset, error, proxy, promise, typedarray, arraybuffer, node, window, generator.
+	Type     string          `json:"type"`
+	Value    json.RawMessage `json:"value,omitempty"`
+	ObjectID string          `json:"objectId,omitempty"`
+	// Set if value reference met more then once during serialization. In such
+	// case, value is provided only to one of the serialized values. Unique
+	// per value in the scope of one CDP call.
+	WeakLocalObjectReference *int64 `json:"weakLocalObjectReference,omitempty"`
+}
+
+// RuntimeEntryPreview is the Runtime.EntryPreview type.
+//
+// Experimental.
+type RuntimeEntryPreview struct {
+	// Preview of the key. Specified for map-like collection entries.
+	Key *RuntimeObjectPreview `json:"key,omitempty"`
+	// Preview of the value.
+	Value RuntimeObjectPreview `json:"value"`
+}
+
+// RuntimeExecutionContextID id of an execution context.
+type RuntimeExecutionContextID int64
+
+// RuntimeObjectPreview object containing abbreviated remote object value.
+//
+// Experimental.
+type RuntimeObjectPreview struct {
+	// Object type.
+	//
+	// Values: object, function, undefined, string, number, boolean, symbol, bigint.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only.
+	//
+	// Values: array, null, node, regexp, date, map, set, weakmap, weakset, iterator, generator, error, proxy, promise, typedarray, arraybuffer, dataview, webassemblymemory, wasmvalue.
+	Subtype string `json:"subtype,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// True iff some of the properties or entries of the original object did not fit.
+	Overflow bool `json:"overflow"`
+	// List of the properties.
+	Properties []RuntimePropertyPreview `json:"properties"`
+	// List of the entries. Specified for `map` and `set` subtype values only.
+	Entries []RuntimeEntryPreview `json:"entries,omitempty"`
+}
+
+// RuntimePropertyPreview is the Runtime.PropertyPreview type.
+//
+// Experimental.
+type RuntimePropertyPreview struct {
+	// Property name.
+	Name string `json:"name"`
+	// Object type. Accessor means that the property itself is an accessor property.
+	//
+	// Values: object, function, undefined, string, number, boolean, symbol, accessor, bigint.
+	Type string `json:"type"`
+	// User-friendly property value string.
+	Value string `json:"value,omitempty"`
+	// Nested value preview.
+	ValuePreview *RuntimeObjectPreview `json:"valuePreview,omitempty"`
+	// Object subtype hint. Specified for `object` type values only.
+	//
+	// Values: array, null, node, regexp, date, map, set, weakmap, weakset, iterator, generator, error, proxy, promise, typedarray, arraybuffer, dataview, webassemblymemory, wasmvalue.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// RuntimeRemoteObject mirror object referencing original JavaScript object.
+type RuntimeRemoteObject struct {
+	// Object type.
+	//
+	// Values: object, function, undefined, string, number, boolean, symbol, bigint.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only.
+	// NOTE: If you change anything here, make sure to also update
+	// `subtype` in `ObjectPreview` and `PropertyPreview` below.
+	//
+	// Values: array, null, node, regexp, date, map, set, weakmap, weakset, iterator, generator, error, proxy, promise, typedarray, arraybuffer, dataview, webassemblymemory, wasmvalue.
+	Subtype string `json:"subtype,omitempty"`
+	// Object class (constructor) name. Specified for `object` type values only.
+	ClassName string `json:"className,omitempty"`
+	// Remote object value in case of primitive values or JSON values (if it was requested).
+	Value json.RawMessage `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified does not have `value`, but gets this
+	// property.
+	UnserializableValue RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// Deep serialized value.
+	DeepSerializedValue *RuntimeDeepSerializedValue `json:"deepSerializedValue,omitempty"`
+	// Unique object identifier (for non-primitive values).
+	ObjectID RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Preview containing abbreviated property values. Specified for `object` type values only.
+	Preview       *RuntimeObjectPreview `json:"preview,omitempty"`
+	CustomPreview *RuntimeCustomPreview `json:"customPreview,omitempty"`
+}
+
+// RuntimeRemoteObjectID unique object identifier.
+type RuntimeRemoteObjectID string
+
+// RuntimeScriptID unique script identifier.
+type RuntimeScriptID string
+
+// RuntimeStackTrace call frames for assertions or error messages.
+type RuntimeStackTrace struct {
+	// String label of this stack trace. For async traces this may be a name of the function that
+	// initiated the async call.
+	Description string `json:"description,omitempty"`
+	// JavaScript function name.
+	CallFrames []RuntimeCallFrame `json:"callFrames"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	Parent *RuntimeStackTrace `json:"parent,omitempty"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	ParentID *RuntimeStackTraceID `json:"parentId,omitempty"`
+}
+
+// RuntimeStackTraceID if `debuggerId` is set stack trace comes from another debugger and can be resolved there. This
+// allows to track cross-debugger calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// Experimental.
+type RuntimeStackTraceID struct {
+	ID         string                  `json:"id"`
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId,omitempty"`
+}
+
+// RuntimeUniqueDebuggerID unique identifier of current debugger.
+//
+// Experimental.
+type RuntimeUniqueDebuggerID string
+
+// RuntimeUnserializableValue primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`, `Infinity`,
+// `-Infinity`, and bigint literals.
+type RuntimeUnserializableValue string
+
+// SecurityCertificateID an internal certificate ID value.
+type SecurityCertificateID int64
+
+// SecurityMixedContentType a description of mixed content (HTTP resources on HTTPS pages), as defined by
+// https://www.w3.org/TR/mixed-content/#categories.
+type SecurityMixedContentType string
+
+const (
+	SecurityMixedContentTypeBlockable           SecurityMixedContentType = "blockable"
+	SecurityMixedContentTypeOptionallyBlockable SecurityMixedContentType = "optionally-blockable"
+	SecurityMixedContentTypeNone                SecurityMixedContentType = "none"
+)
+
+// SecurityState the security level of a page or resource.
+type SecurityState string
+
+const (
+	SecurityStateUnknown        SecurityState = "unknown"
+	SecurityStateNeutral        SecurityState = "neutral"
+	SecurityStateInsecure       SecurityState = "insecure"
+	SecurityStateSecure         SecurityState = "secure"
+	SecurityStateInfo           SecurityState = "info"
+	SecurityStateInsecureBroken SecurityState = "insecure-broken"
+)
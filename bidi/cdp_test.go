@@ -0,0 +1,106 @@
+package bidi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+)
+
+// silentWebSocket accepts every command and never answers.
+type silentWebSocket struct {
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (ws *silentWebSocket) Send([]byte) error {
+	return nil
+}
+
+func (ws *silentWebSocket) Receive() ([]byte, error) {
+	<-ws.closed
+
+	return nil, io.EOF
+}
+
+func (ws *silentWebSocket) Close() error {
+	ws.closeOnce.Do(func() { close(ws.closed) })
+
+	return nil
+}
+
+func TestCDPSessionConcurrentExecuteAndClose(t *testing.T) {
+	t.Parallel()
+
+	ws := &silentWebSocket{closed: make(chan struct{}), closeOnce: sync.Once{}}
+	session := bidi.NewCDPSession(ws, "session", "target")
+
+	var wg sync.WaitGroup
+
+	for range 16 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// Nothing answers, so every call ends through ctx or Close.
+			_, err := session.Execute(ctx, "Browser.getVersion", nil)
+			assert.Error(t, err)
+		}()
+	}
+
+	require.NoError(t, session.Close())
+	wg.Wait()
+
+	_, err := session.Execute(context.Background(), "Browser.getVersion", json.RawMessage(`{}`))
+	require.ErrorIs(t, err, bidi.ErrSessionClosed)
+}
+
+func TestCDPConnAttachToTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	url := newFakeBrowser(t, func(cmd fakeCommand) []interface{} {
+		if cmd.Method == "Target.attachToTarget" {
+			return []interface{}{map[string]interface{}{"id": cmd.ID, "result": map[string]string{"sessionId": "S1"}}}
+		}
+
+		// Events and results are routed by the flattened session ID
+		return []interface{}{
+			map[string]interface{}{"sessionId": cmd.SessionID, "method": "Page.loadEventFired", "params": map[string]int{}},
+			map[string]interface{}{"id": cmd.ID, "result": map[string]string{"sessionId": cmd.SessionID}},
+		}
+	})
+
+	conn, err := bidi.DialCDP(ctx, url)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	page, err := conn.AttachToTarget(ctx, "T1")
+	require.NoError(t, err)
+	assert.Equal(t, "S1", page.SessionID())
+
+	loaded := make(chan struct{}, 1)
+	page.Subscribe("Page.loadEventFired", func(json.RawMessage) { loaded <- struct{}{} })
+
+	result, err := bidi.Run(ctx, page, bidi.NewCDPCommand[struct {
+		SessionID string `json:"sessionId"`
+	}]("Page.reload", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "S1", result.SessionID)
+	<-loaded
+
+	// BiDi commands are rejected before reaching a CDP endpoint
+	_, err = bidi.Run(ctx, page, bidi.NewBiDiCommand[bidi.ScriptResult]("script.evaluate", nil))
+	require.ErrorIs(t, err, bidi.ErrWrongProtocol)
+}
@@ -0,0 +1,73 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Protocol identifies the wire protocol spoken by a Transport.
+type Protocol string
+
+const (
+	// ProtocolBiDi is the W3C WebDriver BiDi protocol.
+	ProtocolBiDi Protocol = "webdriver-bidi"
+	// ProtocolCDP is the Chrome DevTools Protocol.
+	ProtocolCDP Protocol = "cdp"
+)
+
+// ErrWrongProtocol is returned when a command is sent over a transport for another protocol.
+var ErrWrongProtocol = errors.New("command sent over the wrong protocol")
+
+// Transport sends commands over a single protocol.
+type Transport interface {
+	// Execute sends a command and returns the raw result.
+	Execute(ctx context.Context, method string, params interface{}) (json.RawMessage, error)
+	// Protocol returns the protocol spoken by the transport.
+	Protocol() Protocol
+}
+
+// Command is a typed command envelope whose result decodes into R.
+//
+// Modules declare their commands with NewBiDiCommand or NewCDPCommand, so
+// sending one over the wrong transport fails before anything reaches the browser.
+type Command[R any] struct {
+	Params   interface{}
+	Method   string
+	Protocol Protocol
+}
+
+// NewBiDiCommand creates a W3C BiDi command.
+func NewBiDiCommand[R any](method string, params interface{}) Command[R] {
+	return Command[R]{Params: params, Method: method, Protocol: ProtocolBiDi}
+}
+
+// NewCDPCommand creates a CDP command.
+func NewCDPCommand[R any](method string, params interface{}) Command[R] {
+	return Command[R]{Params: params, Method: method, Protocol: ProtocolCDP}
+}
+
+// Run sends the command over the transport and decodes its result.
+func Run[R any](ctx context.Context, transport Transport, cmd Command[R]) (*R, error) {
+	if transport.Protocol() != cmd.Protocol {
+		return nil, fmt.Errorf("%w: %s is a %s command, transport speaks %s",
+			ErrWrongProtocol, cmd.Method, cmd.Protocol, transport.Protocol())
+	}
+
+	raw, err := transport.Execute(ctx, cmd.Method, cmd.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result R
+	if len(raw) == 0 {
+		return &result, nil
+	}
+
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s result: %w", cmd.Method, err)
+	}
+
+	return &result, nil
+}
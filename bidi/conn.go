@@ -0,0 +1,145 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ProtocolError represents a W3C BiDi error response.
+//
+// It also matches *BrowserError with errors.As, so callers can handle CDP and
+// BiDi error responses alike; the BiDi error code is then the Detail.
+type ProtocolError struct {
+	// Code is the error code such as "no such frame".
+	Code       string `json:"error"`
+	Message    string `json:"message"`
+	Stacktrace string `json:"stacktrace,omitempty"`
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// As converts the error to a *BrowserError.
+func (e *ProtocolError) As(target interface{}) bool {
+	browserErr, ok := target.(**BrowserError)
+	if !ok {
+		return false
+	}
+
+	*browserErr = &BrowserError{Detail: e.Code, Message: e.Message, Code: 0}
+
+	return true
+}
+
+// bidiCommand is the W3C BiDi command envelope.
+type bidiCommand struct {
+	Params interface{} `json:"params"`
+	Method string      `json:"method"`
+	ID     int64       `json:"id"`
+}
+
+// Message types of W3C BiDi messages.
+const (
+	messageSuccess = "success"
+	messageError   = "error"
+	messageEvent   = "event"
+)
+
+// bidiMessage is a W3C BiDi response or event.
+type bidiMessage struct {
+	ID         *int64          `json:"id"`
+	Type       string          `json:"type"`
+	Method     string          `json:"method"`
+	Error      string          `json:"error"`
+	Message    string          `json:"message"`
+	Stacktrace string          `json:"stacktrace"`
+	Params     json.RawMessage `json:"params"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// decodeBiDi classifies a W3C BiDi message.
+func decodeBiDi(data []byte) (*int64, response, event, bool) {
+	var msg bidiMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, response{}, event{}, false
+	}
+
+	switch msg.Type {
+	case messageSuccess:
+		if msg.ID == nil {
+			return nil, response{}, event{}, false
+		}
+
+		return msg.ID, response{err: nil, result: msg.Result}, event{}, true
+	case messageError:
+		// Errors without an ID answer commands that could not be parsed.
+		if msg.ID == nil {
+			return nil, response{}, event{}, false
+		}
+
+		return msg.ID, response{
+			err:    &ProtocolError{Code: msg.Error, Message: msg.Message, Stacktrace: msg.Stacktrace},
+			result: nil,
+		}, event{}, true
+	case messageEvent:
+		return nil, response{}, event{sessionID: "", method: msg.Method, params: msg.Params}, true
+	default:
+		return nil, response{}, event{}, false
+	}
+}
+
+// Conn is a W3C WebDriver BiDi connection.
+//
+// A Conn is safe for concurrent use. Events are passed to onEvent in order
+// on a dedicated goroutine.
+type Conn struct {
+	rpc *rpc
+}
+
+// NewConn creates a BiDi connection on an open WebSocket.
+func NewConn(ws WebSocket, onEvent func(method string, params json.RawMessage)) *Conn {
+	return &Conn{
+		rpc: newRPC(ws, decodeBiDi, func(ev event) {
+			if onEvent != nil {
+				onEvent(ev.method, ev.params)
+			}
+		}),
+	}
+}
+
+// Execute sends a command and waits for its result.
+//
+// An error response is returned as a *ProtocolError, which also matches
+// *BrowserError with errors.As.
+func (c *Conn) Execute(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if params == nil {
+		// Every BiDi command carries a params object.
+		params = struct{}{}
+	}
+
+	return c.rpc.call(ctx, func(id int64) interface{} {
+		return bidiCommand{Params: params, Method: method, ID: id}
+	})
+}
+
+// Protocol returns ProtocolBiDi.
+func (c *Conn) Protocol() Protocol {
+	return ProtocolBiDi
+}
+
+// Done returns a channel that is closed once the connection stops receiving messages.
+func (c *Conn) Done() <-chan struct{} {
+	return c.rpc.done
+}
+
+// Err returns the reason the connection stopped, or nil while it is running or after Close.
+func (c *Conn) Err() error {
+	return c.rpc.stopErr()
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	return c.rpc.close()
+}
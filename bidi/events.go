@@ -0,0 +1,86 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// eventHandler is a handler registered for an event type.
+type eventHandler struct {
+	handle func(json.RawMessage)
+	id     uint64
+}
+
+// handlerSet holds the event handlers of a session by event type.
+//
+// It is not synchronized; the owning session guards it with its mutex.
+type handlerSet struct {
+	handlers map[string][]eventHandler
+	nextID   uint64
+}
+
+// newHandlerSet creates an empty handler set.
+func newHandlerSet() handlerSet {
+	return handlerSet{
+		handlers: make(map[string][]eventHandler),
+		nextID:   0,
+	}
+}
+
+// add registers handler for eventType and returns its ID.
+func (h *handlerSet) add(eventType string, handler func(json.RawMessage)) uint64 {
+	h.nextID++
+	h.handlers[eventType] = append(h.handlers[eventType], eventHandler{handle: handler, id: h.nextID})
+
+	return h.nextID
+}
+
+// remove unregisters the handler with the given ID.
+//
+// The list is copied so that handlers being called by HandleEvent are not affected.
+func (h *handlerSet) remove(eventType string, id uint64) {
+	current := h.handlers[eventType]
+	remaining := make([]eventHandler, 0, len(current))
+
+	for _, handler := range current {
+		if handler.id != id {
+			remaining = append(remaining, handler)
+		}
+	}
+
+	if len(remaining) == 0 {
+		delete(h.handlers, eventType)
+
+		return
+	}
+
+	h.handlers[eventType] = remaining
+}
+
+// get returns the handlers for eventType.
+func (h *handlerSet) get(eventType string) []eventHandler {
+	return h.handlers[eventType]
+}
+
+// On subscribes to a BiDi event and passes its decoded params to handler.
+//
+// Events whose params cannot be decoded into T are dropped. If the browser
+// rejects the subscription, handler is removed again.
+func On[T any](ctx context.Context, session *Session, eventType string, handler func(T)) error {
+	unsubscribe := session.Subscribe(eventType, func(data json.RawMessage) {
+		var params T
+		if err := json.Unmarshal(data, &params); err != nil {
+			return
+		}
+
+		handler(params)
+	})
+
+	if err := session.SubscribeEvents(ctx, eventType); err != nil {
+		unsubscribe()
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+// Package input implements the WebDriver BiDi input module.
+package input
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/actions"
+	"github.com/Kcrong/selenium/bidi"
+)
+
+var (
+	// ErrUnsupportedAction is returned for actions that have no BiDi encoding.
+	ErrUnsupportedAction = errors.New("unsupported action")
+	// ErrUnsupportedOrigin is returned for origins other than viewport, pointer or an element.
+	ErrUnsupportedOrigin = errors.New("unsupported origin")
+)
+
+// Input sends input commands over a BiDi session.
+type Input struct {
+	session *bidi.Session
+}
+
+// New creates an input module on the session.
+func New(session *bidi.Session) *Input {
+	return &Input{
+		session: session,
+	}
+}
+
+// PerformActions performs the actions stored on the devices in the browsing context.
+//
+// Element origins are sent as shared references, so elements found over
+// WebDriver classic or with browsingContext.locateNodes can both be used.
+func (i *Input) PerformActions(ctx context.Context, contextID string, devices []actions.InputDevice) error {
+	sources, err := encodeSources(devices)
+	if err != nil {
+		return err
+	}
+
+	_, err = bidi.Run(ctx, i.session, bidi.NewBiDiCommand[struct{}]("input.performActions", map[string]interface{}{
+		"context": contextID,
+		"actions": sources,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to perform actions: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseActions releases all keys and pointer buttons held down in the browsing context.
+func (i *Input) ReleaseActions(ctx context.Context, contextID string) error {
+	_, err := bidi.Run(ctx, i.session, bidi.NewBiDiCommand[struct{}]("input.releaseActions",
+		map[string]string{"context": contextID}))
+	if err != nil {
+		return fmt.Errorf("failed to release actions: %w", err)
+	}
+
+	return nil
+}
+
+// SetFiles sets the files of an <input type="file"> element.
+func (i *Input) SetFiles(ctx context.Context, contextID string, element bidi.SharedReference, files []string) error {
+	if files == nil {
+		files = []string{}
+	}
+
+	_, err := bidi.Run(ctx, i.session, bidi.NewBiDiCommand[struct{}]("input.setFiles", map[string]interface{}{
+		"context": contextID,
+		"element": element,
+		"files":   files,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to set files: %w", err)
+	}
+
+	return nil
+}
+
+// Dispatcher returns an actions.Dispatcher for the browsing context, so an
+// ActionBuilder or ActionChains performs its actions over BiDi.
+func (i *Input) Dispatcher(contextID string) actions.Dispatcher {
+	return &dispatcher{input: i, context: contextID}
+}
+
+// dispatcher binds the input module to a browsing context.
+type dispatcher struct {
+	input   *Input
+	context string
+}
+
+func (d *dispatcher) PerformActions(ctx context.Context, devices []actions.InputDevice) error {
+	return d.input.PerformActions(ctx, d.context, devices)
+}
+
+func (d *dispatcher) ReleaseActions(ctx context.Context) error {
+	return d.input.ReleaseActions(ctx, d.context)
+}
+
+// encodeSources encodes the devices that have actions as BiDi input sources.
+func encodeSources(devices []actions.InputDevice) ([]map[string]interface{}, error) {
+	sources := make([]map[string]interface{}, 0, len(devices))
+
+	for _, device := range devices {
+		if len(device.GetActions()) == 0 {
+			continue
+		}
+
+		source := map[string]interface{}{
+			"type": device.GetType(),
+			"id":   device.GetName(),
+		}
+
+		if pointer, ok := device.(*actions.PointerInput); ok {
+			source["parameters"] = map[string]string{"pointerType": pointer.GetKind()}
+		}
+
+		encoded := make([]map[string]interface{}, 0, len(device.GetActions()))
+
+		for _, action := range device.GetActions() {
+			item, err := encodeAction(action)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", device.GetName(), err)
+			}
+
+			encoded = append(encoded, item)
+		}
+
+		source["actions"] = encoded
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+// encodeAction encodes an action; durations are sent in milliseconds.
+func encodeAction(action actions.Action) (map[string]interface{}, error) {
+	switch a := action.(type) {
+	case *actions.PauseAction:
+		return withDuration(map[string]interface{}{"type": "pause"}, a.Duration), nil
+	case *actions.KeyAction:
+		return map[string]interface{}{"type": a.Type, "value": a.Value}, nil
+	case *actions.PointerAction:
+		return encodePointerAction(a)
+	case *actions.WheelAction:
+		encoded := map[string]interface{}{
+			"type":   "scroll",
+			"x":      a.X,
+			"y":      a.Y,
+			"deltaX": a.DeltaX,
+			"deltaY": a.DeltaY,
+		}
+
+		return withOrigin(withDuration(encoded, a.Duration), a.Origin)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedAction, action)
+	}
+}
+
+// encodePointerAction encodes pointer moves and button presses.
+//
+// BiDi has no tiltX and tiltY, so they are not sent.
+func encodePointerAction(a *actions.PointerAction) (map[string]interface{}, error) {
+	encoded := map[string]interface{}{"type": a.Type}
+
+	if a.Type == "pointerMove" {
+		encoded["x"] = a.X
+		encoded["y"] = a.Y
+	} else {
+		encoded["button"] = a.Button
+	}
+
+	if a.Width != 0 {
+		encoded["width"] = a.Width
+	}
+	if a.Height != 0 {
+		encoded["height"] = a.Height
+	}
+	if a.Pressure != 0 {
+		encoded["pressure"] = a.Pressure
+	}
+	if a.Twist != 0 {
+		encoded["twist"] = a.Twist
+	}
+
+	if a.Type != "pointerMove" {
+		return encoded, nil
+	}
+
+	return withOrigin(withDuration(encoded, a.Duration), a.Origin)
+}
+
+// withDuration adds a positive duration in milliseconds.
+func withDuration(encoded map[string]interface{}, duration time.Duration) map[string]interface{} {
+	if duration > 0 {
+		encoded["duration"] = duration.Milliseconds()
+	}
+
+	return encoded
+}
+
+// withOrigin adds the origin of a move or scroll; nil is the viewport.
+func withOrigin(encoded map[string]interface{}, origin interface{}) (map[string]interface{}, error) {
+	var reference bidi.SharedReference
+
+	switch o := origin.(type) {
+	case nil:
+		return encoded, nil
+	case string:
+		if o != "viewport" && o != "pointer" {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedOrigin, o)
+		}
+
+		encoded["origin"] = o
+
+		return encoded, nil
+	case selenium.WebElement:
+		reference = bidi.ElementReference(o)
+	case bidi.SharedReference:
+		reference = o
+	case bidi.NodeRemoteValue:
+		reference = o.Reference()
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedOrigin, origin)
+	}
+
+	encoded["origin"] = map[string]interface{}{"type": "element", "element": reference}
+
+	return encoded, nil
+}
@@ -0,0 +1,100 @@
+package input_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/actions"
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/biditest"
+	"github.com/Kcrong/selenium/bidi/input"
+)
+
+// element is a WebElement found over WebDriver classic.
+type element struct {
+	selenium.WebElement
+	id string
+}
+
+func (e element) GetID() string {
+	return e.id
+}
+
+func TestActionChains(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+
+	chains := actions.NewActionChains(nil, 100*time.Millisecond).
+		SetDispatcher(input.New(session).Dispatcher("C1"))
+
+	chains.Click(element{id: "E1"}).SendKeys("a").ScrollByAmount(0, 50)
+	require.NoError(t, chains.Perform(ctx))
+	require.NoError(t, chains.ResetActions(ctx))
+
+	assert.JSONEq(t, `{
+		"context": "C1",
+		"actions": [
+			{"type": "pointer", "id": "default mouse", "parameters": {"pointerType": "mouse"}, "actions": [
+				{"type": "pointerMove", "x": 0, "y": 0, "duration": 100,
+					"origin": {"type": "element", "element": {"sharedId": "E1"}}},
+				{"type": "pointerDown", "button": 0},
+				{"type": "pointerUp", "button": 0}
+			]},
+			{"type": "key", "id": "default keyboard", "actions": [
+				{"type": "keyDown", "value": "a"},
+				{"type": "keyUp", "value": "a"}
+			]},
+			{"type": "wheel", "id": "default wheel", "actions": [
+				{"type": "scroll", "x": 0, "y": 0, "deltaX": 0, "deltaY": 50}
+			]}
+		]
+	}`, string(browser.Commands("input.performActions")[0]))
+	assert.JSONEq(t, `{"context": "C1"}`, string(browser.Commands("input.releaseActions")[0]))
+}
+
+func TestPerformActionsOrigin(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+	in := input.New(session)
+
+	pen := actions.NewPointerInput("pen", "pen", time.Second)
+	pen.Move(10, 20, bidi.NodeRemoteValue{Type: "node", SharedID: "N1"})
+	pen.AddAction(pen.CreatePause(500 * time.Millisecond))
+	pen.Move(1, 1, "pointer")
+
+	require.NoError(t, in.PerformActions(context.Background(), "C1", []actions.InputDevice{pen}))
+	assert.JSONEq(t, `{
+		"context": "C1",
+		"actions": [{"type": "pointer", "id": "pen", "parameters": {"pointerType": "pen"}, "actions": [
+			{"type": "pointerMove", "x": 10, "y": 20, "duration": 1000,
+				"origin": {"type": "element", "element": {"sharedId": "N1"}}},
+			{"type": "pause", "duration": 500},
+			{"type": "pointerMove", "x": 1, "y": 1, "duration": 1000, "origin": "pointer"}
+		]}]
+	}`, string(browser.Commands("input.performActions")[0]))
+
+	pen.ClearActions()
+	pen.Move(0, 0, "page")
+	err := in.PerformActions(context.Background(), "C1", []actions.InputDevice{pen})
+	require.ErrorIs(t, err, input.ErrUnsupportedOrigin)
+}
+
+func TestSetFiles(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+
+	err := input.New(session).SetFiles(context.Background(), "C1",
+		bidi.ElementReference(element{id: "E1"}), []string{"/tmp/a.txt"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"context": "C1", "element": {"sharedId": "E1"}, "files": ["/tmp/a.txt"]}`,
+		string(browser.Commands("input.setFiles")[0]))
+}
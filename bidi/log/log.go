@@ -0,0 +1,240 @@
+// Package log implements the WebDriver BiDi log module.
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Kcrong/selenium/bidi"
+)
+
+// EventEntryAdded is sent for every console call and uncaught JavaScript error.
+const EventEntryAdded = "log.entryAdded"
+
+// DefaultCollectorSize is the default number of entries a Collector keeps.
+const DefaultCollectorSize = 1000
+
+// Level is the severity of a log entry.
+type Level string
+
+const (
+	// LevelDebug is used by console.debug and console.trace.
+	LevelDebug Level = "debug"
+	// LevelInfo is used by console.log, console.info and most other console methods.
+	LevelInfo Level = "info"
+	// LevelWarn is used by console.warn.
+	LevelWarn Level = "warn"
+	// LevelError is used by console.error, console.assert and uncaught JavaScript errors.
+	LevelError Level = "error"
+)
+
+// EntryType is the origin of a log entry.
+type EntryType string
+
+const (
+	// TypeConsole entries come from console API calls.
+	TypeConsole EntryType = "console"
+	// TypeJavaScript entries are uncaught JavaScript errors.
+	TypeJavaScript EntryType = "javascript"
+)
+
+// Source is the realm and browsing context that produced an entry.
+type Source struct {
+	Realm   string `json:"realm"`
+	Context string `json:"context,omitempty"`
+}
+
+// StackFrame is a frame of a JavaScript stack trace.
+type StackFrame = bidi.StackFrame
+
+// StackTrace is a JavaScript stack trace.
+type StackTrace = bidi.StackTrace
+
+// Entry is a log entry.
+type Entry struct {
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+	Source     Source      `json:"source"`
+	Level      Level       `json:"level"`
+	Type       EntryType   `json:"type"`
+	Text       string      `json:"text"`
+	// Method is the console method, such as log or error, for console entries.
+	Method string `json:"method,omitempty"`
+	// Args are the console arguments for console entries.
+	Args []bidi.RemoteValue `json:"args,omitempty"`
+	// Timestamp is the entry time in milliseconds since the Unix epoch.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// ConsoleMessage converts the entry to a bidi.ConsoleMessage.
+func (e *Entry) ConsoleMessage() bidi.ConsoleMessage {
+	consoleType := bidi.ConsoleLog
+	if e.Level == LevelError {
+		consoleType = bidi.ConsoleError
+	}
+
+	return bidi.ConsoleMessage{Type: consoleType, Message: e.Text}
+}
+
+// String formats the entry with its stack trace.
+func (e *Entry) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "[%s] %s", e.Level, e.Text)
+
+	if e.StackTrace != nil {
+		for _, frame := range e.StackTrace.CallFrames {
+			fmt.Fprintf(&b, "\n    at %s (%s:%d:%d)", frame.FunctionName, frame.URL, frame.LineNumber, frame.ColumnNumber)
+		}
+	}
+
+	return b.String()
+}
+
+// Log subscribes to log entries over a BiDi session.
+type Log struct {
+	session *bidi.Session
+}
+
+// New creates a log module on the session.
+func New(session *bidi.Session) *Log {
+	return &Log{
+		session: session,
+	}
+}
+
+// OnEntryAdded calls handler for every log entry.
+func (l *Log) OnEntryAdded(ctx context.Context, handler func(Entry)) error {
+	_, err := l.onEntryAdded(ctx, handler)
+
+	return err
+}
+
+// onEntryAdded calls handler for every log entry and returns a function that removes it.
+func (l *Log) onEntryAdded(ctx context.Context, handler func(Entry)) (func(), error) {
+	unsubscribe := l.session.Subscribe(EventEntryAdded, func(data json.RawMessage) {
+		var entry Entry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return
+		}
+
+		handler(entry)
+	})
+
+	if err := l.session.SubscribeEvents(ctx, EventEntryAdded); err != nil {
+		unsubscribe()
+
+		return nil, err
+	}
+
+	return unsubscribe, nil
+}
+
+// OnConsole calls handler for console API calls.
+func (l *Log) OnConsole(ctx context.Context, handler func(Entry)) error {
+	return l.OnEntryAdded(ctx, func(entry Entry) {
+		if entry.Type == TypeConsole {
+			handler(entry)
+		}
+	})
+}
+
+// OnJSError calls handler for uncaught JavaScript errors.
+func (l *Log) OnJSError(ctx context.Context, handler func(Entry)) error {
+	return l.OnEntryAdded(ctx, func(entry Entry) {
+		if entry.Type == TypeJavaScript {
+			handler(entry)
+		}
+	})
+}
+
+// Collector buffers log entries until they are drained.
+//
+// A Collector is safe for concurrent use.
+type Collector struct {
+	// unsubscribe detaches the collector from the session.
+	unsubscribe func()
+	entries     []Entry
+	size        int
+	dropped     int
+	mu          sync.Mutex
+}
+
+// Collect attaches a collector keeping up to size entries to the session.
+//
+// When the buffer is full the oldest entries are dropped. A size of zero
+// or less uses DefaultCollectorSize. Close detaches the collector.
+func Collect(ctx context.Context, session *bidi.Session, size int) (*Collector, error) {
+	if size <= 0 {
+		size = DefaultCollectorSize
+	}
+
+	c := &Collector{
+		unsubscribe: nil,
+		entries:     nil,
+		size:        size,
+		dropped:     0,
+		mu:          sync.Mutex{},
+	}
+
+	unsubscribe, err := New(session).onEntryAdded(ctx, c.add)
+	if err != nil {
+		return nil, err
+	}
+
+	c.unsubscribe = unsubscribe
+
+	return c, nil
+}
+
+// Close detaches the collector from the session; the entries it buffered can still be drained.
+func (c *Collector) Close() {
+	c.unsubscribe()
+}
+
+// add buffers an entry.
+func (c *Collector) add(entry Entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) == c.size {
+		c.entries = c.entries[1:]
+		c.dropped++
+	}
+
+	c.entries = append(c.entries, entry)
+}
+
+// Drain returns the buffered entries and empties the buffer.
+func (c *Collector) Drain() []Entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := c.entries
+	c.entries = nil
+
+	return entries
+}
+
+// Dropped returns the number of entries dropped because the buffer was full.
+func (c *Collector) Dropped() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.dropped
+}
+
+// JSErrors returns the uncaught JavaScript errors among entries.
+func JSErrors(entries []Entry) []Entry {
+	var errors []Entry
+
+	for _, entry := range entries {
+		if entry.Type == TypeJavaScript {
+			errors = append(errors, entry)
+		}
+	}
+
+	return errors
+}
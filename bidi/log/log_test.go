@@ -0,0 +1,141 @@
+package log_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/biditest"
+	"github.com/Kcrong/selenium/bidi/log"
+)
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+
+	collector, err := log.Collect(context.Background(), session, 2)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"events": ["log.entryAdded"]}`, string(browser.Commands("session.subscribe")[0]))
+
+	browser.Emit(log.EventEntryAdded, map[string]interface{}{
+		"type": "console", "level": "info", "method": "log", "text": "dropped", "timestamp": 1,
+		"source": map[string]string{"realm": "R1"}, "args": []interface{}{},
+	})
+	browser.Emit(log.EventEntryAdded, map[string]interface{}{
+		"type": "console", "level": "warn", "method": "warn", "text": "hello 42", "timestamp": 2,
+		"source": map[string]string{"realm": "R1", "context": "C1"},
+		"args":   []interface{}{map[string]string{"type": "string", "value": "hello"}, map[string]interface{}{"type": "number", "value": 42}},
+	})
+	browser.Emit(log.EventEntryAdded, map[string]interface{}{
+		"type": "javascript", "level": "error", "text": "ReferenceError: x is not defined", "timestamp": 3,
+		"source": map[string]string{"realm": "R1", "context": "C1"},
+		"stackTrace": map[string]interface{}{"callFrames": []map[string]interface{}{
+			{"functionName": "onload", "url": "https://example.com/app.js", "lineNumber": 10, "columnNumber": 4},
+		}},
+	})
+
+	require.Eventually(t, func() bool { return collector.Dropped() == 1 }, time.Second, 10*time.Millisecond)
+
+	entries := collector.Drain()
+	require.Len(t, entries, 2)
+	assert.Empty(t, collector.Drain())
+
+	console := entries[0]
+	assert.Equal(t, log.LevelWarn, console.Level)
+	require.Len(t, console.Args, 2)
+	assert.Equal(t, "number", console.Args[1].Type)
+	assert.Equal(t, bidi.ConsoleMessage{Type: bidi.ConsoleLog, Message: "hello 42"}, console.ConsoleMessage())
+
+	jsErrors := log.JSErrors(entries)
+	require.Len(t, jsErrors, 1)
+	assert.Equal(t, "[error] ReferenceError: x is not defined\n    at onload (https://example.com/app.js:10:4)",
+		jsErrors[0].String())
+}
+
+func TestCollectorClose(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+
+	collector, err := log.Collect(context.Background(), session, 0)
+	require.NoError(t, err)
+
+	seen := make(chan struct{}, 2)
+	require.NoError(t, log.New(session).OnEntryAdded(context.Background(), func(log.Entry) { seen <- struct{}{} }))
+
+	browser.Emit(log.EventEntryAdded, consoleEntry("kept"))
+	waitFor(t, seen)
+
+	// Entries added after Close are not buffered, but the buffered ones are kept
+	collector.Close()
+	collector.Close()
+
+	browser.Emit(log.EventEntryAdded, consoleEntry("ignored"))
+	waitFor(t, seen)
+
+	entries := collector.Drain()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "kept", entries[0].Text)
+}
+
+func TestOnConsoleAndJSError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	logs := log.New(session)
+
+	console := make(chan log.Entry, 2)
+	jsErrors := make(chan log.Entry, 2)
+
+	require.NoError(t, logs.OnConsole(ctx, func(entry log.Entry) { console <- entry }))
+	require.NoError(t, logs.OnJSError(ctx, func(entry log.Entry) { jsErrors <- entry }))
+
+	browser.Emit(log.EventEntryAdded, consoleEntry("hello"))
+	browser.Emit(log.EventEntryAdded, map[string]interface{}{
+		"type": "javascript", "level": "error", "text": "Error: boom", "timestamp": 2,
+		"source": map[string]string{"realm": "R1"},
+	})
+
+	select {
+	case entry := <-console:
+		assert.Equal(t, "hello", entry.Text)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no console entry")
+	}
+
+	select {
+	case entry := <-jsErrors:
+		assert.Equal(t, "Error: boom", entry.Text)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no JavaScript error")
+	}
+
+	// Each handler only receives its own entry type
+	assert.Empty(t, console)
+	assert.Empty(t, jsErrors)
+}
+
+// consoleEntry is a console.log entry with the given text.
+func consoleEntry(text string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "console", "level": "info", "method": "log", "text": text, "timestamp": 1,
+		"source": map[string]string{"realm": "R1"}, "args": []interface{}{},
+	}
+}
+
+// waitFor fails the test if ch receives nothing in time.
+func waitFor(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for entry")
+	}
+}
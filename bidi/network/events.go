@@ -0,0 +1,96 @@
+package network
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/bidi"
+)
+
+// network events.
+const (
+	EventBeforeRequestSent = "network.beforeRequestSent"
+	EventResponseStarted   = "network.responseStarted"
+	EventResponseCompleted = "network.responseCompleted"
+	EventAuthRequired      = "network.authRequired"
+)
+
+// RequestData describes a request.
+type RequestData struct {
+	BodySize    *int           `json:"bodySize"`
+	Request     string         `json:"request"`
+	URL         string         `json:"url"`
+	Method      string         `json:"method"`
+	Destination string         `json:"destination"`
+	Headers     []Header       `json:"headers"`
+	Cookies     []CookieHeader `json:"cookies"`
+	HeadersSize int            `json:"headersSize"`
+}
+
+// Header returns the value of the first header with the given name, compared case-insensitively.
+func (r *RequestData) Header(name string) string {
+	return headerValue(r.Headers, name)
+}
+
+// ResponseData describes a response.
+type ResponseData struct {
+	BodySize      *int     `json:"bodySize"`
+	URL           string   `json:"url"`
+	Protocol      string   `json:"protocol"`
+	StatusText    string   `json:"statusText"`
+	MimeType      string   `json:"mimeType"`
+	Headers       []Header `json:"headers"`
+	Status        int      `json:"status"`
+	BytesReceived int      `json:"bytesReceived"`
+	HeadersSize   *int     `json:"headersSize"`
+	FromCache     bool     `json:"fromCache"`
+}
+
+// Header returns the value of the first header with the given name, compared case-insensitively.
+func (r *ResponseData) Header(name string) string {
+	return headerValue(r.Headers, name)
+}
+
+// Event holds the parameters shared by all network events.
+type Event struct {
+	Navigation    *string     `json:"navigation"`
+	Context       string      `json:"context"`
+	Request       RequestData `json:"request"`
+	Intercepts    []string    `json:"intercepts"`
+	Timestamp     int64       `json:"timestamp"`
+	RedirectCount int         `json:"redirectCount"`
+	IsBlocked     bool        `json:"isBlocked"`
+}
+
+// BeforeRequestSent is sent before a request is sent.
+type BeforeRequestSent struct {
+	Initiator *struct {
+		Type string `json:"type"`
+	} `json:"initiator,omitempty"`
+	Event
+}
+
+// ResponseEvent is sent when a response starts, completes or requires authentication.
+type ResponseEvent struct {
+	Event
+	Response ResponseData `json:"response"`
+}
+
+// OnBeforeRequestSent calls handler before every request is sent.
+func (n *Network) OnBeforeRequestSent(ctx context.Context, handler func(BeforeRequestSent)) error {
+	return bidi.On(ctx, n.session, EventBeforeRequestSent, handler)
+}
+
+// OnResponseStarted calls handler when response headers are received.
+func (n *Network) OnResponseStarted(ctx context.Context, handler func(ResponseEvent)) error {
+	return bidi.On(ctx, n.session, EventResponseStarted, handler)
+}
+
+// OnResponseCompleted calls handler when a response body is received.
+func (n *Network) OnResponseCompleted(ctx context.Context, handler func(ResponseEvent)) error {
+	return bidi.On(ctx, n.session, EventResponseCompleted, handler)
+}
+
+// OnAuthRequired calls handler when a request is challenged for credentials.
+func (n *Network) OnAuthRequired(ctx context.Context, handler func(ResponseEvent)) error {
+	return bidi.On(ctx, n.session, EventAuthRequired, handler)
+}
@@ -0,0 +1,231 @@
+// Package network implements the WebDriver BiDi network module.
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+
+	"github.com/Kcrong/selenium/bidi"
+)
+
+// InterceptPhase is the point in a request's life where it is blocked.
+type InterceptPhase string
+
+const (
+	// PhaseBeforeRequestSent blocks requests before they are sent.
+	PhaseBeforeRequestSent InterceptPhase = "beforeRequestSent"
+	// PhaseResponseStarted blocks responses after their headers are received.
+	PhaseResponseStarted InterceptPhase = "responseStarted"
+	// PhaseAuthRequired blocks requests that are challenged for credentials.
+	PhaseAuthRequired InterceptPhase = "authRequired"
+)
+
+// BytesValue is a header, cookie or body value.
+type BytesValue struct {
+	// Type is "string" or "base64".
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// StringValue creates a BytesValue holding text.
+func StringValue(value string) BytesValue {
+	return BytesValue{Type: "string", Value: value}
+}
+
+// Base64Value creates a BytesValue holding binary data.
+func Base64Value(data []byte) BytesValue {
+	return BytesValue{Type: "base64", Value: base64.StdEncoding.EncodeToString(data)}
+}
+
+// Bytes returns the decoded value.
+func (v BytesValue) Bytes() ([]byte, error) {
+	if v.Type == "base64" {
+		return base64.StdEncoding.DecodeString(v.Value)
+	}
+
+	return []byte(v.Value), nil
+}
+
+// Header is an HTTP header.
+type Header struct {
+	Name  string     `json:"name"`
+	Value BytesValue `json:"value"`
+}
+
+// CookieHeader is a cookie sent with a request.
+type CookieHeader struct {
+	Name  string     `json:"name"`
+	Value BytesValue `json:"value"`
+}
+
+// URLPattern matches request URLs.
+type URLPattern struct {
+	// Type is "string" for a URL pattern string or "pattern" for the component fields.
+	Type     string `json:"type"`
+	Pattern  string `json:"pattern,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     string `json:"port,omitempty"`
+	Pathname string `json:"pathname,omitempty"`
+	Search   string `json:"search,omitempty"`
+}
+
+// MatchURL creates a pattern from a URL pattern string such as https://example.com/api/*.
+func MatchURL(pattern string) URLPattern {
+	//nolint:exhaustruct // Component fields are unused for string patterns.
+	return URLPattern{Type: "string", Pattern: pattern}
+}
+
+// AddInterceptOptions restricts an intercept.
+type AddInterceptOptions struct {
+	// Contexts restricts the intercept to top-level browsing contexts.
+	Contexts []string `json:"contexts,omitempty"`
+	// URLPatterns restricts the intercept to matching URLs; empty matches every request.
+	URLPatterns []URLPattern `json:"urlPatterns,omitempty"`
+}
+
+// ContinueRequestOptions modifies a request blocked in the beforeRequestSent phase.
+//
+// Unset fields keep the original request values.
+type ContinueRequestOptions struct {
+	Body    *BytesValue    `json:"body,omitempty"`
+	Cookies []CookieHeader `json:"cookies,omitempty"`
+	Headers []Header       `json:"headers,omitempty"`
+	Method  string         `json:"method,omitempty"`
+	URL     string         `json:"url,omitempty"`
+}
+
+// ProvideResponseOptions is the response returned for a blocked request.
+type ProvideResponseOptions struct {
+	Body         *BytesValue `json:"body,omitempty"`
+	Headers      []Header    `json:"headers,omitempty"`
+	ReasonPhrase string      `json:"reasonPhrase,omitempty"`
+	StatusCode   int         `json:"statusCode,omitempty"`
+}
+
+// AuthAction is the answer to an authentication challenge.
+type AuthAction string
+
+const (
+	// AuthDefault lets the browser handle the challenge, usually by showing a dialog.
+	AuthDefault AuthAction = "default"
+	// AuthCancel cancels the challenge.
+	AuthCancel AuthAction = "cancel"
+	// AuthProvideCredentials answers the challenge with credentials.
+	AuthProvideCredentials AuthAction = "provideCredentials"
+)
+
+// Network sends network commands over a BiDi session.
+type Network struct {
+	session *bidi.Session
+	// routes maps intercept IDs to Handle handlers; removed intercepts keep a
+	// handler continuing the requests they blocked.
+	routes map[string]func(Request) Response
+	// settled is signalled when an intercept being added by Handle is registered or fails.
+	settled *sync.Cond
+	// onRouteError receives the errors of answering intercepted requests.
+	onRouteError func(error)
+	// routing is set once the router is subscribed to network.beforeRequestSent.
+	routing bool
+	// routeMu serializes subscribing the router.
+	routeMu sync.Mutex
+	mu      sync.RWMutex
+	// pending counts the intercepts Handle is adding.
+	pending int
+}
+
+// New creates a network module on the session.
+func New(session *bidi.Session) *Network {
+	//nolint:exhaustruct // Router state is initialized on first Handle.
+	n := &Network{
+		session: session,
+		routes:  make(map[string]func(Request) Response),
+	}
+	n.settled = sync.NewCond(&n.mu)
+
+	return n
+}
+
+// AddIntercept blocks requests matching the options in the given phases and returns the intercept ID.
+func (n *Network) AddIntercept(
+	ctx context.Context, phases []InterceptPhase, opts AddInterceptOptions,
+) (string, error) {
+	params := struct {
+		AddInterceptOptions
+		Phases []InterceptPhase `json:"phases"`
+	}{
+		AddInterceptOptions: opts,
+		Phases:              phases,
+	}
+
+	result, err := bidi.Run(ctx, n.session, bidi.NewBiDiCommand[struct {
+		Intercept string `json:"intercept"`
+	}]("network.addIntercept", params))
+	if err != nil {
+		return "", fmt.Errorf("failed to add intercept: %w", err)
+	}
+
+	return result.Intercept, nil
+}
+
+// RemoveIntercept stops blocking requests for an intercept.
+func (n *Network) RemoveIntercept(ctx context.Context, intercept string) error {
+	return n.run(ctx, "network.removeIntercept", map[string]interface{}{"intercept": intercept})
+}
+
+// ContinueRequest lets a blocked request proceed with optional modifications.
+func (n *Network) ContinueRequest(ctx context.Context, request string, opts ContinueRequestOptions) error {
+	return n.run(ctx, "network.continueRequest", struct {
+		ContinueRequestOptions
+		Request string `json:"request"`
+	}{
+		ContinueRequestOptions: opts,
+		Request:                request,
+	})
+}
+
+// ContinueResponse lets a request blocked in the responseStarted phase proceed unchanged.
+func (n *Network) ContinueResponse(ctx context.Context, request string) error {
+	return n.run(ctx, "network.continueResponse", map[string]interface{}{"request": request})
+}
+
+// ProvideResponse answers a blocked request without contacting the server.
+func (n *Network) ProvideResponse(ctx context.Context, request string, opts ProvideResponseOptions) error {
+	return n.run(ctx, "network.provideResponse", struct {
+		ProvideResponseOptions
+		Request string `json:"request"`
+	}{
+		ProvideResponseOptions: opts,
+		Request:                request,
+	})
+}
+
+// FailRequest fails a blocked request with a network error.
+func (n *Network) FailRequest(ctx context.Context, request string) error {
+	return n.run(ctx, "network.failRequest", map[string]interface{}{"request": request})
+}
+
+// ContinueWithAuth answers a request blocked in the authRequired phase.
+//
+// username and password are only sent with AuthProvideCredentials.
+func (n *Network) ContinueWithAuth(
+	ctx context.Context, request string, action AuthAction, username, password string,
+) error {
+	params := map[string]interface{}{"request": request, "action": action}
+	if action == AuthProvideCredentials {
+		params["credentials"] = map[string]string{"type": "password", "username": username, "password": password}
+	}
+
+	return n.run(ctx, "network.continueWithAuth", params)
+}
+
+// run sends a command whose result is empty.
+func (n *Network) run(ctx context.Context, method string, params interface{}) error {
+	if _, err := bidi.Run(ctx, n.session, bidi.NewBiDiCommand[struct{}](method, params)); err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package network_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi/biditest"
+	"github.com/Kcrong/selenium/bidi/network"
+)
+
+func blockedRequest(request, url, intercept string) map[string]interface{} {
+	return map[string]interface{}{
+		"context":       "C1",
+		"isBlocked":     true,
+		"intercepts":    []string{intercept},
+		"redirectCount": 0,
+		"timestamp":     1700000000000,
+		"request": map[string]interface{}{
+			"request": request,
+			"url":     url,
+			"method":  "GET",
+			"headers": []map[string]interface{}{
+				{"name": "Accept", "value": map[string]string{"type": "string", "value": "application/json"}},
+			},
+		},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	net := network.New(session)
+
+	var intercepts atomic.Int32
+
+	browser.Handle("network.addIntercept", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"intercept": "I" + strconv.Itoa(int(intercepts.Add(1)))}, nil
+	})
+
+	mocked, err := net.Handle(ctx, "https://example.com/api/*", func(req network.Request) network.Response {
+		assert.Equal(t, "application/json", req.Header("accept"))
+
+		return network.Fulfill(200, map[string]string{"Content-Type": "application/json"}, []byte(`{"ok":true}`))
+	})
+	require.NoError(t, err)
+
+	failed, err := net.Handle(ctx, "https://ads.example.com/*", func(network.Request) network.Response {
+		return network.Fail()
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"events": ["network.beforeRequestSent"]}`, string(browser.Commands("session.subscribe")[0]))
+	assert.JSONEq(t, `{"phases": ["beforeRequestSent"], "urlPatterns": [{"type": "string", "pattern": "https://example.com/api/*"}]}`,
+		string(browser.Commands("network.addIntercept")[0]))
+
+	browser.Emit(network.EventBeforeRequestSent, blockedRequest("R1", "https://example.com/api/user", mocked))
+	browser.Emit(network.EventBeforeRequestSent, blockedRequest("R2", "https://ads.example.com/banner", failed))
+
+	assert.Eventually(t, func() bool {
+		return len(browser.Commands("network.provideResponse")) == 1 && len(browser.Commands("network.failRequest")) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	assert.JSONEq(t, `{"request": "R1", "statusCode": 200,
+		"headers": [{"name": "Content-Type", "value": {"type": "string", "value": "application/json"}}],
+		"body": {"type": "base64", "value": "eyJvayI6dHJ1ZX0="}}`,
+		string(browser.Commands("network.provideResponse")[0]))
+	assert.JSONEq(t, `{"request": "R2"}`, string(browser.Commands("network.failRequest")[0]))
+
+	require.NoError(t, net.Unhandle(ctx, mocked))
+	assert.JSONEq(t, `{"intercept": "I1"}`, string(browser.Commands("network.removeIntercept")[0]))
+}
+
+func TestContinueWithAuth(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+	net := network.New(session)
+
+	require.NoError(t, net.ContinueWithAuth(context.Background(), "R1", network.AuthProvideCredentials, "user", "pass"))
+	assert.JSONEq(t, `{"request": "R1", "action": "provideCredentials",
+		"credentials": {"type": "password", "username": "user", "password": "pass"}}`,
+		string(browser.Commands("network.continueWithAuth")[0]))
+}
+
+func TestHandleModify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	net := network.New(session)
+
+	browser.Handle("network.addIntercept", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"intercept": "I1"}, nil
+	})
+
+	body := network.StringValue(`{"name":"test"}`)
+	intercept, err := net.Handle(ctx, "https://example.com/api/*", func(network.Request) network.Response {
+		return network.Modify(network.ContinueRequestOptions{
+			Body:    &body,
+			Cookies: nil,
+			Headers: []network.Header{{Name: "Authorization", Value: network.StringValue("Bearer token")}},
+			Method:  "POST",
+			URL:     "",
+		})
+	})
+	require.NoError(t, err)
+
+	browser.Emit(network.EventBeforeRequestSent, blockedRequest("R1", "https://example.com/api/user", intercept))
+
+	assert.Eventually(t, func() bool {
+		return len(browser.Commands("network.continueRequest")) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	assert.JSONEq(t, `{"request": "R1", "method": "POST",
+		"headers": [{"name": "Authorization", "value": {"type": "string", "value": "Bearer token"}}],
+		"body": {"type": "string", "value": "{\"name\":\"test\"}"}}`,
+		string(browser.Commands("network.continueRequest")[0]))
+}
+
+func TestHandleRetriesSubscription(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	net := network.New(session)
+
+	var subscriptions atomic.Int32
+
+	browser.Handle("session.subscribe", func(json.RawMessage) (interface{}, error) {
+		if subscriptions.Add(1) == 1 {
+			return nil, errors.New("not ready")
+		}
+
+		return struct{}{}, nil
+	})
+	browser.Handle("network.addIntercept", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"intercept": "I1"}, nil
+	})
+
+	handler := func(network.Request) network.Response { return network.Continue() }
+
+	_, err := net.Handle(ctx, "https://example.com/*", handler)
+	require.Error(t, err)
+
+	// The next Handle subscribes again instead of returning the first error
+	_, err = net.Handle(ctx, "https://example.com/*", handler)
+	require.NoError(t, err)
+
+	_, err = net.Handle(ctx, "https://example.org/*", handler)
+	require.NoError(t, err)
+	assert.Len(t, browser.Commands("session.subscribe"), 2)
+}
+
+func TestHandleReportsRouteErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	net := network.New(session)
+
+	browser.Handle("network.addIntercept", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"intercept": "I1"}, nil
+	})
+	browser.Handle("network.continueRequest", func(json.RawMessage) (interface{}, error) {
+		return nil, errors.New("no such request")
+	})
+
+	routeErrors := make(chan error, 1)
+	net.OnRouteError(func(err error) { routeErrors <- err })
+
+	intercept, err := net.Handle(ctx, "https://example.com/*", func(network.Request) network.Response {
+		return network.Continue()
+	})
+	require.NoError(t, err)
+
+	browser.Emit(network.EventBeforeRequestSent, blockedRequest("R1", "https://example.com/", intercept))
+
+	select {
+	case err := <-routeErrors:
+		require.ErrorContains(t, err, "R1")
+		require.ErrorContains(t, err, "no such request")
+	case <-time.After(5 * time.Second):
+		t.Fatal("route error was not reported")
+	}
+}
+
+func TestHandleRequestBlockedBeforeInterceptIsKnown(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	net := network.New(session)
+
+	// The browser blocks a request before the addIntercept response arrives
+	browser.Handle("network.addIntercept", func(json.RawMessage) (interface{}, error) {
+		browser.Emit(network.EventBeforeRequestSent, blockedRequest("R1", "https://example.com/", "I1"))
+
+		return map[string]string{"intercept": "I1"}, nil
+	})
+
+	_, err := net.Handle(ctx, "https://example.com/*", func(network.Request) network.Response {
+		return network.Fail()
+	})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return len(browser.Commands("network.failRequest")) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestUnhandleAnswersBlockedRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	net := network.New(session)
+
+	browser.Handle("network.addIntercept", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"intercept": "I1"}, nil
+	})
+
+	// R1 is blocked while the intercept is being removed and R2 was queued before
+	browser.Handle("network.removeIntercept", func(json.RawMessage) (interface{}, error) {
+		browser.Emit(network.EventBeforeRequestSent, blockedRequest("R1", "https://example.com/", "I1"))
+
+		return struct{}{}, nil
+	})
+
+	intercept, err := net.Handle(ctx, "https://example.com/*", func(network.Request) network.Response {
+		return network.Fail()
+	})
+	require.NoError(t, err)
+	require.NoError(t, net.Unhandle(ctx, intercept))
+
+	browser.Emit(network.EventBeforeRequestSent, blockedRequest("R2", "https://example.com/", intercept))
+
+	// Every blocked request is answered once; R2 arrives after the removal and continues
+	answered := func(method string) []string {
+		var requests []string
+
+		for _, params := range browser.Commands(method) {
+			var answer struct {
+				Request string `json:"request"`
+			}
+			require.NoError(t, json.Unmarshal(params, &answer))
+			requests = append(requests, answer.Request)
+		}
+
+		return requests
+	}
+
+	assert.Eventually(t, func() bool {
+		return len(answered("network.failRequest"))+len(answered("network.continueRequest")) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.ElementsMatch(t, []string{"R1", "R2"},
+		append(answered("network.failRequest"), answered("network.continueRequest")...))
+	assert.Contains(t, answered("network.continueRequest"), "R2")
+}
@@ -0,0 +1,250 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// respondTimeout bounds answering an intercepted request.
+const respondTimeout = 30 * time.Second
+
+// routeAction is what Handle does with an intercepted request.
+type routeAction int
+
+const (
+	// actionDefault fulfills the request when StatusCode is set, otherwise continues it.
+	actionDefault routeAction = iota
+	actionModify
+	actionFail
+)
+
+// Request is an intercepted request passed to a Handle handler.
+type Request struct {
+	RequestData
+	// Context is the browsing context that sent the request.
+	Context string
+}
+
+// Response tells Handle how to answer an intercepted request.
+//
+// The zero Response lets the request continue unchanged; setting StatusCode
+// answers it with a mocked response.
+type Response struct {
+	Headers    map[string]string
+	overrides  ContinueRequestOptions
+	Body       []byte
+	StatusCode int
+	action     routeAction
+}
+
+// Continue lets the request continue unchanged.
+func Continue() Response {
+	//nolint:exhaustruct // The zero Response continues the request.
+	return Response{}
+}
+
+// Modify lets the request continue with the given changes.
+func Modify(opts ContinueRequestOptions) Response {
+	//nolint:exhaustruct // Only the overrides are used.
+	return Response{overrides: opts, action: actionModify}
+}
+
+// Fail fails the request with a network error.
+func Fail() Response {
+	//nolint:exhaustruct // Nothing is sent back.
+	return Response{action: actionFail}
+}
+
+// Fulfill answers the request without contacting the server.
+func Fulfill(statusCode int, headers map[string]string, body []byte) Response {
+	//nolint:exhaustruct // The default action fulfills when StatusCode is set.
+	return Response{Headers: headers, Body: body, StatusCode: statusCode}
+}
+
+// Handle intercepts requests matching a URL pattern such as https://example.com/api/*
+// and answers them with handler. It returns the intercept ID to pass to Unhandle.
+//
+// Handlers run on the session event goroutine, one request at a time.
+// Errors answering a request are passed to the OnRouteError handler.
+func (n *Network) Handle(ctx context.Context, pattern string, handler func(Request) Response) (string, error) {
+	if err := n.subscribeRouter(ctx); err != nil {
+		return "", err
+	}
+
+	// A request may be blocked by the intercept before its ID is known, so
+	// route waits for pending intercepts instead of dropping the request.
+	n.mu.Lock()
+	n.pending++
+	n.mu.Unlock()
+
+	//nolint:exhaustruct // Every context is intercepted.
+	intercept, err := n.AddIntercept(ctx, []InterceptPhase{PhaseBeforeRequestSent}, AddInterceptOptions{
+		URLPatterns: []URLPattern{MatchURL(pattern)},
+	})
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.pending--
+	n.settled.Broadcast()
+
+	if err != nil {
+		return "", err
+	}
+
+	n.routes[intercept] = handler
+
+	return intercept, nil
+}
+
+// OnRouteError sets the handler receiving the errors of answering requests intercepted by Handle.
+func (n *Network) OnRouteError(handler func(error)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.onRouteError = handler
+}
+
+// subscribeRouter subscribes route to network.beforeRequestSent once.
+//
+// A failed subscription is retried by the next Handle.
+func (n *Network) subscribeRouter(ctx context.Context) error {
+	n.routeMu.Lock()
+	defer n.routeMu.Unlock()
+
+	if n.routing {
+		return nil
+	}
+
+	if err := n.OnBeforeRequestSent(ctx, n.route); err != nil {
+		return err
+	}
+
+	n.routing = true
+
+	return nil
+}
+
+// Unhandle removes an intercept added by Handle.
+//
+// Requests the intercept blocked before it was removed are still answered:
+// by the handler until the browser confirms the removal, and afterwards by
+// letting them continue unchanged.
+func (n *Network) Unhandle(ctx context.Context, intercept string) error {
+	if err := n.RemoveIntercept(ctx, intercept); err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	n.routes[intercept] = func(Request) Response { return Continue() }
+	n.mu.Unlock()
+
+	return nil
+}
+
+// route answers a blocked request with the handler of its intercept.
+func (n *Network) route(event BeforeRequestSent) {
+	if !event.IsBlocked {
+		return
+	}
+
+	handler := n.handler(event.Intercepts)
+
+	if handler == nil {
+		// Blocked by an intercept added outside Handle
+		return
+	}
+
+	response := handler(Request{RequestData: event.Request, Context: event.Context})
+
+	ctx, cancel := context.WithTimeout(context.Background(), respondTimeout)
+	defer cancel()
+
+	if err := n.respond(ctx, event.Request.Request, response); err != nil {
+		n.mu.RLock()
+		onRouteError := n.onRouteError
+		n.mu.RUnlock()
+
+		if onRouteError != nil {
+			onRouteError(fmt.Errorf("failed to answer request %s: %w", event.Request.Request, err))
+		}
+	}
+}
+
+// handler returns the Handle handler of the first intercept that has one,
+// waiting for intercepts Handle is still adding.
+func (n *Network) handler(intercepts []string) func(Request) Response {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for {
+		for _, intercept := range intercepts {
+			if handler := n.routes[intercept]; handler != nil {
+				return handler
+			}
+		}
+
+		if n.pending == 0 {
+			return nil
+		}
+
+		n.settled.Wait()
+	}
+}
+
+// respond sends the command matching the response.
+func (n *Network) respond(ctx context.Context, request string, response Response) error {
+	switch {
+	case response.action == actionFail:
+		return n.FailRequest(ctx, request)
+	case response.action == actionModify:
+		return n.ContinueRequest(ctx, request, response.overrides)
+	case response.StatusCode > 0:
+		//nolint:exhaustruct // The reason phrase defaults to the status text.
+		opts := ProvideResponseOptions{
+			Headers:    toHeaders(response.Headers),
+			StatusCode: response.StatusCode,
+		}
+
+		if response.Body != nil {
+			body := Base64Value(response.Body)
+			opts.Body = &body
+		}
+
+		return n.ProvideResponse(ctx, request, opts)
+	default:
+		//nolint:exhaustruct // The request continues unchanged.
+		return n.ContinueRequest(ctx, request, ContinueRequestOptions{})
+	}
+}
+
+// toHeaders converts a header map to headers sorted by name.
+func toHeaders(headers map[string]string) []Header {
+	result := make([]Header, 0, len(headers))
+	for name, value := range headers {
+		result = append(result, Header{Name: name, Value: StringValue(value)})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+// headerValue returns the value of the first header with the given name.
+func headerValue(headers []Header, name string) string {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			value, err := header.Value.Bytes()
+			if err != nil {
+				return ""
+			}
+
+			return string(value)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,477 @@
+package bidi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/Kcrong/selenium"
+)
+
+// ErrUnsupportedValue is returned when a Go value has no BiDi representation.
+var ErrUnsupportedValue = errors.New("unsupported value")
+
+// undefinedType is the type of Undefined.
+type undefinedType struct{}
+
+// Undefined is the JavaScript undefined value.
+var Undefined = undefinedType{}
+
+// RegExp is a JavaScript regular expression.
+type RegExp struct {
+	Pattern string `json:"pattern"`
+	Flags   string `json:"flags,omitempty"`
+}
+
+// MapEntry is an entry of a JavaScript Map.
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// Map is a JavaScript Map; it keeps the insertion order and allows non-string keys.
+type Map []MapEntry
+
+// Set is a JavaScript Set.
+type Set []interface{}
+
+// WindowProxy is a reference to a browsing context window.
+type WindowProxy struct {
+	Context string `json:"context"`
+}
+
+// Channel creates a script.message channel when passed as an argument.
+//
+// The function receives a callback that sends its argument to the channel.
+type Channel struct {
+	ID string
+}
+
+// bidiDateLayout is the ISO 8601 format of JavaScript Date.prototype.toISOString.
+const bidiDateLayout = "2006-01-02T15:04:05.000Z"
+
+// ToLocalValue converts a Go value to a BiDi local value for script arguments.
+//
+// nil becomes null; use Undefined for undefined. Numbers, strings, booleans,
+// *big.Int, time.Time, RegExp, Map, Set, slices, maps with string keys and
+// structs (through their JSON encoding) are serialized. NaN, ±Inf and -0 keep
+// their JavaScript meaning. SharedReference, NodeRemoteValue,
+// selenium.WebElement and RemoteValue values with a handle are sent as
+// references; a RemoteValue without one must be a primitive, date or regexp.
+// Channel creates a channel.
+func ToLocalValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return typed("null", nil), nil
+	case undefinedType:
+		return typed("undefined", nil), nil
+	case string:
+		return typed("string", v), nil
+	case bool:
+		return typed("boolean", v), nil
+	case *big.Int:
+		return typed("bigint", v.String()), nil
+	case time.Time:
+		return typed("date", v.UTC().Format(bidiDateLayout)), nil
+	case RegExp:
+		return typed("regexp", v), nil
+	case SharedReference:
+		return v, nil
+	case NodeRemoteValue:
+		return v.Reference(), nil
+	case selenium.WebElement:
+		return ElementReference(v), nil
+	case RemoteValue:
+		if v.Handle == "" && v.SharedID == "" {
+			return v.withoutIDs()
+		}
+
+		if v.SharedID == "" {
+			return map[string]string{"handle": v.Handle}, nil
+		}
+
+		return SharedReference{SharedID: v.SharedID, Handle: v.Handle}, nil
+	case Channel:
+		return typed("channel", map[string]string{"channel": v.ID}), nil
+	case Map:
+		return mapValue("map", v)
+	case Set:
+		items, err := listValue(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return typed("set", items), nil
+	case json.RawMessage:
+		var decoded interface{}
+		if err := json.Unmarshal(v, &decoded); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnsupportedValue, err)
+		}
+
+		return ToLocalValue(decoded)
+	}
+
+	return reflectLocalValue(reflect.ValueOf(value))
+}
+
+// reflectLocalValue converts numbers, slices, maps and structs.
+func reflectLocalValue(rv reflect.Value) (interface{}, error) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return typed("number", rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return typed("number", rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return numberValue(rv.Float()), nil
+	case reflect.String:
+		return typed("string", rv.String()), nil
+	case reflect.Bool:
+		return typed("boolean", rv.Bool()), nil
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return typed("null", nil), nil
+		}
+
+		return ToLocalValue(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+
+		list, err := listValue(items)
+		if err != nil {
+			return nil, err
+		}
+
+		return typed("array", list), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("%w: map key %s; use bidi.Map", ErrUnsupportedValue, rv.Type().Key())
+		}
+
+		entries := make(Map, 0, rv.Len())
+		for _, key := range rv.MapKeys() {
+			entries = append(entries, MapEntry{Key: key.String(), Value: rv.MapIndex(key).Interface()})
+		}
+
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Key.(string) < entries[j].Key.(string) })
+
+		return mapValue("object", entries)
+	case reflect.Struct:
+		data, err := json.Marshal(rv.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnsupportedValue, err)
+		}
+
+		return ToLocalValue(json.RawMessage(data))
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedValue, rv.Type())
+	}
+}
+
+// typed builds a {type, value} local value.
+func typed(valueType string, value interface{}) map[string]interface{} {
+	if value == nil {
+		return map[string]interface{}{"type": valueType}
+	}
+
+	return map[string]interface{}{"type": valueType, "value": value}
+}
+
+// numberValue encodes a float, keeping the JavaScript special values.
+func numberValue(f float64) map[string]interface{} {
+	switch {
+	case math.IsNaN(f):
+		return typed("number", "NaN")
+	case math.IsInf(f, 1):
+		return typed("number", "Infinity")
+	case math.IsInf(f, -1):
+		return typed("number", "-Infinity")
+	case f == 0 && math.Signbit(f):
+		return typed("number", "-0")
+	default:
+		return typed("number", f)
+	}
+}
+
+// listValue converts every item of a list.
+func listValue(items []interface{}) ([]interface{}, error) {
+	list := make([]interface{}, len(items))
+
+	for i, item := range items {
+		converted, err := ToLocalValue(item)
+		if err != nil {
+			return nil, err
+		}
+
+		list[i] = converted
+	}
+
+	return list, nil
+}
+
+// mapValue converts entries to an object or map local value; string keys are sent as is.
+func mapValue(valueType string, entries Map) (interface{}, error) {
+	pairs := make([]interface{}, len(entries))
+
+	for i, entry := range entries {
+		key, ok := entry.Key.(string)
+
+		var encodedKey interface{} = key
+		if !ok {
+			converted, err := ToLocalValue(entry.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			encodedKey = converted
+		}
+
+		value, err := ToLocalValue(entry.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs[i] = []interface{}{encodedKey, value}
+	}
+
+	return typed(valueType, pairs), nil
+}
+
+// withoutIDs returns a value without references as a local value.
+//
+// Only primitives, dates and regular expressions have the same remote and
+// local form; other values can only be sent back by handle or shared id.
+func (v RemoteValue) withoutIDs() (map[string]interface{}, error) {
+	switch v.Type {
+	case "undefined", "null", "string", "number", "boolean", "bigint", "date", "regexp":
+	default:
+		return nil, fmt.Errorf("%w: %s remote value without a handle", ErrUnsupportedValue, v.Type)
+	}
+
+	if len(v.Value) == 0 {
+		return typed(v.Type, nil), nil
+	}
+
+	return typed(v.Type, v.Value), nil
+}
+
+// Decode converts the remote value to a Go value.
+//
+// Primitives become nil (null), Undefined, string, bool, float64 (including
+// NaN, ±Inf and -0) and *big.Int; date becomes time.Time, regexp RegExp,
+// array []interface{}, set Set, map Map, object map[string]interface{},
+// node NodeRemoteValue and window WindowProxy. Other types, such as
+// functions and promises, are returned as the RemoteValue itself so they can
+// be passed back by handle.
+func (v RemoteValue) Decode() (interface{}, error) {
+	if len(v.Value) == 0 && v.Type != "undefined" && v.Type != "null" {
+		// Values beyond the serialization depth only carry a reference
+		return v, nil
+	}
+
+	switch v.Type {
+	case "undefined":
+		return Undefined, nil
+	case "null":
+		return nil, nil //nolint:nilnil // null decodes to nil.
+	case "string":
+		var s string
+		if err := v.unmarshal(&s); err != nil {
+			return nil, err
+		}
+
+		return s, nil
+	case "boolean":
+		var b bool
+		if err := v.unmarshal(&b); err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	case "number":
+		return v.decodeNumber()
+	case "bigint":
+		var s string
+		if err := v.unmarshal(&s); err != nil {
+			return nil, err
+		}
+
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid bigint %q", ErrInvalidRemoteValue, s)
+		}
+
+		return n, nil
+	case "date":
+		var s string
+		if err := v.unmarshal(&s); err != nil {
+			return nil, err
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRemoteValue, err)
+		}
+
+		return t, nil
+	case "regexp":
+		var r RegExp
+		if err := v.unmarshal(&r); err != nil {
+			return nil, err
+		}
+
+		return r, nil
+	case "array":
+		return v.decodeList()
+	case "set":
+		items, err := v.decodeList()
+		return Set(items), err
+	case "map":
+		return v.decodeEntries()
+	case "object":
+		return v.decodeObject()
+	case "node":
+		var node NodeRemoteValue
+		//nolint:errchkjson // RemoteValue always marshals.
+		data, _ := json.Marshal(v)
+
+		if err := json.Unmarshal(data, &node); err != nil {
+			return nil, fmt.Errorf("%w: node: %w", ErrInvalidRemoteValue, err)
+		}
+
+		return node, nil
+	case "window":
+		var w WindowProxy
+		if err := v.unmarshal(&w); err != nil {
+			return nil, err
+		}
+
+		return w, nil
+	default:
+		return v, nil
+	}
+}
+
+// ErrInvalidRemoteValue is returned when a remote value does not match its type.
+var ErrInvalidRemoteValue = errors.New("invalid remote value")
+
+// unmarshal decodes the value member.
+func (v RemoteValue) unmarshal(out interface{}) error {
+	if err := json.Unmarshal(v.Value, out); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidRemoteValue, v.Type, err)
+	}
+
+	return nil
+}
+
+// decodeNumber decodes a number, including the special values sent as strings.
+func (v RemoteValue) decodeNumber() (interface{}, error) {
+	var special string
+	if err := json.Unmarshal(v.Value, &special); err == nil {
+		switch special {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		case "-0":
+			return math.Copysign(0, -1), nil
+		default:
+			return nil, fmt.Errorf("%w: number %q", ErrInvalidRemoteValue, special)
+		}
+	}
+
+	var f float64
+
+	if err := v.unmarshal(&f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// decodeList decodes array and set items.
+func (v RemoteValue) decodeList() ([]interface{}, error) {
+	var items []RemoteValue
+	if err := v.unmarshal(&items); err != nil {
+		return nil, err
+	}
+
+	list := make([]interface{}, len(items))
+
+	for i, item := range items {
+		decoded, err := item.Decode()
+		if err != nil {
+			return nil, err
+		}
+
+		list[i] = decoded
+	}
+
+	return list, nil
+}
+
+// decodeEntries decodes the [key, value] pairs of maps and objects.
+func (v RemoteValue) decodeEntries() (Map, error) {
+	var pairs [][2]json.RawMessage
+	if err := v.unmarshal(&pairs); err != nil {
+		return nil, err
+	}
+
+	entries := make(Map, len(pairs))
+
+	for i, pair := range pairs {
+		var key interface{}
+
+		var stringKey string
+		if err := json.Unmarshal(pair[0], &stringKey); err == nil {
+			key = stringKey
+		} else {
+			var remoteKey RemoteValue
+			if err := json.Unmarshal(pair[0], &remoteKey); err != nil {
+				return nil, fmt.Errorf("%w: map key: %w", ErrInvalidRemoteValue, err)
+			}
+
+			if key, err = remoteKey.Decode(); err != nil {
+				return nil, err
+			}
+		}
+
+		var remoteValue RemoteValue
+		if err := json.Unmarshal(pair[1], &remoteValue); err != nil {
+			return nil, fmt.Errorf("%w: map value: %w", ErrInvalidRemoteValue, err)
+		}
+
+		value, err := remoteValue.Decode()
+		if err != nil {
+			return nil, err
+		}
+
+		entries[i] = MapEntry{Key: key, Value: value}
+	}
+
+	return entries, nil
+}
+
+// decodeObject decodes an object to a map with string keys.
+func (v RemoteValue) decodeObject() (map[string]interface{}, error) {
+	entries, err := v.decodeEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	object := make(map[string]interface{}, len(entries))
+	for _, entry := range entries {
+		object[fmt.Sprint(entry.Key)] = entry.Value
+	}
+
+	return object, nil
+}
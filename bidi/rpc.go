@@ -0,0 +1,281 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrSessionClosed is returned by commands sent after the session was closed
+// or its connection was lost; the read error, if any, is wrapped with it.
+var ErrSessionClosed = errors.New("session is closed")
+
+// response is the outcome of a command.
+type response struct {
+	err    error
+	result json.RawMessage
+}
+
+// event is a message without an ID.
+type event struct {
+	sessionID string
+	method    string
+	params    json.RawMessage
+}
+
+// decodeFunc classifies a received message as a response to the command with
+// the returned ID, or as an event. ok is false for messages that cannot be routed.
+type decodeFunc func(data []byte) (id *int64, resp response, ev event, ok bool)
+
+// rpc matches commands to responses over a WebSocket for either protocol.
+//
+// A read loop delivers responses and hands events to a dispatcher goroutine,
+// so event handlers may execute commands. The event queue is unbounded so
+// that a slow handler waiting for a response never blocks the read loop.
+type rpc struct {
+	ws       WebSocket
+	inflight map[int64]chan response
+	// queued is signalled when events are queued and closed when the read loop stops.
+	queued  chan struct{}
+	done    chan struct{}
+	decode  decodeFunc
+	onEvent func(event)
+	// err is the reason the read loop stopped.
+	err    error
+	nextID atomic.Int64
+	// mu guards inflight, closed and err.
+	mu sync.RWMutex
+	// sendMu serializes writes to ws.
+	sendMu sync.Mutex
+	// queueMu guards events.
+	queueMu sync.Mutex
+	events  []event
+	closed  bool
+}
+
+// newRPC starts reading from ws.
+func newRPC(ws WebSocket, decode decodeFunc, onEvent func(event)) *rpc {
+	//nolint:exhaustruct // Initialize memory-sensitive fields only for better readability.
+	r := &rpc{
+		ws:       ws,
+		inflight: make(map[int64]chan response),
+		queued:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		decode:   decode,
+		onEvent:  onEvent,
+	}
+
+	go r.readLoop()
+	go r.dispatchLoop()
+
+	return r
+}
+
+// call sends the envelope built for a fresh command ID and waits for the response.
+func (r *rpc) call(ctx context.Context, envelope func(id int64) interface{}) (json.RawMessage, error) {
+	id := r.nextID.Add(1)
+
+	data, err := json.Marshal(envelope(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal command: %w", err)
+	}
+
+	resultCh := make(chan response, 1)
+
+	r.mu.Lock()
+	if r.closed {
+		err := r.closedError()
+		r.mu.Unlock()
+
+		return nil, err
+	}
+
+	r.inflight[id] = resultCh
+	r.mu.Unlock()
+
+	r.sendMu.Lock()
+	err = r.ws.Send(data)
+	r.sendMu.Unlock()
+
+	if err != nil {
+		r.removeInflight(id)
+
+		return nil, fmt.Errorf("failed to send command: %w", err)
+	}
+
+	select {
+	case resp, ok := <-resultCh:
+		if !ok {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			return nil, r.closedError()
+		}
+
+		if resp.err != nil {
+			return nil, resp.err
+		}
+
+		return resp.result, nil
+	case <-ctx.Done():
+		r.removeInflight(id)
+
+		return nil, ctx.Err()
+	}
+}
+
+// closedError returns ErrSessionClosed wrapping the read error, if any. mu must be held.
+func (r *rpc) closedError() error {
+	if r.err != nil {
+		return fmt.Errorf("%w: %w", ErrSessionClosed, r.err)
+	}
+
+	return ErrSessionClosed
+}
+
+// removeInflight stops waiting for the response to the command with the given ID.
+func (r *rpc) removeInflight(id int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The map is nil once the connection is closed.
+	delete(r.inflight, id)
+}
+
+// readLoop delivers responses and queues events until the WebSocket fails or is closed.
+func (r *rpc) readLoop() {
+	defer close(r.done)
+	defer close(r.queued)
+
+	for {
+		data, err := r.ws.Receive()
+		if err != nil {
+			r.shutdown(err)
+
+			return
+		}
+
+		id, resp, ev, ok := r.decode(data)
+		if !ok {
+			// Malformed messages cannot be routed and are dropped.
+			continue
+		}
+
+		if id != nil {
+			r.deliver(*id, resp)
+
+			continue
+		}
+
+		r.enqueue(ev)
+	}
+}
+
+// enqueue queues an event for the dispatcher without blocking.
+func (r *rpc) enqueue(ev event) {
+	r.queueMu.Lock()
+	r.events = append(r.events, ev)
+	r.queueMu.Unlock()
+
+	select {
+	case r.queued <- struct{}{}:
+	default:
+		// The dispatcher has already been signalled.
+	}
+}
+
+// dispatchLoop passes queued events to onEvent in order until the read loop
+// stops and the queue is drained.
+func (r *rpc) dispatchLoop() {
+	for {
+		_, open := <-r.queued
+
+		for {
+			r.queueMu.Lock()
+			events := r.events
+			r.events = nil
+			r.queueMu.Unlock()
+
+			if len(events) == 0 {
+				break
+			}
+
+			for _, ev := range events {
+				if r.onEvent != nil {
+					r.onEvent(ev)
+				}
+			}
+		}
+
+		if !open {
+			return
+		}
+	}
+}
+
+// deliver hands a response to the command waiting for it.
+func (r *rpc) deliver(id int64, resp response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ch, ok := r.inflight[id]
+	if !ok {
+		// The caller gave up waiting.
+		return
+	}
+
+	delete(r.inflight, id)
+	ch <- resp
+}
+
+// shutdown fails every pending command after the read loop stopped.
+func (r *rpc) shutdown(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return
+	}
+
+	r.closed = true
+	r.err = err
+
+	for _, ch := range r.inflight {
+		close(ch)
+	}
+
+	r.inflight = nil
+}
+
+// stopErr returns the reason the read loop stopped, or nil while it is running or after close.
+func (r *rpc) stopErr() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.err
+}
+
+// close fails every pending command and closes the WebSocket.
+func (r *rpc) close() error {
+	r.mu.Lock()
+
+	if r.closed {
+		r.mu.Unlock()
+
+		return nil
+	}
+
+	r.closed = true
+	for _, ch := range r.inflight {
+		close(ch)
+	}
+
+	r.inflight = nil
+	r.mu.Unlock()
+
+	// Closing the WebSocket unblocks the read loop.
+	return r.ws.Close()
+}
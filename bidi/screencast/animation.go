@@ -0,0 +1,237 @@
+package screencast
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	_ "image/jpeg" // Register the JPEG decoder for frames.
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"time"
+)
+
+const (
+	// lastFrameDelay is the delay of the last frame, which has no successor.
+	lastFrameDelay = time.Second
+	// minFrameDelay is the shortest delay browsers honor in animated images.
+	minFrameDelay = 20 * time.Millisecond
+)
+
+var (
+	// ErrNoFrames is returned when encoding an animation without frames.
+	ErrNoFrames = errors.New("no frames")
+	// errTruncatedPNG is returned when a PNG chunk runs past the end of the image.
+	errTruncatedPNG = errors.New("truncated PNG chunk")
+)
+
+// EncodeGIF writes the frames as an animated GIF.
+//
+// Frames are dithered to the Plan 9 palette and shown for the time until the
+// next frame.
+func EncodeGIF(w io.Writer, frames []Frame) error {
+	images, delays, err := load(frames)
+	if err != nil {
+		return err
+	}
+
+	anim := &gif.GIF{
+		Image:     make([]*image.Paletted, len(images)),
+		Delay:     make([]int, len(images)),
+		LoopCount: 0,
+	}
+
+	for i, img := range images {
+		paletted := image.NewPaletted(img.Bounds(), palette.Plan9)
+		draw.FloydSteinberg.Draw(paletted, img.Bounds(), img, image.Point{})
+
+		anim.Image[i] = paletted
+		anim.Delay[i] = int(delays[i] / (10 * time.Millisecond))
+	}
+
+	if err := gif.EncodeAll(w, anim); err != nil {
+		return fmt.Errorf("failed to encode GIF: %w", err)
+	}
+
+	return nil
+}
+
+// EncodeAPNG writes the frames as an animated PNG.
+//
+// Browsers without APNG support show the first frame.
+func EncodeAPNG(w io.Writer, frames []Frame) error {
+	images, delays, err := load(frames)
+	if err != nil {
+		return err
+	}
+
+	bounds := images[0].Bounds()
+	out := &chunkWriter{w: w, err: nil}
+	out.write([]byte("\x89PNG\r\n\x1a\n"))
+
+	var sequence uint32
+
+	for i, img := range images {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return fmt.Errorf("failed to encode frame %d: %w", i, err)
+		}
+
+		ihdr, idat, err := splitPNG(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("frame %d: %w", i, err)
+		}
+
+		if i == 0 {
+			out.chunk("IHDR", ihdr)
+			out.chunk("acTL", be32(uint32(len(images)), 0))
+		}
+
+		// fcTL: sequence, size, offset, delay as a fraction of a second, dispose and blend ops
+		fctl := be32(sequence, uint32(bounds.Dx()), uint32(bounds.Dy()), 0, 0)
+		fctl = append(fctl, be16(uint16(min(delays[i].Milliseconds(), math.MaxUint16)), 1000)...)
+		fctl = append(fctl, 0, 0)
+		out.chunk("fcTL", fctl)
+		sequence++
+
+		if i == 0 {
+			out.chunk("IDAT", idat)
+			continue
+		}
+
+		out.chunk("fdAT", append(be32(sequence), idat...))
+		sequence++
+	}
+
+	out.chunk("IEND", nil)
+
+	if out.err != nil {
+		return fmt.Errorf("failed to write APNG: %w", out.err)
+	}
+
+	return nil
+}
+
+// load decodes the frames onto an opaque canvas of the size of the first
+// frame and returns them with their display time.
+func load(frames []Frame) ([]*image.RGBA, []time.Duration, error) {
+	if len(frames) == 0 {
+		return nil, nil, ErrNoFrames
+	}
+
+	images := make([]*image.RGBA, len(frames))
+	delays := make([]time.Duration, len(frames))
+
+	var bounds image.Rectangle
+
+	for i, frame := range frames {
+		img, err := decode(frame.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if i == 0 {
+			bounds = image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy())
+		}
+
+		canvas := image.NewRGBA(bounds)
+		draw.Draw(canvas, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(canvas, bounds, img, img.Bounds().Min, draw.Over)
+		images[i] = canvas
+
+		delays[i] = lastFrameDelay
+		if i+1 < len(frames) {
+			delays[i] = max(frames[i+1].Timestamp.Sub(frame.Timestamp), minFrameDelay)
+		}
+	}
+
+	return images, delays, nil
+}
+
+// decode reads an image file.
+func decode(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open frame: %w", err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode frame %s: %w", path, err)
+	}
+
+	return img, nil
+}
+
+// splitPNG returns the IHDR data and the concatenated IDAT data of a PNG.
+func splitPNG(data []byte) ([]byte, []byte, error) {
+	var ihdr, idat []byte
+
+	for rest := data[8:]; len(rest) >= 12; {
+		length := binary.BigEndian.Uint32(rest)
+		if uint64(len(rest)) < 12+uint64(length) {
+			return nil, nil, errTruncatedPNG
+		}
+
+		body := rest[8 : 8+length]
+
+		switch string(rest[4:8]) {
+		case "IHDR":
+			ihdr = body
+		case "IDAT":
+			idat = append(idat, body...)
+		}
+
+		rest = rest[12+length:]
+	}
+
+	return ihdr, idat, nil
+}
+
+// chunkWriter writes PNG chunks and keeps the first error.
+type chunkWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (c *chunkWriter) write(data []byte) {
+	if c.err == nil {
+		_, c.err = c.w.Write(data)
+	}
+}
+
+// chunk writes a chunk with its length and CRC.
+func (c *chunkWriter) chunk(chunkType string, data []byte) {
+	typed := append([]byte(chunkType), data...)
+
+	c.write(be32(uint32(len(data))))
+	c.write(typed)
+	c.write(be32(crc32.ChecksumIEEE(typed)))
+}
+
+func be32(values ...uint32) []byte {
+	b := make([]byte, 0, 4*len(values))
+	for _, v := range values {
+		b = binary.BigEndian.AppendUint32(b, v)
+	}
+
+	return b
+}
+
+func be16(values ...uint16) []byte {
+	b := make([]byte, 0, 2*len(values))
+	for _, v := range values {
+		b = binary.BigEndian.AppendUint16(b, v)
+	}
+
+	return b
+}
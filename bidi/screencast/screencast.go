@@ -0,0 +1,325 @@
+// Package screencast records a page with the CDP Page.startScreencast command.
+//
+// Frames are written to a directory as they arrive and can be assembled into
+// an animated GIF or APNG.
+package screencast
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp"
+	"github.com/Kcrong/selenium/bidi/cdp/page"
+)
+
+// Format is the image format of the frames.
+type Format string
+
+const (
+	// JPEG frames are smaller and honor Options.Quality.
+	JPEG Format = "jpeg"
+	// PNG frames are lossless.
+	PNG Format = "png"
+)
+
+// DefaultQuality is the JPEG quality used when Options.Quality is zero.
+const DefaultQuality = 80
+
+// ackTimeout bounds the acknowledgement of a frame.
+const ackTimeout = 5 * time.Second
+
+// ErrStopped is returned when a stopped recorder is stopped again.
+var ErrStopped = errors.New("screencast already stopped")
+
+// Session is a CDP session attached to a page, such as a *bidi.CDPSessionImpl.
+type Session interface {
+	bidi.Transport
+	cdp.EventSource
+}
+
+// Options configures a recording.
+type Options struct {
+	// Format is the frame format; JPEG by default.
+	Format Format
+	// Quality is the JPEG quality from 0 to 100.
+	Quality int
+	// MaxWidth and MaxHeight scale frames down to fit.
+	MaxWidth  int
+	MaxHeight int
+	// EveryNthFrame skips frames; every frame is sent by default.
+	EveryNthFrame int
+	// CloseSession closes the session when the recorder stops.
+	CloseSession bool
+}
+
+// Frame is a frame written to disk.
+type Frame struct {
+	// Timestamp is the time the browser rendered the frame.
+	Timestamp time.Time
+	Path      string
+}
+
+// Recorder writes the frames of a screencast to a directory.
+//
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	session     Session
+	err         error
+	dir         string
+	ext         string
+	frames      []Frame
+	opts        Options
+	mu          sync.Mutex
+	stopped     bool
+	unsubscribe func()
+}
+
+// Start creates dir and starts recording the page of the session into it.
+func Start(ctx context.Context, session Session, dir string, opts Options) (*Recorder, error) {
+	if opts.Format == "" {
+		opts.Format = JPEG
+	}
+
+	if opts.Quality == 0 {
+		opts.Quality = DefaultQuality
+	}
+
+	ext := "jpg"
+	if opts.Format == PNG {
+		ext = "png"
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create screencast directory: %w", err)
+	}
+
+	r := &Recorder{
+		session:     session,
+		err:         nil,
+		dir:         dir,
+		ext:         ext,
+		frames:      nil,
+		opts:        opts,
+		mu:          sync.Mutex{},
+		stopped:     false,
+		unsubscribe: nil,
+	}
+
+	r.unsubscribe = page.OnScreencastFrame(session, r.handleFrame)
+
+	params := page.StartScreencastParams{
+		Format:        string(opts.Format),
+		Quality:       nil,
+		MaxWidth:      positive(opts.MaxWidth),
+		MaxHeight:     positive(opts.MaxHeight),
+		EveryNthFrame: positive(opts.EveryNthFrame),
+	}
+	if opts.Format == JPEG {
+		params.Quality = cdp.Ptr(int64(opts.Quality))
+	}
+
+	if err := page.StartScreencast(ctx, session, params); err != nil {
+		r.stop()
+
+		return nil, fmt.Errorf("failed to start screencast: %w", err)
+	}
+
+	return r, nil
+}
+
+// positive returns n as an optional CDP number, leaving it unset when it is not positive.
+func positive(n int) *int64 {
+	if n <= 0 {
+		return nil
+	}
+
+	return cdp.Ptr(int64(n))
+}
+
+// Dir returns the directory the frames are written to.
+func (r *Recorder) Dir() string {
+	return r.dir
+}
+
+// handleFrame writes a frame and acknowledges it so the browser sends the next one.
+func (r *Recorder) handleFrame(event *page.ScreencastFrameEvent) {
+	if !r.write(event) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ackTimeout)
+	defer cancel()
+
+	_ = page.ScreencastFrameAck(ctx, r.session, page.ScreencastFrameAckParams{SessionID: event.SessionID})
+}
+
+// write stores a frame, reporting whether the recorder is still running.
+func (r *Recorder) write(event *page.ScreencastFrameEvent) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped {
+		return false
+	}
+
+	timestamp := time.Now()
+	if ts := event.Metadata.Timestamp; ts != nil && *ts > 0 {
+		timestamp = time.UnixMicro(int64(*ts * 1e6))
+	}
+
+	data, err := base64.StdEncoding.DecodeString(event.Data)
+	if err != nil {
+		r.fail(fmt.Errorf("failed to decode frame: %w", err))
+
+		return true
+	}
+
+	name := fmt.Sprintf("frame-%05d-%d.%s", len(r.frames)+1, timestamp.UnixMilli(), r.ext)
+	path := filepath.Join(r.dir, name)
+
+	if err := os.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // Frames are not secret.
+		r.fail(fmt.Errorf("failed to write frame: %w", err))
+
+		return true
+	}
+
+	r.frames = append(r.frames, Frame{Timestamp: timestamp, Path: path})
+
+	return true
+}
+
+// fail records the first error.
+func (r *Recorder) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// stop marks the recorder stopped and removes its frame handler, reporting whether it was running.
+func (r *Recorder) stop() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped {
+		return false
+	}
+
+	r.stopped = true
+	r.unsubscribe()
+
+	return true
+}
+
+// Stop stops the screencast and returns the first error writing frames.
+func (r *Recorder) Stop(ctx context.Context) error {
+	if !r.stop() {
+		return ErrStopped
+	}
+
+	err := page.StopScreencast(ctx, r.session)
+	if err != nil {
+		err = fmt.Errorf("failed to stop screencast: %w", err)
+	}
+
+	if closer, ok := r.session.(interface{ Close() error }); ok && r.opts.CloseSession {
+		err = errors.Join(err, closer.Close())
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return errors.Join(r.err, err)
+}
+
+// Frames returns the frames written so far.
+func (r *Recorder) Frames() []Frame {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Frame(nil), r.frames...)
+}
+
+// Discard deletes the frames, and the directory if it is then empty.
+func (r *Recorder) Discard() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs []error
+
+	for _, frame := range r.frames {
+		if err := os.Remove(frame.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+
+	r.frames = nil
+	_ = os.Remove(r.dir)
+
+	return errors.Join(errs...)
+}
+
+// SaveAnimation assembles the frames into an animation at path.
+//
+// The animation is an APNG when the extension of path is .png or .apng, and a
+// GIF otherwise.
+func (r *Recorder) SaveAnimation(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create animation: %w", err)
+	}
+
+	switch filepath.Ext(path) {
+	case ".png", ".apng":
+		err = EncodeAPNG(f, r.Frames())
+	default:
+		err = EncodeGIF(f, r.Frames())
+	}
+
+	return errors.Join(err, f.Close())
+}
+
+// TB is the part of testing.TB used by KeepOnFailure.
+type TB interface {
+	Cleanup(func())
+	Failed() bool
+	Logf(format string, args ...interface{})
+}
+
+// KeepOnFailure stops the recorder when the test ends.
+//
+// The frames of a failed test are kept and assembled into screencast.gif in
+// the frame directory; the frames of a passing test are deleted.
+func (r *Recorder) KeepOnFailure(t TB) {
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), ackTimeout)
+		defer cancel()
+
+		if err := r.Stop(ctx); err != nil && !errors.Is(err, ErrStopped) {
+			t.Logf("screencast: %v", err)
+		}
+
+		if !t.Failed() {
+			if err := r.Discard(); err != nil {
+				t.Logf("screencast: %v", err)
+			}
+
+			return
+		}
+
+		path := filepath.Join(r.dir, "screencast.gif")
+		if err := r.SaveAnimation(path); err != nil {
+			t.Logf("screencast: frames in %s: %v", r.dir, err)
+
+			return
+		}
+
+		t.Logf("screencast: %s", path)
+	})
+}
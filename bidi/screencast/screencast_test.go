@@ -0,0 +1,198 @@
+package screencast_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp/page"
+	"github.com/Kcrong/selenium/bidi/screencast"
+)
+
+// session records commands and delivers events like a CDP page session.
+type session struct {
+	handlers map[string][]func(json.RawMessage)
+	commands []string
+	params   map[string]json.RawMessage
+	mu       sync.Mutex
+	closed   bool
+}
+
+func newSession() *session {
+	return &session{
+		handlers: make(map[string][]func(json.RawMessage)),
+		params:   make(map[string]json.RawMessage),
+	}
+}
+
+func (s *session) Execute(_ context.Context, method string, params interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.commands = append(s.commands, method)
+	s.params[method] = data
+
+	return nil, nil
+}
+
+func (s *session) Protocol() bidi.Protocol {
+	return bidi.ProtocolCDP
+}
+
+func (s *session) Subscribe(method string, handler func(json.RawMessage)) func() {
+	s.handlers[method] = append(s.handlers[method], handler)
+
+	return func() {}
+}
+
+func (s *session) Close() error {
+	s.closed = true
+
+	return nil
+}
+
+// frame sends a solid PNG frame rendered at timestamp seconds.
+func (s *session) frame(t *testing.T, id int, timestamp float64, c color.Color) {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	for x := range 4 {
+		for y := range 3 {
+			img.Set(x, y, c)
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	params := fmt.Sprintf(`{"data": %q, "sessionId": %d, "metadata": {"timestamp": %f}}`,
+		base64.StdEncoding.EncodeToString(buf.Bytes()), id, timestamp)
+	for _, handler := range s.handlers[page.EventScreencastFrame] {
+		handler(json.RawMessage(params))
+	}
+}
+
+func record(t *testing.T, s *session, dir string) *screencast.Recorder {
+	t.Helper()
+
+	recorder, err := screencast.Start(context.Background(), s, dir, screencast.Options{
+		Format: screencast.PNG, MaxWidth: 800, CloseSession: true,
+	})
+	require.NoError(t, err)
+
+	s.frame(t, 1, 1700000000.0, color.RGBA{R: 255, A: 255})
+	s.frame(t, 2, 1700000000.5, color.RGBA{G: 255, A: 255})
+
+	return recorder
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	s := newSession()
+	dir := filepath.Join(t.TempDir(), "frames")
+	recorder := record(t, s, dir)
+
+	require.NoError(t, recorder.Stop(context.Background()))
+	require.ErrorIs(t, recorder.Stop(context.Background()), screencast.ErrStopped)
+	assert.True(t, s.closed)
+
+	s.frame(t, 3, 1700000001.0, color.White)
+
+	assert.Equal(t, []string{
+		"Page.startScreencast", "Page.screencastFrameAck", "Page.screencastFrameAck", "Page.stopScreencast",
+	}, s.commands)
+	assert.JSONEq(t, `{"format": "png", "maxWidth": 800}`, string(s.params["Page.startScreencast"]))
+	assert.JSONEq(t, `{"sessionId": 2}`, string(s.params["Page.screencastFrameAck"]))
+
+	frames := recorder.Frames()
+	require.Len(t, frames, 2)
+	assert.Equal(t, filepath.Join(dir, "frame-00001-1700000000000.png"), frames[0].Path)
+	assert.FileExists(t, frames[1].Path)
+
+	gifPath := filepath.Join(dir, "screencast.gif")
+	require.NoError(t, recorder.SaveAnimation(gifPath))
+
+	f, err := os.Open(gifPath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	anim, err := gif.DecodeAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, []int{50, 100}, anim.Delay)
+
+	apngPath := filepath.Join(dir, "screencast.png")
+	require.NoError(t, recorder.SaveAnimation(apngPath))
+
+	data, err := os.ReadFile(apngPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1, bytes.Count(data, []byte("acTL")))
+	assert.Equal(t, 2, bytes.Count(data, []byte("fcTL")))
+	assert.Equal(t, 1, bytes.Count(data, []byte("fdAT")))
+
+	// Viewers without APNG support show the first frame
+	first, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	r, g, b, _ := first.At(0, 0).RGBA()
+	assert.Equal(t, [3]uint32{0xffff, 0, 0}, [3]uint32{r, g, b})
+}
+
+// fakeTB runs cleanups on demand.
+type fakeTB struct {
+	cleanups []func()
+	logs     []string
+	failed   bool
+}
+
+func (f *fakeTB) Cleanup(cleanup func()) { f.cleanups = append(f.cleanups, cleanup) }
+
+func (f *fakeTB) Failed() bool { return f.failed }
+
+func (f *fakeTB) Logf(format string, args ...interface{}) {
+	f.logs = append(f.logs, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) finish() {
+	for _, cleanup := range f.cleanups {
+		cleanup()
+	}
+}
+
+func TestKeepOnFailure(t *testing.T) {
+	t.Parallel()
+
+	passed := &fakeTB{}
+	dir := filepath.Join(t.TempDir(), "passed")
+	record(t, newSession(), dir).KeepOnFailure(passed)
+	passed.finish()
+	assert.NoDirExists(t, dir)
+
+	failed := &fakeTB{failed: true}
+	dir = filepath.Join(t.TempDir(), "failed")
+	recorder := record(t, newSession(), dir)
+	recorder.KeepOnFailure(failed)
+	failed.finish()
+
+	assert.FileExists(t, recorder.Frames()[0].Path)
+	assert.FileExists(t, filepath.Join(dir, "screencast.gif"))
+	assert.Equal(t, []string{"screencast: " + filepath.Join(dir, "screencast.gif")}, failed.logs)
+}
@@ -3,65 +3,437 @@ package bidi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
+// EventMessage is sent when a script calls a channel callback.
+const EventMessage = "script.message"
+
+// ErrEventsUnsupported is returned when subscribing to events over a transport without a Session.
+var ErrEventsUnsupported = errors.New("transport does not deliver events")
+
+// ErrNoTarget is returned when a script has neither a browsing context nor a realm to run in.
+var ErrNoTarget = errors.New("script target has no browsing context or realm")
+
+// ResultOwnership controls whether the browser keeps a handle to returned objects.
+type ResultOwnership string
+
+const (
+	// OwnershipNone returns values without handles.
+	OwnershipNone ResultOwnership = "none"
+	// OwnershipRoot keeps returned objects alive until they are disowned.
+	OwnershipRoot ResultOwnership = "root"
+)
+
+// Target is where a script runs: a realm, or a browsing context with an optional sandbox.
+type Target struct {
+	Context string `json:"context,omitempty"`
+	Sandbox string `json:"sandbox,omitempty"`
+	Realm   string `json:"realm,omitempty"`
+}
+
+// ContextTarget targets a browsing context; a non-empty sandbox isolates the script from page scripts.
+func ContextTarget(contextID, sandbox string) Target {
+	return Target{Context: contextID, Sandbox: sandbox, Realm: ""}
+}
+
+// RealmTarget targets a realm returned by GetRealms.
+func RealmTarget(realm string) Target {
+	return Target{Context: "", Sandbox: "", Realm: realm}
+}
+
+// empty reports whether the target names neither a browsing context nor a realm.
+func (t Target) empty() bool {
+	return t.Context == "" && t.Realm == ""
+}
+
+// MarshalJSON encodes the target without the unused members.
+func (t Target) MarshalJSON() ([]byte, error) {
+	if t.Realm != "" {
+		return json.Marshal(map[string]string{"realm": t.Realm})
+	}
+
+	target := map[string]string{"context": t.Context}
+	if t.Sandbox != "" {
+		target["sandbox"] = t.Sandbox
+	}
+
+	return json.Marshal(target)
+}
+
+// EvaluateOptions configures Evaluate and Call.
+type EvaluateOptions struct {
+	Target          Target          `json:"target"`
+	ResultOwnership ResultOwnership `json:"resultOwnership,omitempty"`
+	// AwaitPromise waits for a returned promise and returns its result.
+	AwaitPromise bool `json:"awaitPromise"`
+	// UserActivation runs the script as if the user interacted with the page.
+	UserActivation bool `json:"userActivation,omitempty"`
+}
+
+// ExceptionDetails describes an exception thrown by a script.
+type ExceptionDetails struct {
+	StackTrace   StackTrace  `json:"stackTrace"`
+	Exception    RemoteValue `json:"exception"`
+	Text         string      `json:"text"`
+	LineNumber   int         `json:"lineNumber"`
+	ColumnNumber int         `json:"columnNumber"`
+}
+
+// StackFrame is a frame of a JavaScript stack trace.
+type StackFrame struct {
+	FunctionName string `json:"functionName"`
+	URL          string `json:"url"`
+	LineNumber   int    `json:"lineNumber"`
+	ColumnNumber int    `json:"columnNumber"`
+}
+
+// StackTrace is a JavaScript stack trace.
+type StackTrace struct {
+	CallFrames []StackFrame `json:"callFrames"`
+}
+
+// ScriptException is returned by ScriptResult.Value when the script threw.
+type ScriptException struct {
+	Details ExceptionDetails
+}
+
+func (e *ScriptException) Error() string {
+	return fmt.Sprintf("script exception at %d:%d: %s", e.Details.LineNumber, e.Details.ColumnNumber, e.Details.Text)
+}
+
 // ScriptResult represents the result of a script execution.
+//
+// Type is "success", with the returned value in Result, or "exception",
+// with the thrown error in ExceptionDetails.
 type ScriptResult struct {
-	Type  string          `json:"type"`
-	Value json.RawMessage `json:"value"`
+	Result           *RemoteValue      `json:"result,omitempty"`
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+	Type             string            `json:"type"`
+	Realm            string            `json:"realm"`
 }
 
-// Script provides methods for script execution.
+// Value decodes the returned value, or returns a *ScriptException if the script threw.
+func (r *ScriptResult) Value() (interface{}, error) {
+	if r.ExceptionDetails != nil {
+		return nil, &ScriptException{Details: *r.ExceptionDetails}
+	}
+
+	if r.Result == nil {
+		return Undefined, nil
+	}
+
+	return r.Result.Decode()
+}
+
+// RealmInfo describes a realm.
+type RealmInfo struct {
+	Realm   string `json:"realm"`
+	Origin  string `json:"origin"`
+	Type    string `json:"type"`
+	Context string `json:"context,omitempty"`
+	Sandbox string `json:"sandbox,omitempty"`
+}
+
+// PreloadScriptOptions configures AddPreloadScript.
+type PreloadScriptOptions struct {
+	// Channels are passed to the function as arguments.
+	Channels []Channel
+	// Contexts restricts the script to top-level browsing contexts.
+	Contexts []string
+	// Sandbox runs the script in a sandbox.
+	Sandbox string
+}
+
+// Message is a value sent through a channel.
+type Message struct {
+	Data    RemoteValue `json:"data"`
+	Channel string      `json:"channel"`
+	Source  struct {
+		Realm   string `json:"realm"`
+		Context string `json:"context,omitempty"`
+	} `json:"source"`
+}
+
+// Script provides methods for script execution over WebDriver BiDi.
 type Script struct {
-	session CDPSession
+	transport Transport
+	context   string
 }
 
-// NewScript creates a new Script instance.
-func NewScript(session CDPSession) *Script {
+// NewScript creates a new Script instance on a BiDi transport.
+//
+// Until InContext selects a browsing context, EvaluateScript and CallFunction
+// run in the first top-level browsing context of the browser.
+func NewScript(transport Transport) *Script {
 	return &Script{
-		session: session,
+		transport: transport,
+		context:   "",
 	}
 }
 
-// EvaluateScript evaluates a JavaScript script in the current browsing context.
-func (s *Script) EvaluateScript(ctx context.Context, script string, args []interface{}) (*ScriptResult, error) {
-	params := map[string]interface{}{
-		"expression": script,
-		"arguments":  args,
+// InContext returns a Script whose EvaluateScript and CallFunction run in the given browsing context.
+func (s *Script) InContext(contextID string) *Script {
+	return &Script{
+		transport: s.transport,
+		context:   contextID,
 	}
+}
 
-	result, err := s.session.Execute(ctx, "script.evaluate", params)
+// EvaluateScript evaluates a JavaScript expression in the browsing context.
+//
+// When args are given the script is run as a function body, so it can read
+// them from `arguments` like WebDriver classic executeScript. The arguments
+// are converted with ToLocalValue.
+func (s *Script) EvaluateScript(ctx context.Context, script string, args []interface{}) (*ScriptResult, error) {
+	opts, err := s.defaultOptions(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate script: %w", err)
+		return nil, err
 	}
 
-	var scriptResult ScriptResult
-	if err := json.Unmarshal(result, &scriptResult); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal script result: %w", err)
+	if len(args) > 0 {
+		arguments, err := listValue(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.call(ctx, "function() {\n"+script+"\n}", arguments, nil, opts)
 	}
 
-	return &scriptResult, nil
+	return s.Evaluate(ctx, script, opts)
 }
 
-// CallFunction calls a JavaScript function in the current browsing context.
+// CallFunction calls a JavaScript function in the browsing context.
+//
+// The arguments must already be BiDi local values and are sent as is; use
+// Call to convert Go values with ToLocalValue.
 func (s *Script) CallFunction(
 	ctx context.Context, functionDeclaration string, args []interface{},
 ) (*ScriptResult, error) {
+	opts, err := s.defaultOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.call(ctx, functionDeclaration, args, nil, opts)
+}
+
+// defaultOptions targets the browsing context of the Script and awaits promises.
+func (s *Script) defaultOptions(ctx context.Context) (EvaluateOptions, error) {
+	contextID := s.context
+	if contextID == "" {
+		var err error
+		if contextID, err = s.topLevelContext(ctx); err != nil {
+			return EvaluateOptions{}, err
+		}
+	}
+
+	//nolint:exhaustruct // Defaults apply to the remaining options.
+	return EvaluateOptions{Target: ContextTarget(contextID, ""), AwaitPromise: true}, nil
+}
+
+// topLevelContext returns the first top-level browsing context.
+func (s *Script) topLevelContext(ctx context.Context) (string, error) {
+	type tree struct {
+		Contexts []struct {
+			Context string `json:"context"`
+		} `json:"contexts"`
+	}
+
+	result, err := Run(ctx, s.transport, NewBiDiCommand[tree]("browsingContext.getTree", map[string]int{"maxDepth": 0}))
+	if err != nil {
+		return "", fmt.Errorf("failed to get the top-level browsing context: %w", err)
+	}
+
+	if len(result.Contexts) == 0 {
+		return "", ErrNoTarget
+	}
+
+	return result.Contexts[0].Context, nil
+}
+
+// Evaluate evaluates an expression.
+//
+// The target must name a browsing context or a realm, otherwise ErrNoTarget is returned.
+func (s *Script) Evaluate(ctx context.Context, expression string, opts EvaluateOptions) (*ScriptResult, error) {
+	if opts.Target.empty() {
+		return nil, ErrNoTarget
+	}
+
+	params := struct {
+		EvaluateOptions
+		Expression string `json:"expression"`
+	}{
+		EvaluateOptions: opts,
+		Expression:      expression,
+	}
+
+	result, err := Run(ctx, s.transport, NewBiDiCommand[ScriptResult]("script.evaluate", params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate script: %w", err)
+	}
+
+	return result, nil
+}
+
+// Call calls a function with arguments converted by ToLocalValue; this is the
+// value of `this`, nil for undefined. The target must name a browsing context
+// or a realm, otherwise ErrNoTarget is returned.
+func (s *Script) Call(
+	ctx context.Context, functionDeclaration string, args []interface{}, this interface{}, opts EvaluateOptions,
+) (*ScriptResult, error) {
+	arguments, err := listValue(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var local interface{}
+	if this != nil {
+		if local, err = ToLocalValue(this); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.call(ctx, functionDeclaration, arguments, local, opts)
+}
+
+// call sends script.callFunction with arguments and this already encoded as local values.
+func (s *Script) call(
+	ctx context.Context, functionDeclaration string, arguments []interface{}, this interface{}, opts EvaluateOptions,
+) (*ScriptResult, error) {
+	if opts.Target.empty() {
+		return nil, ErrNoTarget
+	}
+
 	params := map[string]interface{}{
 		"functionDeclaration": functionDeclaration,
-		"arguments":           args,
+		"arguments":           arguments,
+		"target":              opts.Target,
+		"awaitPromise":        opts.AwaitPromise,
+	}
+
+	if opts.ResultOwnership != "" {
+		params["resultOwnership"] = opts.ResultOwnership
 	}
 
-	result, err := s.session.Execute(ctx, "script.callFunction", params)
+	if opts.UserActivation {
+		params["userActivation"] = true
+	}
+
+	if this != nil {
+		params["this"] = this
+	}
+
+	result, err := Run(ctx, s.transport, NewBiDiCommand[ScriptResult]("script.callFunction", params))
 	if err != nil {
 		return nil, fmt.Errorf("failed to call function: %w", err)
 	}
 
-	var scriptResult ScriptResult
-	if err := json.Unmarshal(result, &scriptResult); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal function result: %w", err)
+	return result, nil
+}
+
+// Disown releases handles so the objects they refer to can be garbage collected.
+func (s *Script) Disown(ctx context.Context, handles []string, target Target) error {
+	_, err := Run(ctx, s.transport, NewBiDiCommand[struct{}]("script.disown", map[string]interface{}{
+		"handles": handles,
+		"target":  target,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to disown handles: %w", err)
+	}
+
+	return nil
+}
+
+// GetRealms returns the realms of a browsing context, or of every context when
+// contextID is empty; a non-empty realmType such as "window" filters them.
+func (s *Script) GetRealms(ctx context.Context, contextID, realmType string) ([]RealmInfo, error) {
+	params := map[string]interface{}{}
+	if contextID != "" {
+		params["context"] = contextID
+	}
+
+	if realmType != "" {
+		params["type"] = realmType
+	}
+
+	result, err := Run(ctx, s.transport, NewBiDiCommand[struct {
+		Realms []RealmInfo `json:"realms"`
+	}]("script.getRealms", params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get realms: %w", err)
+	}
+
+	return result.Realms, nil
+}
+
+// AddPreloadScript runs a function in every new document before page scripts and returns its ID.
+func (s *Script) AddPreloadScript(
+	ctx context.Context, functionDeclaration string, opts PreloadScriptOptions,
+) (string, error) {
+	params := map[string]interface{}{"functionDeclaration": functionDeclaration}
+
+	if len(opts.Channels) > 0 {
+		channels, err := listValue(toInterfaces(opts.Channels))
+		if err != nil {
+			return "", err
+		}
+
+		params["arguments"] = channels
+	}
+
+	if len(opts.Contexts) > 0 {
+		params["contexts"] = opts.Contexts
+	}
+
+	if opts.Sandbox != "" {
+		params["sandbox"] = opts.Sandbox
+	}
+
+	result, err := Run(ctx, s.transport, NewBiDiCommand[struct {
+		Script string `json:"script"`
+	}]("script.addPreloadScript", params))
+	if err != nil {
+		return "", fmt.Errorf("failed to add preload script: %w", err)
+	}
+
+	return result.Script, nil
+}
+
+// RemovePreloadScript removes a preload script.
+func (s *Script) RemovePreloadScript(ctx context.Context, script string) error {
+	_, err := Run(ctx, s.transport, NewBiDiCommand[struct{}]("script.removePreloadScript",
+		map[string]interface{}{"script": script}))
+	if err != nil {
+		return fmt.Errorf("failed to remove preload script: %w", err)
+	}
+
+	return nil
+}
+
+// OnMessage calls handler for every value sent through the channel.
+//
+// The Script must have been created by a Session.
+func (s *Script) OnMessage(ctx context.Context, channel string, handler func(Message)) error {
+	session, ok := s.transport.(*Session)
+	if !ok {
+		return ErrEventsUnsupported
+	}
+
+	return On(ctx, session, EventMessage, func(message Message) {
+		if message.Channel == channel {
+			handler(message)
+		}
+	})
+}
+
+// toInterfaces converts a typed slice for listValue.
+func toInterfaces[T any](items []T) []interface{} {
+	result := make([]interface{}, len(items))
+	for i, item := range items {
+		result[i] = item
 	}
 
-	return &scriptResult, nil
+	return result
 }
@@ -0,0 +1,285 @@
+package bidi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/biditest"
+)
+
+func TestToLocalValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"null", nil, `{"type": "null"}`},
+		{"undefined", bidi.Undefined, `{"type": "undefined"}`},
+		{"int", 42, `{"type": "number", "value": 42}`},
+		{"NaN", math.NaN(), `{"type": "number", "value": "NaN"}`},
+		{"Infinity", math.Inf(-1), `{"type": "number", "value": "-Infinity"}`},
+		{"negative zero", math.Copysign(0, -1), `{"type": "number", "value": "-0"}`},
+		{"bigint", big.NewInt(9007199254740993), `{"type": "bigint", "value": "9007199254740993"}`},
+		{
+			"date", time.Date(2024, 1, 2, 3, 4, 5, 6e6, time.UTC),
+			`{"type": "date", "value": "2024-01-02T03:04:05.006Z"}`,
+		},
+		{"regexp", bidi.RegExp{Pattern: "a+", Flags: "g"}, `{"type": "regexp", "value": {"pattern": "a+", "flags": "g"}}`},
+		{
+			"object", map[string]interface{}{"b": true, "a": "x"},
+			`{"type": "object", "value": [["a", {"type": "string", "value": "x"}], ["b", {"type": "boolean", "value": true}]]}`,
+		},
+		{
+			"map", bidi.Map{{Key: 1, Value: "one"}},
+			`{"type": "map", "value": [[{"type": "number", "value": 1}, {"type": "string", "value": "one"}]]}`,
+		},
+		{"set", bidi.Set{"a"}, `{"type": "set", "value": [{"type": "string", "value": "a"}]}`},
+		{"array", []int{1}, `{"type": "array", "value": [{"type": "number", "value": 1}]}`},
+		{"node", bidi.NodeRemoteValue{Type: "node", SharedID: "S1"}, `{"sharedId": "S1"}`},
+		{"channel", bidi.Channel{ID: "events"}, `{"type": "channel", "value": {"channel": "events"}}`},
+		{
+			"remote primitive", bidi.RemoteValue{Type: "string", Value: json.RawMessage(`"x"`)},
+			`{"type": "string", "value": "x"}`,
+		},
+		{"remote handle", bidi.RemoteValue{Type: "function", Handle: "H1"}, `{"handle": "H1"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := bidi.ToLocalValue(tt.value)
+			require.NoError(t, err)
+
+			data, err := json.Marshal(value)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(data))
+		})
+	}
+
+	_, err := bidi.ToLocalValue(map[int]string{1: "one"})
+	require.ErrorIs(t, err, bidi.ErrUnsupportedValue)
+
+	// Without a reference only primitives can be sent back
+	_, err = bidi.ToLocalValue(bidi.RemoteValue{Type: "window", Value: json.RawMessage(`{"context": "C1"}`)})
+	require.ErrorIs(t, err, bidi.ErrUnsupportedValue)
+
+	_, err = bidi.ToLocalValue(bidi.RemoteValue{
+		Type: "array", Value: json.RawMessage(`[{"type": "node", "sharedId": "S1"}]`),
+	})
+	require.ErrorIs(t, err, bidi.ErrUnsupportedValue)
+}
+
+func TestRemoteValueDecode(t *testing.T) {
+	t.Parallel()
+
+	decode := func(t *testing.T, data string) interface{} {
+		t.Helper()
+
+		var value bidi.RemoteValue
+		require.NoError(t, json.Unmarshal([]byte(data), &value))
+
+		decoded, err := value.Decode()
+		require.NoError(t, err)
+
+		return decoded
+	}
+
+	assert.Equal(t, bidi.Undefined, decode(t, `{"type": "undefined"}`))
+	assert.Equal(t, "x", decode(t, `{"type": "string", "value": "x"}`))
+	assert.Equal(t, true, decode(t, `{"type": "boolean", "value": true}`))
+	assert.InDelta(t, 1.5, decode(t, `{"type": "number", "value": 1.5}`), 0)
+	assert.Nil(t, decode(t, `{"type": "null"}`))
+	assert.True(t, math.IsInf(decode(t, `{"type": "number", "value": "Infinity"}`).(float64), 1))
+	assert.True(t, math.Signbit(decode(t, `{"type": "number", "value": "-0"}`).(float64)))
+	assert.Equal(t, big.NewInt(12), decode(t, `{"type": "bigint", "value": "12"}`))
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		decode(t, `{"type": "date", "value": "2024-01-02T03:04:05.000Z"}`))
+	assert.Equal(t, bidi.Set{"a"}, decode(t, `{"type": "set", "value": [{"type": "string", "value": "a"}]}`))
+	assert.Equal(t,
+		bidi.Map{{Key: 1.0, Value: []interface{}{true}}},
+		decode(t, `{"type": "map", "value": [[{"type": "number", "value": 1},
+			{"type": "array", "value": [{"type": "boolean", "value": true}]}]]}`))
+	assert.Equal(t,
+		map[string]interface{}{"a": math.Inf(-1)},
+		decode(t, `{"type": "object", "value": [["a", {"type": "number", "value": "-Infinity"}]]}`))
+
+	node, ok := decode(t, `{"type": "node", "sharedId": "S1", "value": {"nodeType": 1, "localName": "div"}}`).(bidi.NodeRemoteValue)
+	require.True(t, ok)
+	assert.Equal(t, bidi.SharedReference{SharedID: "S1"}, node.Reference())
+
+	function, ok := decode(t, `{"type": "function", "handle": "H1"}`).(bidi.RemoteValue)
+	require.True(t, ok)
+	assert.Equal(t, "H1", function.Handle)
+}
+
+func TestScript(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	script := bidi.NewScript(session)
+
+	browser.Handle("script.callFunction", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{
+			"type": "success", "realm": "R1",
+			"result": map[string]interface{}{"type": "number", "value": "NaN"},
+		}, nil
+	})
+	browser.Handle("script.evaluate", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{
+			"type": "exception", "realm": "R1",
+			"exceptionDetails": map[string]interface{}{
+				"text": "Error: boom", "lineNumber": 1, "columnNumber": 6,
+				"exception":  map[string]string{"type": "error", "handle": "H1"},
+				"stackTrace": map[string]interface{}{"callFrames": []interface{}{}},
+			},
+		}, nil
+	})
+	browser.Handle("script.addPreloadScript", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"script": "P1"}, nil
+	})
+	browser.Handle("browsingContext.getTree", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"contexts": []map[string]interface{}{{"context": "C0", "children": nil}}}, nil
+	})
+	browser.Handle("script.getRealms", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"realms": []map[string]string{
+			{"realm": "R1", "origin": "https://example.com", "type": "window", "context": "C1"},
+		}}, nil
+	})
+
+	result, err := script.Call(ctx, "(a) => a / 0", []interface{}{0}, nil, bidi.EvaluateOptions{
+		Target:          bidi.ContextTarget("C1", "isolated"),
+		ResultOwnership: bidi.OwnershipRoot,
+		AwaitPromise:    false,
+		UserActivation:  true,
+	})
+	require.NoError(t, err)
+
+	value, err := result.Value()
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(value.(float64)))
+	assert.JSONEq(t, `{
+		"functionDeclaration": "(a) => a / 0",
+		"arguments": [{"type": "number", "value": 0}],
+		"target": {"context": "C1", "sandbox": "isolated"},
+		"awaitPromise": false,
+		"resultOwnership": "root",
+		"userActivation": true
+	}`, string(browser.Commands("script.callFunction")[0]))
+
+	// CallFunction sends local values as is, in the top-level browsing context
+	_, err = session.Script().CallFunction(ctx, "(a) => a", []interface{}{map[string]string{"type": "undefined"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"functionDeclaration": "(a) => a",
+		"arguments": [{"type": "undefined"}],
+		"target": {"context": "C0"},
+		"awaitPromise": true
+	}`, string(browser.Commands("script.callFunction")[1]))
+	assert.JSONEq(t, `{"maxDepth": 0}`, string(browser.Commands("browsingContext.getTree")[0]))
+
+	// EvaluateScript converts its arguments to local values
+	_, err = script.InContext("C2").EvaluateScript(ctx, "return arguments[0]", []interface{}{1, "x", nil})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"functionDeclaration": "function() {\nreturn arguments[0]\n}",
+		"arguments": [{"type": "number", "value": 1}, {"type": "string", "value": "x"}, {"type": "null"}],
+		"target": {"context": "C2"},
+		"awaitPromise": true
+	}`, string(browser.Commands("script.callFunction")[2]))
+
+	result, err = script.InContext("C2").EvaluateScript(ctx, "throw new Error('boom')", nil)
+	require.NoError(t, err)
+
+	_, err = result.Value()
+
+	var exception *bidi.ScriptException
+	require.True(t, errors.As(err, &exception))
+	assert.Equal(t, "H1", exception.Details.Exception.Handle)
+	assert.JSONEq(t, `{"expression": "throw new Error('boom')", "target": {"context": "C2"}, "awaitPromise": true}`,
+		string(browser.Commands("script.evaluate")[0]))
+
+	id, err := script.AddPreloadScript(ctx, "(send) => send(1)", bidi.PreloadScriptOptions{
+		Channels: []bidi.Channel{{ID: "ready"}},
+		Contexts: []string{"C1"},
+		Sandbox:  "",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "P1", id)
+	assert.JSONEq(t, `{
+		"functionDeclaration": "(send) => send(1)",
+		"arguments": [{"type": "channel", "value": {"channel": "ready"}}],
+		"contexts": ["C1"]
+	}`, string(browser.Commands("script.addPreloadScript")[0]))
+
+	require.NoError(t, script.RemovePreloadScript(ctx, id))
+	require.NoError(t, script.Disown(ctx, []string{"H1"}, bidi.RealmTarget("R1")))
+	assert.JSONEq(t, `{"handles": ["H1"], "target": {"realm": "R1"}}`, string(browser.Commands("script.disown")[0]))
+
+	realms, err := script.GetRealms(ctx, "C1", "")
+	require.NoError(t, err)
+	require.Len(t, realms, 1)
+	assert.Equal(t, "https://example.com", realms[0].Origin)
+
+	messages := make(chan bidi.Message, 2)
+	require.NoError(t, script.OnMessage(ctx, "ready", func(message bidi.Message) { messages <- message }))
+
+	browser.Emit(bidi.EventMessage, map[string]interface{}{
+		"channel": "other", "data": map[string]string{"type": "undefined"}, "source": map[string]string{"realm": "R1"},
+	})
+	browser.Emit(bidi.EventMessage, map[string]interface{}{
+		"channel": "ready", "data": map[string]interface{}{"type": "number", "value": 1},
+		"source": map[string]string{"realm": "R1", "context": "C1"},
+	})
+
+	select {
+	case message := <-messages:
+		data, err := message.Data.Decode()
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, data, 0)
+		assert.Equal(t, "C1", message.Source.Context)
+	case <-time.After(time.Second):
+		t.Fatal("no script.message received")
+	}
+}
+
+func TestScriptWithoutTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	script := bidi.NewScript(session)
+
+	browser.Handle("browsingContext.getTree", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"contexts": []interface{}{}}, nil
+	})
+
+	_, err := script.EvaluateScript(ctx, "1", nil)
+	require.ErrorIs(t, err, bidi.ErrNoTarget)
+
+	_, err = script.CallFunction(ctx, "() => 1", nil)
+	require.ErrorIs(t, err, bidi.ErrNoTarget)
+
+	//nolint:exhaustruct // Only the target matters.
+	_, err = script.Evaluate(ctx, "1", bidi.EvaluateOptions{})
+	require.ErrorIs(t, err, bidi.ErrNoTarget)
+
+	//nolint:exhaustruct // Only the target matters.
+	_, err = script.Call(ctx, "() => 1", nil, nil, bidi.EvaluateOptions{})
+	require.ErrorIs(t, err, bidi.ErrNoTarget)
+
+	assert.Empty(t, browser.Commands("script.evaluate"))
+	assert.Empty(t, browser.Commands("script.callFunction"))
+}
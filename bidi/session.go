@@ -3,63 +3,155 @@ package bidi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
+
+	"github.com/Kcrong/selenium"
 )
 
-// Session represents a BiDi session with the browser.
+// ErrNoWebSocketURL is returned when the session capabilities do not contain a webSocketUrl.
+var ErrNoWebSocketURL = errors.New("capabilities do not contain a webSocketUrl")
+
+// Session represents a W3C WebDriver BiDi session with the browser.
+//
+// A Session is safe for concurrent use. Events received from the browser are
+// passed to HandleEvent in order on a dedicated goroutine; handlers may
+// execute commands and subscribe or unsubscribe other handlers.
 type Session struct {
-	cdp      CDPSession
+	conn     *Conn
 	script   *Script
-	handlers map[string][]func(json.RawMessage)
+	handlers handlerSet
 	mu       sync.RWMutex
 	closed   bool
 }
 
-// NewSession creates a new BiDi session.
-func NewSession(ws WebSocket, sessionID, targetID string) *Session {
-	cdp := NewCDPSession(ws, sessionID, targetID)
+// NewSession creates a new BiDi session on an open WebSocket.
+func NewSession(ws WebSocket) *Session {
 	session := &Session{
-		cdp:      cdp,
+		conn:     nil,
 		script:   nil,
-		handlers: make(map[string][]func(json.RawMessage)),
+		handlers: newHandlerSet(),
 		mu:       sync.RWMutex{},
 		closed:   false,
 	}
-	session.script = NewScript(cdp)
+	session.conn = NewConn(ws, session.HandleEvent)
+	session.script = NewScript(session)
 
 	return session
 }
 
+// Connect dials the WebSocket URL and creates a session on it.
+//
+// The session is closed when ctx is done.
+func Connect(ctx context.Context, webSocketURL string) (*Session, error) {
+	ws, err := DialWebSocket(ctx, webSocketURL)
+	if err != nil {
+		return nil, err
+	}
+
+	session := NewSession(ws)
+	go session.closeOnDone(ctx)
+
+	return session, nil
+}
+
+// ConnectFromCapabilities connects to the webSocketUrl returned in the session capabilities.
+//
+// The session must have been created with the webSocketUrl capability set to true.
+func ConnectFromCapabilities(ctx context.Context, caps selenium.Convertible) (*Session, error) {
+	webSocketURL, ok := caps.ToCapabilities()["webSocketUrl"].(string)
+	if !ok || webSocketURL == "" {
+		return nil, ErrNoWebSocketURL
+	}
+
+	return Connect(ctx, webSocketURL)
+}
+
+// Done returns a channel that is closed once the session stops receiving messages.
+func (s *Session) Done() <-chan struct{} {
+	return s.conn.Done()
+}
+
+// closeOnDone closes the session when ctx is done.
+func (s *Session) closeOnDone(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		_ = s.Close()
+	case <-s.Done():
+	}
+}
+
 // Script returns the script executor for this session.
+//
+// Scripts run in the first top-level browsing context of the browser. Use
+// NewScript with the session for the rest of the script module.
 func (s *Session) Script() ScriptEvaluator {
 	return s.script
 }
 
-// Subscribe adds an event handler for the specified event type.
-func (s *Session) Subscribe(eventType string, handler func(json.RawMessage)) {
+// Subscribe adds an event handler for the specified event type and returns
+// a function that removes only this handler.
+//
+// The browser only sends events enabled with SubscribeEvents. After Close,
+// Subscribe does nothing.
+func (s *Session) Subscribe(eventType string, handler func(json.RawMessage)) func() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.handlers[eventType] = append(s.handlers[eventType], handler)
+	if s.closed {
+		return func() {}
+	}
+
+	id := s.handlers.add(eventType, handler)
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.handlers.remove(eventType, id)
+	}
 }
 
 // Unsubscribe removes all handlers for the specified event type.
+//
+// Deprecated: Unsubscribe also removes handlers added by other packages, such
+// as a log.Collector. Call the function returned by Subscribe instead.
 func (s *Session) Unsubscribe(eventType string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	delete(s.handlers, eventType)
+	delete(s.handlers.handlers, eventType)
+}
+
+// SubscribeEvents asks the browser to send the given events or modules, such as "log.entryAdded" or "network".
+func (s *Session) SubscribeEvents(ctx context.Context, events ...string) error {
+	_, err := s.conn.Execute(ctx, "session.subscribe", map[string]interface{}{"events": events})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %v: %w", events, err)
+	}
+
+	return nil
+}
+
+// UnsubscribeEvents asks the browser to stop sending the given events or modules.
+func (s *Session) UnsubscribeEvents(ctx context.Context, events ...string) error {
+	_, err := s.conn.Execute(ctx, "session.unsubscribe", map[string]interface{}{"events": events})
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe from %v: %w", events, err)
+	}
+
+	return nil
 }
 
 // HandleEvent processes an incoming event.
 func (s *Session) HandleEvent(eventType string, data json.RawMessage) {
 	s.mu.RLock()
-	handlers := s.handlers[eventType]
+	handlers := s.handlers.get(eventType)
 	s.mu.RUnlock()
 
 	for _, handler := range handlers {
-		handler(data)
+		handler.handle(data)
 	}
 }
 
@@ -73,10 +165,9 @@ func (s *Session) Close() error {
 	}
 
 	s.closed = true
-	s.handlers = nil
 
-	if err := s.cdp.Close(); err != nil {
-		return fmt.Errorf("failed to close CDP session: %w", err)
+	if err := s.conn.Close(); err != nil {
+		return fmt.Errorf("failed to close BiDi connection: %w", err)
 	}
 
 	return nil
@@ -84,10 +175,10 @@ func (s *Session) Close() error {
 
 // Execute sends a command to the browser and waits for the response.
 func (s *Session) Execute(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
-	result, err := s.cdp.Execute(ctx, method, params)
-	if err != nil {
-		return nil, err
-	}
+	return s.conn.Execute(ctx, method, params)
+}
 
-	return result, nil
+// Protocol returns ProtocolBiDi.
+func (s *Session) Protocol() Protocol {
+	return ProtocolBiDi
 }
@@ -0,0 +1,113 @@
+package bidi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/biditest"
+)
+
+// waitFor fails the test if ch is not closed or signalled in time.
+func waitFor(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestSessionUnsubscribeHandler(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+
+	var removedCalls atomic.Int32
+
+	seen := make(chan struct{}, 2)
+	unsubscribe := session.Subscribe("log.entryAdded", func(json.RawMessage) { removedCalls.Add(1) })
+	session.Subscribe("log.entryAdded", func(json.RawMessage) { seen <- struct{}{} })
+
+	// Only the handler whose function is called is removed
+	unsubscribe()
+	unsubscribe()
+
+	browser.Emit("log.entryAdded", map[string]interface{}{})
+	waitFor(t, seen)
+	assert.Zero(t, removedCalls.Load())
+}
+
+func TestSessionSubscribeAfterClose(t *testing.T) {
+	t.Parallel()
+
+	session, _ := biditest.NewSession(t)
+	require.NoError(t, session.Close())
+
+	unsubscribe := session.Subscribe("log.entryAdded", func(json.RawMessage) {})
+	unsubscribe()
+
+	err := bidi.On(context.Background(), session, "log.entryAdded", func(map[string]interface{}) {})
+	require.ErrorIs(t, err, bidi.ErrSessionClosed)
+}
+
+func TestOnRemovesHandlerWhenSubscribeFails(t *testing.T) {
+	t.Parallel()
+
+	session, browser := biditest.NewSession(t)
+	browser.Handle("session.subscribe", func(json.RawMessage) (interface{}, error) {
+		return nil, errors.New("unsupported event")
+	})
+
+	var calls atomic.Int32
+
+	err := bidi.On(context.Background(), session, "log.entryAdded", func(map[string]interface{}) { calls.Add(1) })
+	require.Error(t, err)
+
+	// Handlers run in order, so the failed handler would have run before this one
+	seen := make(chan struct{})
+	session.Subscribe("log.entryAdded", func(json.RawMessage) { close(seen) })
+
+	browser.Emit("log.entryAdded", map[string]interface{}{})
+	waitFor(t, seen)
+	assert.Zero(t, calls.Load())
+}
+
+func TestSessionHandlerExecutesWhileEventsQueue(t *testing.T) {
+	t.Parallel()
+
+	// More events than the WebSocket buffers arrive before the response to
+	// the command the first handler waits for
+	const events = 3000
+
+	session, browser := biditest.NewSession(t)
+
+	var count atomic.Int32
+
+	done := make(chan struct{})
+	session.Subscribe("test.event", func(json.RawMessage) {
+		switch count.Add(1) {
+		case 1:
+			_, err := session.Execute(context.Background(), "session.status", nil)
+			assert.NoError(t, err)
+		case events:
+			close(done)
+		}
+	})
+
+	go func() {
+		for range events {
+			browser.Emit("test.event", map[string]interface{}{})
+		}
+	}()
+
+	waitFor(t, done)
+}
@@ -0,0 +1,236 @@
+// Package storage implements the WebDriver BiDi storage module.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/network"
+)
+
+// ErrNoCookieDomain is returned when converting a cookie without a domain,
+// which storage.setCookie requires.
+var ErrNoCookieDomain = errors.New("cookie has no domain")
+
+// SameSite is the SameSite attribute of a cookie.
+type SameSite string
+
+const (
+	// SameSiteStrict sends the cookie only with same-site requests.
+	SameSiteStrict SameSite = "strict"
+	// SameSiteLax also sends the cookie with top-level cross-site navigations.
+	SameSiteLax SameSite = "lax"
+	// SameSiteNone sends the cookie with every request; browsers require Secure with it.
+	SameSiteNone SameSite = "none"
+	// SameSiteDefault leaves the attribute to the browser default.
+	SameSiteDefault SameSite = "default"
+)
+
+// Cookie is a cookie stored by the browser.
+type Cookie struct {
+	// Expiry is the expiry time in seconds since the Unix epoch; nil for session cookies.
+	Expiry   *int64             `json:"expiry,omitempty"`
+	Value    network.BytesValue `json:"value"`
+	Name     string             `json:"name"`
+	Domain   string             `json:"domain"`
+	Path     string             `json:"path"`
+	SameSite SameSite           `json:"sameSite"`
+	Size     int                `json:"size"`
+	HTTPOnly bool               `json:"httpOnly"`
+	Secure   bool               `json:"secure"`
+}
+
+// PartialCookie is a cookie to set; Name, Value and Domain are required.
+type PartialCookie struct {
+	Expiry   *int64             `json:"expiry,omitempty"`
+	Value    network.BytesValue `json:"value"`
+	Name     string             `json:"name"`
+	Domain   string             `json:"domain"`
+	Path     string             `json:"path,omitempty"`
+	SameSite SameSite           `json:"sameSite,omitempty"`
+	HTTPOnly bool               `json:"httpOnly,omitempty"`
+	Secure   bool               `json:"secure,omitempty"`
+}
+
+// CookieFilter selects cookies; zero fields match every cookie.
+type CookieFilter struct {
+	Value    *network.BytesValue `json:"value,omitempty"`
+	Expiry   *int64              `json:"expiry,omitempty"`
+	HTTPOnly *bool               `json:"httpOnly,omitempty"`
+	Secure   *bool               `json:"secure,omitempty"`
+	Name     string              `json:"name,omitempty"`
+	Domain   string              `json:"domain,omitempty"`
+	Path     string              `json:"path,omitempty"`
+	SameSite SameSite            `json:"sameSite,omitempty"`
+	Size     int                 `json:"size,omitempty"`
+}
+
+// PartitionKey identifies the storage partition cookies were read from or written to.
+type PartitionKey struct {
+	UserContext  string `json:"userContext,omitempty"`
+	SourceOrigin string `json:"sourceOrigin,omitempty"`
+}
+
+// Partition selects a storage partition.
+//
+// Use ContextPartition or KeyPartition to create one.
+type Partition struct {
+	Type         string `json:"type"`
+	Context      string `json:"context,omitempty"`
+	UserContext  string `json:"userContext,omitempty"`
+	SourceOrigin string `json:"sourceOrigin,omitempty"`
+}
+
+// ContextPartition selects the partition of a browsing context.
+func ContextPartition(contextID string) *Partition {
+	//nolint:exhaustruct // Storage key fields are unused for context partitions.
+	return &Partition{Type: "context", Context: contextID}
+}
+
+// KeyPartition selects a partition by storage key, such as the partition a
+// third-party frame embedded in SourceOrigin uses.
+func KeyPartition(key PartitionKey) *Partition {
+	return &Partition{
+		Type:         "storageKey",
+		Context:      "",
+		UserContext:  key.UserContext,
+		SourceOrigin: key.SourceOrigin,
+	}
+}
+
+// Storage sends storage commands over a BiDi session.
+type Storage struct {
+	session *bidi.Session
+}
+
+// New creates a storage module on the session.
+func New(session *bidi.Session) *Storage {
+	return &Storage{
+		session: session,
+	}
+}
+
+// GetCookies returns the cookies matching filter in the partition.
+//
+// A nil filter matches every cookie and a nil partition is the default partition.
+func (s *Storage) GetCookies(
+	ctx context.Context, filter *CookieFilter, partition *Partition,
+) ([]Cookie, PartitionKey, error) {
+	result, err := bidi.Run(ctx, s.session, bidi.NewBiDiCommand[struct {
+		Cookies      []Cookie     `json:"cookies"`
+		PartitionKey PartitionKey `json:"partitionKey"`
+	}]("storage.getCookies", params(filter, partition)))
+	if err != nil {
+		return nil, PartitionKey{}, fmt.Errorf("failed to get cookies: %w", err)
+	}
+
+	return result.Cookies, result.PartitionKey, nil
+}
+
+// SetCookie stores a cookie in the partition.
+//
+// Cookies can be set for any domain before the first navigation.
+func (s *Storage) SetCookie(ctx context.Context, cookie PartialCookie, partition *Partition) (PartitionKey, error) {
+	p := map[string]interface{}{"cookie": cookie}
+	if partition != nil {
+		p["partition"] = partition
+	}
+
+	result, err := bidi.Run(ctx, s.session, bidi.NewBiDiCommand[struct {
+		PartitionKey PartitionKey `json:"partitionKey"`
+	}]("storage.setCookie", p))
+	if err != nil {
+		return PartitionKey{}, fmt.Errorf("failed to set cookie %s: %w", cookie.Name, err)
+	}
+
+	return result.PartitionKey, nil
+}
+
+// DeleteCookies deletes the cookies matching filter in the partition.
+func (s *Storage) DeleteCookies(ctx context.Context, filter *CookieFilter, partition *Partition) (PartitionKey, error) {
+	result, err := bidi.Run(ctx, s.session, bidi.NewBiDiCommand[struct {
+		PartitionKey PartitionKey `json:"partitionKey"`
+	}]("storage.deleteCookies", params(filter, partition)))
+	if err != nil {
+		return PartitionKey{}, fmt.Errorf("failed to delete cookies: %w", err)
+	}
+
+	return result.PartitionKey, nil
+}
+
+// params builds the filter and partition parameters.
+func params(filter *CookieFilter, partition *Partition) map[string]interface{} {
+	p := map[string]interface{}{}
+	if filter != nil {
+		p["filter"] = filter
+	}
+
+	if partition != nil {
+		p["partition"] = partition
+	}
+
+	return p
+}
+
+// FromCookie converts a WebDriver classic cookie.
+//
+// WebDriver classic defaults the domain to the current page, which BiDi has no
+// notion of, so a cookie without a Domain returns ErrNoCookieDomain.
+func FromCookie(cookie selenium.Cookie) (PartialCookie, error) {
+	if cookie.Domain == "" {
+		return PartialCookie{}, fmt.Errorf("%w: %s", ErrNoCookieDomain, cookie.Name)
+	}
+
+	partial := PartialCookie{
+		Expiry:   nil,
+		Value:    network.StringValue(cookie.Value),
+		Name:     cookie.Name,
+		Domain:   cookie.Domain,
+		Path:     cookie.Path,
+		SameSite: SameSite(strings.ToLower(cookie.SameSite)),
+		HTTPOnly: cookie.HTTPOnly,
+		Secure:   cookie.Secure,
+	}
+
+	if cookie.Expiry > 0 {
+		expiry := int64(cookie.Expiry)
+		partial.Expiry = &expiry
+	}
+
+	return partial, nil
+}
+
+// Cookie converts the cookie to a WebDriver classic cookie.
+//
+// Binary values are decoded into the raw bytes of Value.
+func (c Cookie) Cookie() (selenium.Cookie, error) {
+	value, err := c.Value.Bytes()
+	if err != nil {
+		return selenium.Cookie{}, fmt.Errorf("failed to decode cookie %s: %w", c.Name, err)
+	}
+
+	cookie := selenium.Cookie{
+		Name:     c.Name,
+		Value:    string(value),
+		Path:     c.Path,
+		Domain:   c.Domain,
+		SameSite: "",
+		Expiry:   0,
+		Secure:   c.Secure,
+		HTTPOnly: c.HTTPOnly,
+	}
+
+	if c.SameSite != "" && c.SameSite != SameSiteDefault {
+		cookie.SameSite = strings.ToUpper(string(c.SameSite[:1])) + string(c.SameSite[1:])
+	}
+
+	if c.Expiry != nil {
+		cookie.Expiry = float64(*c.Expiry)
+	}
+
+	return cookie, nil
+}
@@ -0,0 +1,93 @@
+package storage_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/bidi/biditest"
+	"github.com/Kcrong/selenium/bidi/network"
+	"github.com/Kcrong/selenium/bidi/storage"
+)
+
+func TestCookies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session, browser := biditest.NewSession(t)
+	store := storage.New(session)
+
+	browser.Handle("storage.setCookie", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"partitionKey": map[string]string{"sourceOrigin": "https://app.example"}}, nil
+	})
+	browser.Handle("storage.getCookies", func(json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{
+			"cookies": []map[string]interface{}{{
+				"name": "sid", "value": map[string]string{"type": "string", "value": "abc"},
+				"domain": ".auth.example", "path": "/", "size": 6, "httpOnly": true, "secure": true,
+				"sameSite": "lax", "expiry": 1900000000,
+			}, {
+				"name": "raw", "value": map[string]string{"type": "base64", "value": "AP8="},
+				"domain": ".auth.example", "path": "/", "size": 5, "sameSite": "default",
+			}},
+			"partitionKey": map[string]string{"userContext": "default"},
+		}, nil
+	})
+
+	partial, err := storage.FromCookie(selenium.Cookie{
+		Name: "sid", Value: "abc", Domain: ".auth.example", SameSite: "None", Secure: true, Expiry: 1900000000,
+	})
+	require.NoError(t, err)
+
+	key, err := store.SetCookie(ctx, partial, storage.KeyPartition(storage.PartitionKey{SourceOrigin: "https://app.example"}))
+	require.NoError(t, err)
+	assert.Equal(t, "https://app.example", key.SourceOrigin)
+	assert.JSONEq(t, `{
+		"cookie": {
+			"name": "sid", "value": {"type": "string", "value": "abc"}, "domain": ".auth.example",
+			"sameSite": "none", "secure": true, "expiry": 1900000000
+		},
+		"partition": {"type": "storageKey", "sourceOrigin": "https://app.example"}
+	}`, string(browser.Commands("storage.setCookie")[0]))
+
+	cookies, key, err := store.GetCookies(ctx, &storage.CookieFilter{Name: "sid"}, storage.ContextPartition("C1"))
+	require.NoError(t, err)
+	assert.Equal(t, "default", key.UserContext)
+	assert.JSONEq(t, `{"filter": {"name": "sid"}, "partition": {"type": "context", "context": "C1"}}`,
+		string(browser.Commands("storage.getCookies")[0]))
+
+	require.Len(t, cookies, 2)
+
+	cookie, err := cookies[0].Cookie()
+	require.NoError(t, err)
+	assert.Equal(t, selenium.Cookie{
+		Name: "sid", Value: "abc", Path: "/", Domain: ".auth.example", SameSite: "Lax",
+		Expiry: 1900000000, Secure: true, HTTPOnly: true,
+	}, cookie)
+
+	// Binary values are decoded
+	cookie, err = cookies[1].Cookie()
+	require.NoError(t, err)
+	assert.Equal(t, "\x00\xff", cookie.Value)
+	assert.Empty(t, cookie.SameSite)
+
+	_, err = store.DeleteCookies(ctx, nil, nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(browser.Commands("storage.deleteCookies")[0]))
+}
+
+func TestCookieConversionErrors(t *testing.T) {
+	t.Parallel()
+
+	//nolint:exhaustruct // Only the domain matters.
+	_, err := storage.FromCookie(selenium.Cookie{Name: "sid", Value: "abc"})
+	require.ErrorIs(t, err, storage.ErrNoCookieDomain)
+
+	//nolint:exhaustruct // Only the value matters.
+	_, err = storage.Cookie{Name: "raw", Value: network.BytesValue{Type: "base64", Value: "not base64"}}.Cookie()
+	require.Error(t, err)
+}
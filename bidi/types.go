@@ -1,6 +1,11 @@
 package bidi
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Kcrong/selenium"
+)
 
 // WebSocket interface defines the methods required for WebSocket communication.
 type WebSocket interface {
@@ -11,7 +16,7 @@ type WebSocket interface {
 
 // CDPSession represents a CDP session with the browser.
 type CDPSession interface {
-	Execute(ctx context.Context, method string, params interface{}) ([]byte, error)
+	Transport
 	Close() error
 }
 
@@ -22,3 +27,48 @@ type ScriptEvaluator interface {
 	// CallFunction calls a JavaScript function and returns the result
 	CallFunction(ctx context.Context, functionDeclaration string, args []interface{}) (*ScriptResult, error)
 }
+
+// SharedReference refers to a DOM node across realms, for example an element
+// found with browsingContext.locateNodes.
+type SharedReference struct {
+	SharedID string `json:"sharedId"`
+	Handle   string `json:"handle,omitempty"`
+}
+
+// NodeProperties describes a DOM node.
+type NodeProperties struct {
+	Attributes     map[string]string `json:"attributes,omitempty"`
+	LocalName      string            `json:"localName,omitempty"`
+	NamespaceURI   string            `json:"namespaceURI,omitempty"`
+	NodeValue      string            `json:"nodeValue,omitempty"`
+	NodeType       int               `json:"nodeType"`
+	ChildNodeCount int               `json:"childNodeCount"`
+}
+
+// NodeRemoteValue is a DOM node returned by the browser.
+type NodeRemoteValue struct {
+	Value    *NodeProperties `json:"value,omitempty"`
+	Type     string          `json:"type"`
+	SharedID string          `json:"sharedId"`
+	Handle   string          `json:"handle,omitempty"`
+}
+
+// Reference returns a shared reference to the node.
+func (n NodeRemoteValue) Reference() SharedReference {
+	return SharedReference{SharedID: n.SharedID, Handle: n.Handle}
+}
+
+// ElementReference refers to an element found over WebDriver classic; the
+// element ID is the shared ID of the node in BiDi.
+func ElementReference(element selenium.WebElement) SharedReference {
+	return SharedReference{SharedID: element.GetID(), Handle: ""}
+}
+
+// RemoteValue is a JavaScript value serialized by the browser.
+type RemoteValue struct {
+	Value      json.RawMessage `json:"value,omitempty"`
+	Type       string          `json:"type"`
+	Handle     string          `json:"handle,omitempty"`
+	InternalID string          `json:"internalId,omitempty"`
+	SharedID   string          `json:"sharedId,omitempty"`
+}
@@ -0,0 +1,347 @@
+package bidi
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6455 mandates SHA-1 for the accept key.
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// websocketGUID is appended to the handshake key as defined by RFC 6455.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// MaxMessageSize bounds the size of a single received message.
+//
+// CDP screenshots and screencast frames can be tens of megabytes.
+const MaxMessageSize = 256 << 20
+
+// closeTimeout bounds how long Close waits to send the close frame.
+const closeTimeout = 5 * time.Second
+
+// WebSocket frame opcodes.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+var (
+	// ErrHandshakeFailed is returned when the server does not accept the WebSocket upgrade.
+	ErrHandshakeFailed = errors.New("websocket handshake failed")
+	// ErrMessageTooLarge is returned when a received message exceeds MaxMessageSize.
+	ErrMessageTooLarge = errors.New("websocket message too large")
+	// ErrProtocol is returned when the server violates the WebSocket framing rules.
+	ErrProtocol = errors.New("websocket protocol error")
+)
+
+// WebSocketConn is a client WebSocket connection.
+//
+// Send and Close may be called concurrently with each other and with Receive;
+// Receive must be called from a single goroutine.
+type WebSocketConn struct {
+	conn      net.Conn
+	reader    *bufio.Reader
+	writeMu   sync.Mutex
+	closeOnce sync.Once
+}
+
+// DialWebSocket opens a WebSocket connection to a ws:// or wss:// URL.
+//
+// ctx bounds the TCP, TLS and HTTP upgrade handshake only.
+func DialWebSocket(ctx context.Context, rawURL string) (*WebSocketConn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid websocket URL: %w", err)
+	}
+
+	secure := false
+
+	switch u.Scheme {
+	case "ws", "http":
+	case "wss", "https":
+		secure = true
+	default:
+		return nil, fmt.Errorf("%w: unsupported scheme %q", ErrHandshakeFailed, u.Scheme)
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		if secure {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", host, err)
+	}
+
+	if secure {
+		//nolint:exhaustruct // Defaults are the safe choice.
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+
+			return nil, fmt.Errorf("%w: %w", ErrHandshakeFailed, err)
+		}
+
+		conn = tlsConn
+	}
+
+	ws := &WebSocketConn{
+		conn:      conn,
+		reader:    bufio.NewReader(conn),
+		writeMu:   sync.Mutex{},
+		closeOnce: sync.Once{},
+	}
+
+	if err := ws.handshake(ctx, u); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// handshake performs the HTTP upgrade.
+func (ws *WebSocketConn) handshake(ctx context.Context, u *url.URL) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = ws.conn.SetDeadline(deadline)
+		defer func() { _ = ws.conn.SetDeadline(time.Time{}) }()
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("%w: %w", ErrHandshakeFailed, err)
+	}
+
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	//nolint:exhaustruct // Only the fields of an upgrade request are set.
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        &url.URL{Path: u.Path, RawPath: u.RawPath, RawQuery: u.RawQuery},
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Upgrade":               {"websocket"},
+			"Connection":            {"Upgrade"},
+			"Sec-Websocket-Key":     {key},
+			"Sec-Websocket-Version": {"13"},
+		},
+		Host: u.Host,
+	}
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+
+	if err := req.Write(ws.conn); err != nil {
+		return fmt.Errorf("%w: %w", ErrHandshakeFailed, err)
+	}
+
+	resp, err := http.ReadResponse(ws.reader, req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrHandshakeFailed, err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return fmt.Errorf("%w: unexpected status %s", ErrHandshakeFailed, resp.Status)
+	}
+
+	if resp.Header.Get("Sec-Websocket-Accept") != acceptKey(key) {
+		return fmt.Errorf("%w: invalid Sec-WebSocket-Accept", ErrHandshakeFailed)
+	}
+
+	return nil
+}
+
+// acceptKey computes the Sec-WebSocket-Accept value for a handshake key.
+func acceptKey(key string) string {
+	//nolint:gosec // RFC 6455 mandates SHA-1 for the accept key.
+	sum := sha1.Sum([]byte(key + websocketGUID))
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// Send sends a text message.
+func (ws *WebSocketConn) Send(data []byte) error {
+	return ws.writeFrame(opText, data)
+}
+
+// Receive returns the next text or binary message.
+//
+// Pings are answered transparently. It returns io.EOF once the server closes the connection.
+func (ws *WebSocketConn) Receive() ([]byte, error) {
+	var message []byte
+
+	started := false
+
+	for {
+		fin, opcode, payload, err := ws.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case opPing:
+			if err := ws.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+
+			continue
+		case opPong:
+			continue
+		case opClose:
+			_ = ws.Close()
+
+			return nil, io.EOF
+		case opText, opBinary:
+			if started {
+				return nil, fmt.Errorf("%w: unfinished fragmented message", ErrProtocol)
+			}
+
+			started = true
+		case opContinuation:
+			if !started {
+				return nil, fmt.Errorf("%w: unexpected continuation frame", ErrProtocol)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown opcode %d", ErrProtocol, opcode)
+		}
+
+		if len(message)+len(payload) > MaxMessageSize {
+			return nil, ErrMessageTooLarge
+		}
+
+		message = append(message, payload...)
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// Close sends a close frame and closes the underlying connection.
+func (ws *WebSocketConn) Close() error {
+	var err error
+
+	ws.closeOnce.Do(func() {
+		_ = ws.conn.SetWriteDeadline(time.Now().Add(closeTimeout))
+		// 1000 is the normal closure status code.
+		_ = ws.writeFrame(opClose, []byte{0x03, 0xE8})
+		err = ws.conn.Close()
+	})
+
+	return err
+}
+
+// readFrame reads a single frame.
+func (ws *WebSocketConn) readFrame() (bool, byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(ws.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0F
+	masked := header[1]&0x80 != 0
+	length := uint64(header[1] & 0x7F)
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(ws.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(ws.reader, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	if length > MaxMessageSize {
+		return false, 0, nil, ErrMessageTooLarge
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(ws.reader, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(ws.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+
+	return fin, opcode, payload, nil
+}
+
+// writeFrame writes a single masked frame, as required for clients.
+func (ws *WebSocketConn) writeFrame(opcode byte, payload []byte) error {
+	frame := make([]byte, 0, len(payload)+14)
+	frame = append(frame, 0x80|opcode)
+
+	switch length := len(payload); {
+	case length < 126:
+		frame = append(frame, 0x80|byte(length))
+	case length <= 0xFFFF:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(length))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(length))
+	}
+
+	var mask [4]byte
+	if _, err := rand.Read(mask[:]); err != nil {
+		return fmt.Errorf("failed to generate mask: %w", err)
+	}
+
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	ws.writeMu.Lock()
+	defer ws.writeMu.Unlock()
+
+	if _, err := ws.conn.Write(frame); err != nil {
+		return fmt.Errorf("failed to write frame: %w", err)
+	}
+
+	return nil
+}
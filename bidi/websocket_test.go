@@ -0,0 +1,263 @@
+package bidi_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // RFC 6455 mandates SHA-1 for the accept key.
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/chromium"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote"
+)
+
+// readClientFrame reads a single masked client frame.
+func readClientFrame(r *bufio.Reader) (byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+
+	length := uint64(header[1] & 0x7F)
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(r, mask[:]); err != nil {
+		return 0, nil, err
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+
+	return header[0] & 0x0F, payload, nil
+}
+
+// serverFrame encodes an unmasked server frame.
+func serverFrame(opcode byte, payload []byte) []byte {
+	frame := []byte{0x80 | opcode}
+
+	switch length := len(payload); {
+	case length < 126:
+		frame = append(frame, byte(length))
+	case length <= 0xFFFF:
+		frame = append(frame, 126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(length))
+	default:
+		frame = append(frame, 127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(length))
+	}
+
+	return append(frame, payload...)
+}
+
+// fakeCommand is a command received by the fake browser.
+type fakeCommand struct {
+	Params    json.RawMessage `json:"params"`
+	Method    string          `json:"method"`
+	SessionID string          `json:"sessionId"`
+	ID        int64           `json:"id"`
+}
+
+// newFakeBrowser serves a WebSocket endpoint that pings the client and then
+// sends the messages returned by respond for each command.
+func newFakeBrowser(t *testing.T, respond func(cmd fakeCommand) []interface{}) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		//nolint:gosec // RFC 6455 mandates SHA-1 for the accept key.
+		sum := sha1.Sum([]byte(r.Header.Get("Sec-WebSocket-Key") + "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"))
+
+		conn, rw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, _ = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+			"Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(sum[:]) + "\r\n\r\n")
+		_ = rw.Flush()
+
+		for {
+			opcode, payload, err := readClientFrame(rw.Reader)
+			if err != nil || opcode == 0x8 {
+				return
+			}
+
+			if opcode == 0xA {
+				// Pong to our ping
+				continue
+			}
+
+			var cmd fakeCommand
+			if err := json.Unmarshal(payload, &cmd); err != nil {
+				return
+			}
+
+			_, _ = rw.Write(serverFrame(0x9, []byte("ping")))
+
+			for _, message := range respond(cmd) {
+				data, _ := json.Marshal(message)
+				_, _ = rw.Write(serverFrame(0x1, data))
+			}
+
+			_ = rw.Flush()
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// respondBiDi emits an event before answering each command. "Fail" is
+// answered with an error and "Big" with a result larger than 64 KiB.
+func respondBiDi(cmd fakeCommand) []interface{} {
+	event := map[string]interface{}{"type": "event", "method": "test.seen", "params": map[string]string{"method": cmd.Method}}
+
+	switch cmd.Method {
+	case "Fail":
+		return []interface{}{event, map[string]interface{}{
+			"type": "error", "id": cmd.ID, "error": "unknown command", "message": "Fail",
+		}}
+	case "Big":
+		return []interface{}{event, map[string]interface{}{
+			"type": "success", "id": cmd.ID, "result": map[string]string{"data": strings.Repeat("x", 70000)},
+		}}
+	default:
+		return []interface{}{event, map[string]interface{}{
+			"type": "success", "id": cmd.ID, "result": map[string]string{"method": cmd.Method},
+		}}
+	}
+}
+
+func TestSessionOverWebSocket(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session, err := bidi.Connect(ctx, newFakeBrowser(t, respondBiDi))
+	require.NoError(t, err)
+
+	seen := make(chan string, 8)
+	session.Subscribe("test.seen", func(params json.RawMessage) {
+		var payload struct {
+			Method string `json:"method"`
+		}
+
+		_ = json.Unmarshal(params, &payload)
+		seen <- payload.Method
+	})
+
+	result, err := session.Execute(ctx, "session.status", nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"method": "session.status"}`, string(result))
+	assert.Equal(t, "session.status", <-seen)
+
+	result, err = session.Execute(ctx, "Big", nil)
+	require.NoError(t, err)
+	assert.Len(t, result, 70000+len(`{"data":""}`))
+
+	_, err = session.Execute(ctx, "Fail", nil)
+
+	var protocolErr *bidi.ProtocolError
+	require.ErrorAs(t, err, &protocolErr)
+	assert.Equal(t, "unknown command", protocolErr.Code)
+
+	// BiDi errors can be handled like CDP errors
+	var browserErr *bidi.BrowserError
+	require.ErrorAs(t, err, &browserErr)
+	assert.Equal(t, "unknown command", browserErr.Detail)
+	assert.Equal(t, protocolErr.Message, browserErr.Message)
+
+	// Cancelling the context closes the session
+	cancel()
+
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("session did not stop after the context was cancelled")
+	}
+
+	_, err = session.Execute(context.Background(), "session.status", nil)
+	require.ErrorIs(t, err, bidi.ErrSessionClosed)
+}
+
+func TestConnectFromCapabilities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	webSocketURL := newFakeBrowser(t, respondBiDi)
+
+	// The remote end answers webSocketUrl: true with the URL of the BiDi endpoint
+	server := remotetest.NewServer(t)
+	server.Handle("POST /session", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Capabilities struct {
+				AlwaysMatch map[string]interface{} `json:"alwaysMatch"`
+			} `json:"capabilities"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		caps := request.Capabilities.AlwaysMatch
+		if caps["webSocketUrl"] == true {
+			caps["webSocketUrl"] = webSocketURL
+		}
+
+		remotetest.Reply(w, map[string]interface{}{"sessionId": "s1", "capabilities": caps})
+	})
+
+	opts := chromium.NewOptions()
+
+	driver, err := remote.New(ctx, server.Conn(t), opts)
+	require.NoError(t, err)
+
+	_, err = bidi.ConnectFromCapabilities(ctx, driver.GetCapabilities())
+	require.ErrorIs(t, err, bidi.ErrNoWebSocketURL)
+
+	opts.SetEnableBiDi(true)
+
+	driver, err = remote.New(ctx, server.Conn(t), opts)
+	require.NoError(t, err)
+
+	session, err := bidi.ConnectFromCapabilities(ctx, driver.GetCapabilities())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+
+	result, err := session.Execute(ctx, "session.status", nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"method": "session.status"}`, string(result))
+}
@@ -0,0 +1,114 @@
+package chrome
+
+// Device is a preset of an emulated device's screen and user agent.
+type Device struct {
+	Name      string
+	UserAgent string
+	Platform  string
+	Metrics   DeviceMetrics
+}
+
+// MobileEmulation returns mobile emulation settings with the device's explicit metrics.
+//
+// Unlike NewDeviceEmulation, this does not depend on the device list bundled with Chrome.
+func (d *Device) MobileEmulation() *MobileEmulation {
+	metrics := d.Metrics
+
+	return &MobileEmulation{
+		DeviceMetrics: &metrics,
+		//nolint:exhaustruct // Only the platform and mobile hints are known for presets.
+		ClientHints: &ClientHints{
+			Platform: d.Platform,
+			Mobile:   d.Metrics.Mobile,
+		},
+		DeviceName: "",
+		UserAgent:  d.UserAgent,
+	}
+}
+
+const (
+	iosUserAgent = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) " +
+		"AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+	ipadUserAgent = "Mozilla/5.0 (iPad; CPU OS 17_0 like Mac OS X) " +
+		"AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+	pixelUserAgent = "Mozilla/5.0 (Linux; Android 14; Pixel 7) " +
+		"AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36"
+	galaxyUserAgent = "Mozilla/5.0 (Linux; Android 13; SM-S911B) " +
+		"AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36"
+)
+
+// Common device presets.
+var (
+	// IPhoneSE is the Apple iPhone SE (3rd generation).
+	IPhoneSE = Device{
+		Name:      "iPhone SE",
+		UserAgent: iosUserAgent,
+		Platform:  "iOS",
+		Metrics:   DeviceMetrics{Width: 375, Height: 667, PixelRatio: 2, Touch: true, Mobile: true},
+	}
+	// IPhone14Pro is the Apple iPhone 14 Pro.
+	IPhone14Pro = Device{
+		Name:      "iPhone 14 Pro",
+		UserAgent: iosUserAgent,
+		Platform:  "iOS",
+		Metrics:   DeviceMetrics{Width: 393, Height: 852, PixelRatio: 3, Touch: true, Mobile: true},
+	}
+	// IPhone14ProMax is the Apple iPhone 14 Pro Max.
+	IPhone14ProMax = Device{
+		Name:      "iPhone 14 Pro Max",
+		UserAgent: iosUserAgent,
+		Platform:  "iOS",
+		Metrics:   DeviceMetrics{Width: 430, Height: 932, PixelRatio: 3, Touch: true, Mobile: true},
+	}
+	// IPadAir is the Apple iPad Air.
+	IPadAir = Device{
+		Name:      "iPad Air",
+		UserAgent: ipadUserAgent,
+		Platform:  "iOS",
+		Metrics:   DeviceMetrics{Width: 820, Height: 1180, PixelRatio: 2, Touch: true, Mobile: true},
+	}
+	// IPadPro is the Apple iPad Pro 12.9".
+	IPadPro = Device{
+		Name:      "iPad Pro",
+		UserAgent: ipadUserAgent,
+		Platform:  "iOS",
+		Metrics:   DeviceMetrics{Width: 1024, Height: 1366, PixelRatio: 2, Touch: true, Mobile: true},
+	}
+	// Pixel7 is the Google Pixel 7.
+	Pixel7 = Device{
+		Name:      "Pixel 7",
+		UserAgent: pixelUserAgent,
+		Platform:  "Android",
+		Metrics:   DeviceMetrics{Width: 412, Height: 915, PixelRatio: 2.625, Touch: true, Mobile: true},
+	}
+	// GalaxyS23 is the Samsung Galaxy S23.
+	GalaxyS23 = Device{
+		Name:      "Galaxy S23",
+		UserAgent: galaxyUserAgent,
+		Platform:  "Android",
+		Metrics:   DeviceMetrics{Width: 360, Height: 780, PixelRatio: 3, Touch: true, Mobile: true},
+	}
+)
+
+// Devices lists the built-in device presets.
+var Devices = []Device{
+	IPhoneSE,
+	IPhone14Pro,
+	IPhone14ProMax,
+	IPadAir,
+	IPadPro,
+	Pixel7,
+	GalaxyS23,
+}
+
+// LookupDevice returns the built-in device preset with the given name.
+func LookupDevice(name string) (Device, bool) {
+	for _, device := range Devices {
+		if device.Name == name {
+			return device, true
+		}
+	}
+
+	//nolint:exhaustruct // Zero value for unknown devices.
+	return Device{}, false
+}
@@ -0,0 +1,28 @@
+package chrome
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/chromium"
+)
+
+// NewDriver starts chromedriver and creates a new Chrome session.
+//
+// Nil options or service use the defaults. Quit on the returned driver also
+// stops chromedriver.
+//
+// Example usage:
+//
+//	driver, err := chrome.NewDriver(ctx, opts, nil)
+//	defer driver.Quit(ctx)
+func NewDriver(ctx context.Context, opts *Options, svc *Service) (*chromium.Driver, error) {
+	if opts == nil {
+		opts = NewOptions()
+	}
+
+	if svc == nil {
+		svc = NewService("")
+	}
+
+	return chromium.NewDriver(ctx, opts.Options, svc.Service)
+}
@@ -0,0 +1,206 @@
+package chrome
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MobileEmulationKey is the key of the mobile emulation option in goog:chromeOptions.
+const MobileEmulationKey = "mobileEmulation"
+
+// ErrInvalidMobileEmulation is returned when mobile emulation settings are inconsistent.
+var ErrInvalidMobileEmulation = errors.New("invalid mobile emulation")
+
+// DeviceMetrics describes the screen of an emulated device.
+type DeviceMetrics struct {
+	Width      int
+	Height     int
+	PixelRatio float64
+	Touch      bool
+	Mobile     bool
+}
+
+// ToCapabilities converts the device metrics to a capabilities map.
+func (m *DeviceMetrics) ToCapabilities() map[string]interface{} {
+	return map[string]interface{}{
+		"width":      m.Width,
+		"height":     m.Height,
+		"pixelRatio": m.PixelRatio,
+		"touch":      m.Touch,
+		"mobile":     m.Mobile,
+	}
+}
+
+// BrandVersion is a single entry of the user agent client hints brand list.
+type BrandVersion struct {
+	Brand   string `json:"brand"`
+	Version string `json:"version"`
+}
+
+// ClientHints describes the User-Agent Client Hints reported by an emulated device.
+type ClientHints struct {
+	Platform        string
+	PlatformVersion string
+	Architecture    string
+	Model           string
+	Bitness         string
+	Brands          []BrandVersion
+	FullVersionList []BrandVersion
+	Mobile          bool
+	Wow64           bool
+}
+
+// ToCapabilities converts the client hints to a capabilities map.
+func (h *ClientHints) ToCapabilities() map[string]interface{} {
+	caps := map[string]interface{}{
+		"platform": h.Platform,
+		"mobile":   h.Mobile,
+	}
+
+	if h.PlatformVersion != "" {
+		caps["platformVersion"] = h.PlatformVersion
+	}
+
+	if h.Architecture != "" {
+		caps["architecture"] = h.Architecture
+	}
+
+	if h.Model != "" {
+		caps["model"] = h.Model
+	}
+
+	if h.Bitness != "" {
+		caps["bitness"] = h.Bitness
+	}
+
+	if len(h.Brands) > 0 {
+		caps["brands"] = h.Brands
+	}
+
+	if len(h.FullVersionList) > 0 {
+		caps["fullVersionList"] = h.FullVersionList
+	}
+
+	if h.Wow64 {
+		caps["wow64"] = true
+	}
+
+	return caps
+}
+
+// MobileEmulation contains the mobile emulation settings.
+//
+// Either DeviceName or DeviceMetrics/UserAgent/ClientHints may be set, but not both.
+type MobileEmulation struct {
+	DeviceMetrics *DeviceMetrics
+	ClientHints   *ClientHints
+	DeviceName    string
+	UserAgent     string
+}
+
+// NewDeviceEmulation creates mobile emulation settings for a device known to Chrome DevTools.
+//
+// Example usage:
+//
+//	opts.SetMobileEmulation(chrome.NewDeviceEmulation("Pixel 7"))
+func NewDeviceEmulation(deviceName string) *MobileEmulation {
+	//nolint:exhaustruct // Only the device name is used by Chrome.
+	return &MobileEmulation{DeviceName: deviceName}
+}
+
+// Validate checks that the mobile emulation settings are consistent.
+func (m *MobileEmulation) Validate() error {
+	hasMetrics := m.DeviceMetrics != nil || m.UserAgent != "" || m.ClientHints != nil
+
+	if m.DeviceName != "" && hasMetrics {
+		return fmt.Errorf("%w: device name cannot be combined with explicit metrics", ErrInvalidMobileEmulation)
+	}
+
+	if m.DeviceName == "" && !hasMetrics {
+		return fmt.Errorf("%w: either device name or device metrics must be set", ErrInvalidMobileEmulation)
+	}
+
+	if m.ClientHints != nil && m.ClientHints.Platform == "" {
+		return fmt.Errorf("%w: client hints require a platform", ErrInvalidMobileEmulation)
+	}
+
+	return nil
+}
+
+// clone returns a deep copy of the settings.
+func (m *MobileEmulation) clone() *MobileEmulation {
+	if m == nil {
+		return nil
+	}
+
+	c := *m
+
+	if m.DeviceMetrics != nil {
+		metrics := *m.DeviceMetrics
+		c.DeviceMetrics = &metrics
+	}
+
+	if m.ClientHints != nil {
+		hints := *m.ClientHints
+		hints.Brands = append([]BrandVersion(nil), m.ClientHints.Brands...)
+		hints.FullVersionList = append([]BrandVersion(nil), m.ClientHints.FullVersionList...)
+		c.ClientHints = &hints
+	}
+
+	return &c
+}
+
+// ToCapabilities converts the mobile emulation settings to a capabilities map.
+func (m *MobileEmulation) ToCapabilities() map[string]interface{} {
+	caps := make(map[string]interface{})
+
+	if m.DeviceName != "" {
+		caps["deviceName"] = m.DeviceName
+
+		return caps
+	}
+
+	if m.DeviceMetrics != nil {
+		caps["deviceMetrics"] = m.DeviceMetrics.ToCapabilities()
+	}
+
+	if m.UserAgent != "" {
+		caps["userAgent"] = m.UserAgent
+	}
+
+	if m.ClientHints != nil {
+		caps["clientHints"] = m.ClientHints.ToCapabilities()
+	}
+
+	return caps
+}
+
+// SetMobileEmulation enables mobile emulation with a device name or explicit metrics.
+//
+// The settings are validated and copied, so later changes to emulation have
+// no effect; call SetMobileEmulation again to apply them.
+func (o *Options) SetMobileEmulation(emulation *MobileEmulation) error {
+	if emulation == nil {
+		return fmt.Errorf("%w: settings are nil", ErrInvalidMobileEmulation)
+	}
+
+	if err := emulation.Validate(); err != nil {
+		return err
+	}
+
+	o.mobileEmulation = emulation.clone()
+	o.AddExperimentalOption(MobileEmulationKey, o.mobileEmulation)
+
+	return nil
+}
+
+// GetMobileEmulation returns a copy of the mobile emulation settings, or nil if emulation is disabled.
+func (o *Options) GetMobileEmulation() *MobileEmulation {
+	return o.mobileEmulation.clone()
+}
+
+// ClearMobileEmulation disables mobile emulation.
+func (o *Options) ClearMobileEmulation() {
+	o.mobileEmulation = nil
+	delete(o.GetExperimentalOptions(), MobileEmulationKey)
+}
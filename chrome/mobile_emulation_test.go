@@ -0,0 +1,153 @@
+package chrome_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/chrome"
+)
+
+func TestMobileEmulationValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		emulation *chrome.MobileEmulation
+		name      string
+		valid     bool
+	}{
+		{
+			name:      "device name",
+			emulation: chrome.NewDeviceEmulation("Pixel 7"),
+			valid:     true,
+		},
+		{
+			name: "explicit metrics",
+			//nolint:exhaustruct // Only the metrics are set.
+			emulation: &chrome.MobileEmulation{DeviceMetrics: &chrome.DeviceMetrics{Width: 360, Height: 640}},
+			valid:     true,
+		},
+		{
+			name:      "empty",
+			emulation: &chrome.MobileEmulation{}, //nolint:exhaustruct // Nothing is set.
+			valid:     false,
+		},
+		{
+			name: "device name with metrics",
+			//nolint:exhaustruct // Conflicting settings.
+			emulation: &chrome.MobileEmulation{DeviceName: "Pixel 7", UserAgent: "agent"},
+			valid:     false,
+		},
+		{
+			name: "client hints without platform",
+			//nolint:exhaustruct // Only the client hints are set.
+			emulation: &chrome.MobileEmulation{ClientHints: &chrome.ClientHints{Mobile: true}},
+			valid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.emulation.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, chrome.ErrInvalidMobileEmulation)
+			}
+		})
+	}
+}
+
+func TestSetMobileEmulation(t *testing.T) {
+	t.Parallel()
+
+	opts := chrome.NewOptions()
+	require.ErrorIs(t, opts.SetMobileEmulation(nil), chrome.ErrInvalidMobileEmulation)
+	require.ErrorIs(t, opts.SetMobileEmulation(&chrome.MobileEmulation{}), //nolint:exhaustruct // Invalid.
+		chrome.ErrInvalidMobileEmulation)
+	assert.Nil(t, opts.GetMobileEmulation())
+
+	emulation := chrome.NewDeviceEmulation("Pixel 7")
+	require.NoError(t, opts.SetMobileEmulation(emulation))
+	assert.Equal(t, map[string]interface{}{"deviceName": "Pixel 7"}, mobileEmulation(t, opts))
+
+	// The settings are copied, so unvalidated changes never reach the capabilities
+	emulation.UserAgent = "custom"
+	opts.GetMobileEmulation().DeviceName = "iPhone SE"
+	assert.Equal(t, map[string]interface{}{"deviceName": "Pixel 7"}, mobileEmulation(t, opts))
+
+	opts.ClearMobileEmulation()
+	assert.Nil(t, opts.GetMobileEmulation())
+	assert.NotContains(t, browserOptions(t, opts), chrome.MobileEmulationKey)
+}
+
+func TestDevicePresets(t *testing.T) {
+	t.Parallel()
+
+	for _, device := range chrome.Devices {
+		t.Run(device.Name, func(t *testing.T) {
+			t.Parallel()
+
+			found, ok := chrome.LookupDevice(device.Name)
+			require.True(t, ok)
+			assert.Equal(t, device, found)
+			assert.NotEmpty(t, device.UserAgent)
+			assert.Positive(t, device.Metrics.Width)
+			assert.Positive(t, device.Metrics.Height)
+			require.NoError(t, device.MobileEmulation().Validate())
+		})
+	}
+
+	_, ok := chrome.LookupDevice("Nokia 3310")
+	assert.False(t, ok)
+}
+
+func TestDeviceCapabilities(t *testing.T) {
+	t.Parallel()
+
+	opts := chrome.NewOptions()
+	require.NoError(t, opts.SetMobileEmulation(chrome.Pixel7.MobileEmulation()))
+
+	assert.Equal(t, map[string]interface{}{
+		"deviceMetrics": map[string]interface{}{
+			"width":      412,
+			"height":     915,
+			"pixelRatio": 2.625,
+			"touch":      true,
+			"mobile":     true,
+		},
+		"userAgent": chrome.Pixel7.UserAgent,
+		"clientHints": map[string]interface{}{
+			"platform": "Android",
+			"mobile":   true,
+		},
+	}, mobileEmulation(t, opts))
+
+	// Presets are copied, so editing the settings does not change the preset
+	opts.GetMobileEmulation().DeviceMetrics.Width = 1
+	assert.Equal(t, 412, chrome.Pixel7.Metrics.Width)
+}
+
+func browserOptions(t *testing.T, opts *chrome.Options) map[string]interface{} {
+	t.Helper()
+
+	caps := opts.ToCapabilities()
+	assert.EqualValues(t, "chrome", caps["browserName"])
+
+	browserOptions, ok := caps[chrome.OptionsKey].(map[string]interface{})
+	require.True(t, ok)
+
+	return browserOptions
+}
+
+func mobileEmulation(t *testing.T, opts *chrome.Options) map[string]interface{} {
+	t.Helper()
+
+	emulation, ok := browserOptions(t, opts)[chrome.MobileEmulationKey].(map[string]interface{})
+	require.True(t, ok)
+
+	return emulation
+}
@@ -0,0 +1,29 @@
+package chrome
+
+import (
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/chromium"
+)
+
+// DriverPathEnvKey is the environment variable that may hold the chromedriver path.
+const DriverPathEnvKey = "SE_CHROMEDRIVER"
+
+// Service manages a chromedriver server process.
+type Service struct {
+	*chromium.Service
+}
+
+// NewService creates a new chromedriver service.
+//
+// Example usage:
+//
+//	svc := chrome.NewService("/usr/bin/chromedriver")
+//	svc.LogPath = "chromedriver.log"
+//	svc.LogLevel = chromium.LogLevelDebug
+func NewService(path string, options ...selenium.ServiceOption) *Service {
+	options = append([]selenium.ServiceOption{selenium.WithDriverPathEnvKey(DriverPathEnvKey)}, options...)
+
+	return &Service{
+		Service: chromium.NewService(path, options...),
+	}
+}
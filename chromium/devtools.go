@@ -0,0 +1,114 @@
+package chromium
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/screencast"
+)
+
+// ErrNoDevTools is returned when the session capabilities do not contain a DevTools address.
+var ErrNoDevTools = errors.New("capabilities do not contain a DevTools address")
+
+// DevToolsURL returns the CDP WebSocket URL of the browser.
+//
+// It is read from the se:cdp capability a Grid returns, or resolved from the
+// debuggerAddress chromedriver and msedgedriver return in the vendor options.
+func (d *Driver) DevToolsURL(ctx context.Context) (string, error) {
+	caps := d.GetCapabilities().ToCapabilities()
+
+	if url, ok := caps["se:cdp"].(string); ok && url != "" {
+		return url, nil
+	}
+
+	for key, value := range caps {
+		options, ok := value.(map[string]interface{})
+		if !ok || !strings.HasPrefix(key, d.vendorPrefix+":") {
+			continue
+		}
+
+		if address, ok := options["debuggerAddress"].(string); ok && address != "" {
+			return resolveDevToolsURL(ctx, address)
+		}
+	}
+
+	return "", ErrNoDevTools
+}
+
+// resolveDevToolsURL asks the browser at address for its CDP WebSocket URL.
+func resolveDevToolsURL(ctx context.Context, address string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+"/json/version", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create DevTools request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to query DevTools at %s: %w", address, err)
+	}
+	defer resp.Body.Close()
+
+	var version struct {
+		WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	if version.WebSocketDebuggerURL == "" {
+		return "", fmt.Errorf("%w: no webSocketDebuggerUrl at %s", ErrInvalidResponse, address)
+	}
+
+	return version.WebSocketDebuggerURL, nil
+}
+
+// CDPSession connects to the browser over CDP and attaches to the current window.
+//
+// Closing the session closes its connection.
+func (d *Driver) CDPSession(ctx context.Context) (*bidi.CDPSessionImpl, error) {
+	url, err := d.DevToolsURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Window handles are the CDP target IDs of their pages
+	targetID, err := d.GetWindowHandle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return bidi.DialCDPTarget(ctx, url, targetID)
+}
+
+// StartScreencast records the current window into timestamped frames in dir.
+//
+// Stopping the recorder closes its CDP session. Use KeepOnFailure to keep
+// the frames of failed tests only:
+//
+//	recorder, err := driver.StartScreencast(ctx, filepath.Join("screencasts", t.Name()), screencast.Options{})
+//	...
+//	recorder.KeepOnFailure(t)
+func (d *Driver) StartScreencast(
+	ctx context.Context, dir string, opts screencast.Options,
+) (*screencast.Recorder, error) {
+	session, err := d.CDPSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.CloseSession = true
+
+	recorder, err := screencast.Start(ctx, session, dir, opts)
+	if err != nil {
+		_ = session.Close()
+
+		return nil, err
+	}
+
+	return recorder, nil
+}
@@ -0,0 +1,311 @@
+package chromium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Kcrong/selenium/remote"
+	"github.com/Kcrong/selenium/remote/command"
+	"github.com/Kcrong/selenium/remote/connection"
+)
+
+// Chromium specific commands
+const (
+	ExecuteCDPCommand       command.Command = "executeCdpCommand"
+	GetNetworkConditions    command.Command = "getNetworkConditions"
+	SetNetworkConditions    command.Command = "setNetworkConditions"
+	DeleteNetworkConditions command.Command = "deleteNetworkConditions"
+	SetPermissions          command.Command = "setPermissions"
+	GetSinks                command.Command = "getSinks"
+	SetSinkToUse            command.Command = "setSinkToUse"
+	StartDesktopMirroring   command.Command = "startDesktopMirroring"
+	StartTabMirroring       command.Command = "startTabMirroring"
+	StopCasting             command.Command = "stopCasting"
+	GetIssueMessage         command.Command = "getIssueMessage"
+	GetLog                  command.Command = "getLog"
+	GetAvailableLogTypes    command.Command = "getAvailableLogTypes"
+)
+
+// Endpoints returns the Chromium specific commands for the given vendor prefix.
+func Endpoints(vendorPrefix string) command.EndPointMapType {
+	vendor := "/session/$sessionId/" + vendorPrefix
+
+	return command.EndPointMapType{
+		ExecuteCDPCommand:       {Method: http.MethodPost, Path: vendor + "/cdp/execute"},
+		GetNetworkConditions:    {Method: http.MethodGet, Path: "/session/$sessionId/chromium/network_conditions"},
+		SetNetworkConditions:    {Method: http.MethodPost, Path: "/session/$sessionId/chromium/network_conditions"},
+		DeleteNetworkConditions: {Method: http.MethodDelete, Path: "/session/$sessionId/chromium/network_conditions"},
+		SetPermissions:          {Method: http.MethodPost, Path: "/session/$sessionId/permissions"},
+		GetSinks:                {Method: http.MethodGet, Path: vendor + "/cast/get_sinks"},
+		SetSinkToUse:            {Method: http.MethodPost, Path: vendor + "/cast/set_sink_to_use"},
+		StartDesktopMirroring:   {Method: http.MethodPost, Path: vendor + "/cast/start_desktop_mirroring"},
+		StartTabMirroring:       {Method: http.MethodPost, Path: vendor + "/cast/start_tab_mirroring"},
+		StopCasting:             {Method: http.MethodPost, Path: vendor + "/cast/stop_casting"},
+		GetIssueMessage:         {Method: http.MethodGet, Path: vendor + "/cast/get_issue_message"},
+		GetLog:                  {Method: http.MethodPost, Path: "/session/$sessionId/se/log"},
+		GetAvailableLogTypes:    {Method: http.MethodGet, Path: "/session/$sessionId/se/log/types"},
+	}
+}
+
+// ErrInvalidResponse is returned when the driver returns a value of an unexpected shape.
+var ErrInvalidResponse = errors.New("invalid response")
+
+// NetworkConditions describes the emulated network.
+type NetworkConditions struct {
+	// Latency is the additional round trip latency.
+	Latency time.Duration
+	// DownloadThroughput is the maximal download throughput in bytes per second.
+	DownloadThroughput int
+	// UploadThroughput is the maximal upload throughput in bytes per second.
+	UploadThroughput int
+	// Offline emulates a disconnected network.
+	Offline bool
+}
+
+// networkConditions is the wire format of NetworkConditions.
+type networkConditions struct {
+	Latency            int64 `json:"latency"`
+	DownloadThroughput int   `json:"download_throughput"`
+	UploadThroughput   int   `json:"upload_throughput"`
+	Offline            bool  `json:"offline"`
+}
+
+// PermissionState is the state a permission can be set to.
+type PermissionState string
+
+const (
+	// PermissionGranted grants the permission.
+	PermissionGranted PermissionState = "granted"
+	// PermissionDenied denies the permission.
+	PermissionDenied PermissionState = "denied"
+	// PermissionPrompt asks the user for the permission.
+	PermissionPrompt PermissionState = "prompt"
+)
+
+// CastSink is a Cast device that can be used for mirroring.
+type CastSink struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Session string `json:"session"`
+}
+
+// LogEntry is a single entry of a browser log, e.g. the "browser" or "performance" log.
+type LogEntry struct {
+	Level     string `json:"level"`
+	Message   string `json:"message"`
+	Source    string `json:"source,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Time returns the time the entry was logged at.
+func (e *LogEntry) Time() time.Time {
+	return time.UnixMilli(e.Timestamp)
+}
+
+// Driver extends remote.WebDriver with chromedriver and msedgedriver specific commands.
+type Driver struct {
+	*remote.WebDriver
+	vendorPrefix string
+}
+
+// NewDriverFromRemote wraps an existing session and registers the Chromium commands on its connection.
+func NewDriverFromRemote(driver *remote.WebDriver, vendorPrefix string) *Driver {
+	conn := driver.Connection()
+	for cmd, endpoint := range Endpoints(vendorPrefix) {
+		conn.AddCommand(cmd, endpoint.Method, endpoint.Path)
+	}
+
+	return &Driver{
+		WebDriver:    driver,
+		vendorPrefix: vendorPrefix,
+	}
+}
+
+// GetVendorPrefix returns the vendor prefix used for vendor commands.
+func (d *Driver) GetVendorPrefix() string {
+	return d.vendorPrefix
+}
+
+// ExecuteCDPCommand executes a Chrome DevTools Protocol command and returns its result.
+//
+// Example usage:
+//
+//	result, err := driver.ExecuteCDPCommand(ctx, "Browser.getVersion", nil)
+func (d *Driver) ExecuteCDPCommand(
+	ctx context.Context, cmd string, params map[string]interface{},
+) (map[string]interface{}, error) {
+	if params == nil {
+		params = make(map[string]interface{})
+	}
+
+	response, err := d.Execute(ctx, ExecuteCDPCommand, map[string]interface{}{
+		"cmd":    cmd,
+		"params": params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response["value"] == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	if result, ok := response["value"].(map[string]interface{}); ok {
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, response)
+}
+
+// GetNetworkConditions returns the emulated network conditions.
+func (d *Driver) GetNetworkConditions(ctx context.Context) (*NetworkConditions, error) {
+	response, err := d.Execute(ctx, GetNetworkConditions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions networkConditions
+	if err := decodeValue(response, &conditions); err != nil {
+		return nil, err
+	}
+
+	return &NetworkConditions{
+		Latency:            time.Duration(conditions.Latency) * time.Millisecond,
+		DownloadThroughput: conditions.DownloadThroughput,
+		UploadThroughput:   conditions.UploadThroughput,
+		Offline:            conditions.Offline,
+	}, nil
+}
+
+// SetNetworkConditions emulates the given network conditions.
+func (d *Driver) SetNetworkConditions(ctx context.Context, conditions *NetworkConditions) error {
+	_, err := d.Execute(ctx, SetNetworkConditions, map[string]interface{}{
+		"network_conditions": networkConditions{
+			Latency:            conditions.Latency.Milliseconds(),
+			DownloadThroughput: conditions.DownloadThroughput,
+			UploadThroughput:   conditions.UploadThroughput,
+			Offline:            conditions.Offline,
+		},
+	})
+
+	return err
+}
+
+// DeleteNetworkConditions stops network emulation.
+func (d *Driver) DeleteNetworkConditions(ctx context.Context) error {
+	_, err := d.Execute(ctx, DeleteNetworkConditions, nil)
+
+	return err
+}
+
+// SetPermissions sets the state of a permission, e.g. "geolocation" or "clipboard-read".
+func (d *Driver) SetPermissions(ctx context.Context, name string, state PermissionState) error {
+	_, err := d.Execute(ctx, SetPermissions, map[string]interface{}{
+		"descriptor": map[string]interface{}{
+			"name": name,
+		},
+		"state": string(state),
+	})
+
+	return err
+}
+
+// GetSinks returns the Cast sinks available for mirroring.
+func (d *Driver) GetSinks(ctx context.Context) ([]CastSink, error) {
+	response, err := d.Execute(ctx, GetSinks, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sinks []CastSink
+	if err := decodeValue(response, &sinks); err != nil {
+		return nil, err
+	}
+
+	return sinks, nil
+}
+
+// SetSinkToUse selects the Cast sink to use for mirroring.
+func (d *Driver) SetSinkToUse(ctx context.Context, sinkName string) error {
+	return d.executeCast(ctx, SetSinkToUse, sinkName)
+}
+
+// StartDesktopMirroring starts mirroring the desktop to the given sink.
+func (d *Driver) StartDesktopMirroring(ctx context.Context, sinkName string) error {
+	return d.executeCast(ctx, StartDesktopMirroring, sinkName)
+}
+
+// StartTabMirroring starts mirroring the current tab to the given sink.
+func (d *Driver) StartTabMirroring(ctx context.Context, sinkName string) error {
+	return d.executeCast(ctx, StartTabMirroring, sinkName)
+}
+
+// StopCasting stops mirroring to the given sink.
+func (d *Driver) StopCasting(ctx context.Context, sinkName string) error {
+	return d.executeCast(ctx, StopCasting, sinkName)
+}
+
+// GetIssueMessage returns the error message of the last Cast issue, if any.
+func (d *Driver) GetIssueMessage(ctx context.Context) (string, error) {
+	response, err := d.Execute(ctx, GetIssueMessage, nil)
+	if err != nil {
+		return "", err
+	}
+
+	message, _ := response["value"].(string)
+
+	return message, nil
+}
+
+// GetLog returns and clears the entries of the given log, e.g. "browser" or "performance".
+func (d *Driver) GetLog(ctx context.Context, logType string) ([]LogEntry, error) {
+	response, err := d.Execute(ctx, GetLog, map[string]interface{}{
+		"type": logType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []LogEntry
+	if err := decodeValue(response, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetLogTypes returns the available log types.
+func (d *Driver) GetLogTypes(ctx context.Context) ([]string, error) {
+	response, err := d.Execute(ctx, GetAvailableLogTypes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var logTypes []string
+	if err := decodeValue(response, &logTypes); err != nil {
+		return nil, err
+	}
+
+	return logTypes, nil
+}
+
+// executeCast executes a Cast command addressed to a sink.
+func (d *Driver) executeCast(ctx context.Context, cmd command.Command, sinkName string) error {
+	_, err := d.Execute(ctx, cmd, map[string]interface{}{
+		"sinkName": sinkName,
+	})
+
+	return err
+}
+
+// decodeValue decodes the "value" of a response into out.
+func decodeValue(response map[string]interface{}, out interface{}) error {
+	if err := connection.DecodeValue(response, out); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	return nil
+}
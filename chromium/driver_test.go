@@ -0,0 +1,163 @@
+package chromium_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/chromium"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote"
+)
+
+func newDriver(t *testing.T, vendorPrefix string) (*chromium.Driver, *remotetest.Server) {
+	t.Helper()
+
+	server := remotetest.NewServer(t)
+
+	driver, err := remote.New(context.Background(), server.Conn(t), selenium.RawConvertible{"browserName": "chrome"})
+	require.NoError(t, err)
+
+	return chromium.NewDriverFromRemote(driver, vendorPrefix), server
+}
+
+func TestExecuteCDPCommand(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	driver, server := newDriver(t, "ms")
+	server.Handle("POST /session/{id}/ms/cdp/execute", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, map[string]interface{}{"product": "Edg/120.0"})
+	})
+
+	result, err := driver.ExecuteCDPCommand(ctx, "Browser.getVersion", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"product": "Edg/120.0"}, result)
+
+	requests := server.Requests(http.MethodPost, "/session/s1/ms/cdp/execute")
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]interface{}{
+		"cmd": "Browser.getVersion", "params": map[string]interface{}{},
+	}, requests[0].Body)
+}
+
+func TestNetworkConditions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	driver, server := newDriver(t, "goog")
+	server.Handle("GET /session/{id}/chromium/network_conditions", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, map[string]interface{}{
+			"latency": 150, "download_throughput": 1000, "upload_throughput": 500, "offline": false,
+		})
+	})
+
+	require.NoError(t, driver.SetNetworkConditions(ctx, &chromium.NetworkConditions{
+		Latency: 150 * time.Millisecond, DownloadThroughput: 1000, UploadThroughput: 500, Offline: true,
+	}))
+
+	requests := server.Requests(http.MethodPost, "/session/s1/chromium/network_conditions")
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]interface{}{
+		"network_conditions": map[string]interface{}{
+			"latency": 150.0, "download_throughput": 1000.0, "upload_throughput": 500.0, "offline": true,
+		},
+	}, requests[0].Body)
+
+	conditions, err := driver.GetNetworkConditions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &chromium.NetworkConditions{
+		Latency: 150 * time.Millisecond, DownloadThroughput: 1000, UploadThroughput: 500, Offline: false,
+	}, conditions)
+
+	require.NoError(t, driver.DeleteNetworkConditions(ctx))
+	assert.Len(t, server.Requests(http.MethodDelete, "/session/s1/chromium/network_conditions"), 1)
+}
+
+func TestSetPermissions(t *testing.T) {
+	t.Parallel()
+
+	driver, server := newDriver(t, "goog")
+	require.NoError(t, driver.SetPermissions(context.Background(), "geolocation", chromium.PermissionGranted))
+
+	requests := server.Requests(http.MethodPost, "/session/s1/permissions")
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]interface{}{
+		"descriptor": map[string]interface{}{"name": "geolocation"}, "state": "granted",
+	}, requests[0].Body)
+}
+
+func TestCast(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	driver, server := newDriver(t, "goog")
+	server.Handle("GET /session/{id}/goog/cast/get_sinks", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, []map[string]interface{}{{"id": "1", "name": "Living Room", "session": ""}})
+	})
+	server.Handle("GET /session/{id}/goog/cast/get_issue_message", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, "sink unavailable")
+	})
+
+	sinks, err := driver.GetSinks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []chromium.CastSink{{ID: "1", Name: "Living Room", Session: ""}}, sinks)
+
+	require.NoError(t, driver.SetSinkToUse(ctx, "Living Room"))
+	require.NoError(t, driver.StartTabMirroring(ctx, "Living Room"))
+	require.NoError(t, driver.StartDesktopMirroring(ctx, "Living Room"))
+	require.NoError(t, driver.StopCasting(ctx, "Living Room"))
+
+	for _, path := range []string{"set_sink_to_use", "start_tab_mirroring", "start_desktop_mirroring", "stop_casting"} {
+		requests := server.Requests(http.MethodPost, "/session/s1/goog/cast/"+path)
+		require.Len(t, requests, 1, path)
+		assert.Equal(t, map[string]interface{}{"sinkName": "Living Room"}, requests[0].Body)
+	}
+
+	message, err := driver.GetIssueMessage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "sink unavailable", message)
+}
+
+func TestGetLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	driver, server := newDriver(t, "goog")
+	server.Handle("POST /session/{id}/se/log", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, []map[string]interface{}{
+			{"level": "SEVERE", "message": "boom", "source": "javascript", "timestamp": 1700000000000},
+		})
+	})
+	server.Handle("GET /session/{id}/se/log/types", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, []string{"browser", "performance"})
+	})
+
+	entries, err := driver.GetLog(ctx, "browser")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "boom", entries[0].Message)
+	assert.Equal(t, time.UnixMilli(1700000000000), entries[0].Time())
+
+	requests := server.Requests(http.MethodPost, "/session/s1/se/log")
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]interface{}{"type": "browser"}, requests[0].Body)
+
+	types, err := driver.GetLogTypes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"browser", "performance"}, types)
+
+	// A value of the wrong shape is reported as an invalid response
+	broken, server := newDriver(t, "goog")
+	server.Handle("GET /session/{id}/se/log/types", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, "browser")
+	})
+
+	_, err = broken.GetLogTypes(ctx)
+	require.ErrorIs(t, err, chromium.ErrInvalidResponse)
+}
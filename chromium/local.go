@@ -0,0 +1,70 @@
+package chromium
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/remote"
+)
+
+// NewDriver finds the driver, starts the service and creates a new session.
+//
+// The driver path is taken from the service when set, otherwise it is
+// resolved with a DriverFinder. Nil options or service use the Chrome
+// defaults. Quit on the returned driver also stops the service.
+func NewDriver(ctx context.Context, opts *Options, svc *Service) (*Driver, error) {
+	if opts == nil {
+		opts = NewOptions()
+	}
+
+	if svc == nil {
+		svc = NewService("")
+	}
+
+	resolved, err := resolvePaths(ctx, opts, svc.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := remote.NewLocal(ctx, svc.Service, resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDriverFromRemote(driver, opts.GetVendorPrefix()), nil
+}
+
+// resolvePaths sets the driver path of the service and returns a copy of
+// opts with the browser binary location resolved if it is unset.
+//
+// The caller's options are left untouched so that they can be reused.
+func resolvePaths(ctx context.Context, opts *Options, svc *selenium.Service) (*Options, error) {
+	base := selenium.NewCapabilities()
+	base.Capabilities.BrowserName = opts.browserName
+
+	if opts.binaryLocation != "" {
+		base.SetBrowserOptions(opts.optionsKey, map[string]interface{}{"binary": opts.binaryLocation})
+	}
+
+	finder := selenium.NewDriverFinder(svc, base)
+
+	driverPath, err := finder.GetDriverPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.Path = driverPath
+
+	resolved := *opts
+
+	if resolved.binaryLocation == "" {
+		browserPath, err := finder.GetBrowserPath(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved.binaryLocation = browserPath
+	}
+
+	return &resolved, nil
+}
@@ -0,0 +1,38 @@
+package chromium
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePathsCopiesOptions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake binaries are shell scripts")
+	}
+
+	dir := t.TempDir()
+	for name, output := range map[string]string{"chromedriver": "ChromeDriver 120.0.6099.109", "google-chrome": "Google Chrome 120.0.6099.129"} {
+		script := []byte("#!/bin/sh\necho '" + output + "'\n")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), script, 0o700))
+	}
+
+	t.Setenv("PATH", dir)
+	t.Setenv("SE_CHROMEDRIVER", "")
+
+	opts := NewOptions()
+	svc := NewService("")
+
+	resolved, err := resolvePaths(context.Background(), opts, svc.Service)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "chromedriver"), svc.Path)
+	assert.Equal(t, filepath.Join(dir, "google-chrome"), resolved.GetBinaryLocation())
+
+	// The caller's options are not modified
+	assert.Empty(t, opts.GetBinaryLocation())
+}
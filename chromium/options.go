@@ -15,33 +15,88 @@ const (
 	OptionsKey = "goog:chromeOptions"
 	// BrowserName is the name of the Chrome browser.
 	BrowserName = "chrome"
+	// VendorPrefix is the vendor prefix used by Google Chrome.
+	VendorPrefix = "goog"
 )
 
 // ErrEmptyExtension is returned when an empty extension is provided.
 var ErrEmptyExtension = errors.New("encoded extension cannot be empty")
 
 // Options contains the base options for Chromium-based browsers.
+//
+// Browser packages such as chrome and edge embed Options and only differ in
+// the vendor prefix, the browser name and the capability key they emit.
 type Options struct {
+	experimentalOpts map[string]interface{}
+	prefs            map[string]interface{}
+	localState       map[string]interface{}
+	perfLoggingPrefs map[string]interface{}
+	vendorPrefix     string
+	optionsKey       string
+	browserName      selenium.BrowserType
 	binaryLocation   string
+	debuggerAddress  string
+	minidumpPath     string
+	androidPackage   string
+	androidActivity  string
+	androidSerial    string
 	extensionFiles   []string
 	extensions       []string
-	experimentalOpts map[string]interface{}
-	debuggerAddress  string
 	arguments        []string
+	excludeSwitches  []string
+	windowTypes      []string
+	detach           bool
+	enableBiDi       bool
 }
 
-// NewOptions creates a new Chromium options instance.
+// NewOptions creates a new Chromium options instance for Google Chrome.
 func NewOptions() *Options {
+	return NewVendorOptions(BrowserName, VendorPrefix, OptionsKey)
+}
+
+// NewVendorOptions creates a new Chromium options instance for the given vendor.
+//
+// Example usage:
+//
+//	opts := NewVendorOptions("MicrosoftEdge", "ms", "ms:edgeOptions")
+func NewVendorOptions(browserName selenium.BrowserType, vendorPrefix, optionsKey string) *Options {
+	//nolint:exhaustruct // Initialize required fields only for better readability.
 	return &Options{
-		binaryLocation:   "",
+		experimentalOpts: make(map[string]interface{}),
+		prefs:            make(map[string]interface{}),
+		localState:       make(map[string]interface{}),
+		perfLoggingPrefs: make(map[string]interface{}),
+		vendorPrefix:     vendorPrefix,
+		optionsKey:       optionsKey,
+		browserName:      browserName,
 		extensionFiles:   make([]string, 0),
 		extensions:       make([]string, 0),
-		experimentalOpts: make(map[string]interface{}),
-		debuggerAddress:  "",
 		arguments:        make([]string, 0),
+		excludeSwitches:  make([]string, 0),
+		windowTypes:      make([]string, 0),
 	}
 }
 
+// GetVendorPrefix returns the vendor prefix, e.g. "goog" or "ms".
+func (o *Options) GetVendorPrefix() string {
+	return o.vendorPrefix
+}
+
+// GetOptionsKey returns the capability key the options are emitted under.
+func (o *Options) GetOptionsKey() string {
+	return o.optionsKey
+}
+
+// SetBrowserName sets the browser name reported in the capabilities.
+func (o *Options) SetBrowserName(name selenium.BrowserType) {
+	o.browserName = name
+}
+
+// GetBrowserName returns the browser name reported in the capabilities.
+func (o *Options) GetBrowserName() selenium.BrowserType {
+	return o.browserName
+}
+
 // SetBinaryLocation sets the path to Chromium binary.
 func (o *Options) SetBinaryLocation(path string) {
 	o.binaryLocation = path
@@ -74,7 +129,7 @@ func (o *Options) AddExtension(path string) error {
 	}
 
 	if _, err := os.Stat(absPath); os.IsNotExist(err) {
-		return fmt.Errorf("%w: %s", err, absPath)
+		return fmt.Errorf("%w: %s", os.ErrNotExist, absPath)
 	}
 
 	o.extensionFiles = append(o.extensionFiles, absPath)
@@ -98,14 +153,133 @@ func (o *Options) AddArgument(arg string) {
 	o.arguments = append(o.arguments, arg)
 }
 
+// GetArguments returns the command-line arguments.
+func (o *Options) GetArguments() []string {
+	return o.arguments
+}
+
 // AddExperimentalOption adds an experimental option.
+//
+// Values implementing selenium.Convertible are converted when the capabilities are built.
 func (o *Options) AddExperimentalOption(name string, value interface{}) {
 	o.experimentalOpts[name] = value
 }
 
+// SetPreference sets a user profile preference, e.g. "download.default_directory".
+func (o *Options) SetPreference(name string, value interface{}) {
+	o.prefs[name] = value
+}
+
+// GetPreferences returns the user profile preferences.
+func (o *Options) GetPreferences() map[string]interface{} {
+	return o.prefs
+}
+
+// AddExcludedSwitch excludes a default command-line switch, e.g. "enable-automation".
+func (o *Options) AddExcludedSwitch(name string) {
+	o.excludeSwitches = append(o.excludeSwitches, name)
+}
+
+// GetExcludedSwitches returns the excluded command-line switches.
+func (o *Options) GetExcludedSwitches() []string {
+	return o.excludeSwitches
+}
+
+// SetLocalState sets a Local State preference.
+func (o *Options) SetLocalState(name string, value interface{}) {
+	o.localState[name] = value
+}
+
+// GetLocalState returns the Local State preferences.
+func (o *Options) GetLocalState() map[string]interface{} {
+	return o.localState
+}
+
+// SetMinidumpPath sets the directory to store crash minidumps in (Linux only).
+func (o *Options) SetMinidumpPath(path string) {
+	o.minidumpPath = path
+}
+
+// GetMinidumpPath returns the directory crash minidumps are stored in.
+func (o *Options) GetMinidumpPath() string {
+	return o.minidumpPath
+}
+
+// SetPerfLoggingPref sets a performance logging preference, e.g. "enableNetwork".
+func (o *Options) SetPerfLoggingPref(name string, value interface{}) {
+	o.perfLoggingPrefs[name] = value
+}
+
+// GetPerfLoggingPrefs returns the performance logging preferences.
+func (o *Options) GetPerfLoggingPrefs() map[string]interface{} {
+	return o.perfLoggingPrefs
+}
+
+// AddWindowType adds a window type that will appear in the window handles list, e.g. "webview".
+func (o *Options) AddWindowType(windowType string) {
+	o.windowTypes = append(o.windowTypes, windowType)
+}
+
+// GetWindowTypes returns the additional window types.
+func (o *Options) GetWindowTypes() []string {
+	return o.windowTypes
+}
+
+// SetDetach sets whether the browser should keep running after the driver quits.
+func (o *Options) SetDetach(detach bool) {
+	o.detach = detach
+}
+
+// GetDetach returns whether the browser keeps running after the driver quits.
+func (o *Options) GetDetach() bool {
+	return o.detach
+}
+
+// SetEnableBiDi requests a WebDriver BiDi WebSocket URL for the session.
+//
+// The URL is returned in the session capabilities and used by bidi.ConnectFromCapabilities.
+func (o *Options) SetEnableBiDi(enable bool) {
+	o.enableBiDi = enable
+}
+
+// GetEnableBiDi reports whether a WebDriver BiDi WebSocket URL is requested.
+func (o *Options) GetEnableBiDi() bool {
+	return o.enableBiDi
+}
+
+// SetAndroidPackage sets the package name of the browser app on Android.
+func (o *Options) SetAndroidPackage(pkg string) {
+	o.androidPackage = pkg
+}
+
+// GetAndroidPackage returns the package name of the browser app on Android.
+func (o *Options) GetAndroidPackage() string {
+	return o.androidPackage
+}
+
+// SetAndroidActivity sets the activity name of the browser app on Android.
+func (o *Options) SetAndroidActivity(activity string) {
+	o.androidActivity = activity
+}
+
+// GetAndroidActivity returns the activity name of the browser app on Android.
+func (o *Options) GetAndroidActivity() string {
+	return o.androidActivity
+}
+
+// SetAndroidDeviceSerial sets the serial of the Android device to launch the browser on.
+func (o *Options) SetAndroidDeviceSerial(serial string) {
+	o.androidSerial = serial
+}
+
+// GetAndroidDeviceSerial returns the serial of the Android device.
+func (o *Options) GetAndroidDeviceSerial() string {
+	return o.androidSerial
+}
+
 // getEncodedExtensions returns a list of encoded extensions.
 func (o *Options) getEncodedExtensions() ([]string, error) {
-	encodedExts := make([]string, 0)
+	encodedExts := make([]string, 0, len(o.extensions)+len(o.extensionFiles))
 
 	// Add pre-encoded extensions
 	encodedExts = append(encodedExts, o.extensions...)
@@ -124,39 +298,93 @@ func (o *Options) getEncodedExtensions() ([]string, error) {
 	return encodedExts, nil
 }
 
-// ToCapabilities converts the options to a capabilities map.
-func (o *Options) ToCapabilities() map[string]interface{} {
-	caps := selenium.NewCapabilities()
-	caps.Capabilities.BrowserName = BrowserName
-
-	chromeOptions := make(map[string]interface{})
+// BrowserOptions returns the vendor specific options map, e.g. the value of goog:chromeOptions.
+//
+//nolint:cyclop // Each option is checked independently.
+func (o *Options) BrowserOptions() map[string]interface{} {
+	browserOptions := make(map[string]interface{})
 
-	// Add experimental options
+	// Add experimental options, converting those that build their own capabilities
 	for k, v := range o.experimentalOpts {
-		chromeOptions[k] = v
+		if convertible, ok := v.(selenium.Convertible); ok {
+			v = convertible.ToCapabilities()
+		}
+
+		browserOptions[k] = v
 	}
 
 	// Add extensions
 	if encodedExts, err := o.getEncodedExtensions(); err == nil && len(encodedExts) > 0 {
-		chromeOptions["extensions"] = encodedExts
+		browserOptions["extensions"] = encodedExts
 	}
 
-	// Add binary location if set
 	if o.binaryLocation != "" {
-		chromeOptions["binary"] = o.binaryLocation
+		browserOptions["binary"] = o.binaryLocation
 	}
 
-	// Add arguments if any
 	if len(o.arguments) > 0 {
-		chromeOptions["args"] = o.arguments
+		browserOptions["args"] = o.arguments
 	}
 
-	// Add debugger address if set
 	if o.debuggerAddress != "" {
-		chromeOptions["debuggerAddress"] = o.debuggerAddress
+		browserOptions["debuggerAddress"] = o.debuggerAddress
+	}
+
+	if len(o.prefs) > 0 {
+		browserOptions["prefs"] = o.prefs
+	}
+
+	if len(o.excludeSwitches) > 0 {
+		browserOptions["excludeSwitches"] = o.excludeSwitches
 	}
 
-	caps.SetBrowserOptions(OptionsKey, chromeOptions)
+	if len(o.localState) > 0 {
+		browserOptions["localState"] = o.localState
+	}
+
+	if o.minidumpPath != "" {
+		browserOptions["minidumpPath"] = o.minidumpPath
+	}
+
+	if len(o.perfLoggingPrefs) > 0 {
+		browserOptions["perfLoggingPrefs"] = o.perfLoggingPrefs
+	}
+
+	if len(o.windowTypes) > 0 {
+		browserOptions["windowTypes"] = o.windowTypes
+	}
+
+	if o.detach {
+		browserOptions["detach"] = true
+	}
+
+	o.addAndroidOptions(browserOptions)
+
+	return browserOptions
+}
+
+// addAndroidOptions adds the Android specific options to the browser options.
+func (o *Options) addAndroidOptions(browserOptions map[string]interface{}) {
+	if o.androidPackage != "" {
+		browserOptions["androidPackage"] = o.androidPackage
+	}
+
+	if o.androidActivity != "" {
+		browserOptions["androidActivity"] = o.androidActivity
+	}
+
+	if o.androidSerial != "" {
+		browserOptions["androidDeviceSerial"] = o.androidSerial
+	}
+}
+
+// ToCapabilities converts the options to a capabilities map.
+func (o *Options) ToCapabilities() map[string]interface{} {
+	caps := selenium.NewCapabilities()
+	caps.Capabilities.BrowserName = o.browserName
+	caps.Capabilities.EnableBiDi = o.enableBiDi
+
+	caps.SetBrowserOptions(o.optionsKey, o.BrowserOptions())
 
 	return caps.ToCapabilities()
 }
@@ -171,10 +399,10 @@ func (o *Options) GetExperimentalOptions() map[string]interface{} {
 	return o.experimentalOpts
 }
 
-// DefaultCapabilities returns the default capabilities for Chromium.
+// DefaultCapabilities returns the default capabilities for the browser.
 func (o *Options) DefaultCapabilities() map[string]interface{} {
 	caps := selenium.NewCapabilities()
-	caps.Capabilities.BrowserName = BrowserName
+	caps.Capabilities.BrowserName = o.browserName
 
 	return caps.ToCapabilities()
 }
@@ -0,0 +1,59 @@
+package chromium_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/chromium"
+)
+
+func TestOptionsToCapabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		opts        *chromium.Options
+		name        string
+		optionsKey  string
+		browserName selenium.BrowserType
+	}{
+		{
+			name:        "Chrome",
+			opts:        chromium.NewOptions(),
+			optionsKey:  "goog:chromeOptions",
+			browserName: "chrome",
+		},
+		{
+			name:        "Edge",
+			opts:        chromium.NewVendorOptions("MicrosoftEdge", "ms", "ms:edgeOptions"),
+			optionsKey:  "ms:edgeOptions",
+			browserName: "MicrosoftEdge",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.opts.AddArgument("--headless=new")
+			tt.opts.SetPreference("download.default_directory", "/tmp")
+			tt.opts.AddExcludedSwitch("enable-automation")
+			tt.opts.SetDetach(true)
+			tt.opts.SetAndroidPackage("com.android.chrome")
+
+			caps := tt.opts.ToCapabilities()
+			assert.Equal(t, tt.browserName, caps["browserName"])
+
+			browserOptions, ok := caps[tt.optionsKey].(map[string]interface{})
+			require.True(t, ok)
+			assert.Equal(t, []string{"--headless=new"}, browserOptions["args"])
+			assert.Equal(t, map[string]interface{}{"download.default_directory": "/tmp"}, browserOptions["prefs"])
+			assert.Equal(t, []string{"enable-automation"}, browserOptions["excludeSwitches"])
+			assert.Equal(t, true, browserOptions["detach"])
+			assert.Equal(t, "com.android.chrome", browserOptions["androidPackage"])
+			assert.NotContains(t, browserOptions, "localState")
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package chromium
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Kcrong/selenium"
+)
+
+// LogLevel is the verbosity of chromedriver and msedgedriver logs.
+type LogLevel string
+
+const (
+	// LogLevelAll logs everything.
+	LogLevelAll LogLevel = "ALL"
+	// LogLevelDebug logs debug messages and above.
+	LogLevelDebug LogLevel = "DEBUG"
+	// LogLevelInfo logs informational messages and above.
+	LogLevelInfo LogLevel = "INFO"
+	// LogLevelWarning logs warnings and above.
+	LogLevelWarning LogLevel = "WARNING"
+	// LogLevelSevere logs errors only.
+	LogLevelSevere LogLevel = "SEVERE"
+	// LogLevelOff disables logging.
+	LogLevelOff LogLevel = "OFF"
+)
+
+// Service manages a chromedriver or msedgedriver server process.
+type Service struct {
+	*selenium.Service
+	// LogPath is the file the driver writes its log to.
+	LogPath string
+	// LogLevel is the verbosity of the driver log.
+	LogLevel LogLevel
+	// AllowedIPs lists the remote IPs allowed to connect; local connections are always allowed.
+	AllowedIPs []string
+	// AllowedOrigins lists the request origins allowed to connect.
+	AllowedOrigins []string
+	// ExtraArgs are additional command line arguments passed verbatim.
+	ExtraArgs []string
+	// AppendLog appends to the log file instead of truncating it.
+	AppendLog bool
+	// ReadableTimestamp adds human readable timestamps to the log.
+	ReadableTimestamp bool
+}
+
+var _ selenium.ArgsProvider = (*Service)(nil)
+
+// NewService creates a new chromedriver service.
+func NewService(path string, options ...selenium.ServiceOption) *Service {
+	//nolint:exhaustruct // Optional settings are configured by the user.
+	s := &Service{}
+	// Clone so the provider is not written into the caller's backing array
+	options = append(slices.Clone(options), selenium.WithArgsProvider(s))
+	s.Service = selenium.NewService(path, options...)
+
+	return s
+}
+
+// Args returns the command line arguments to start the driver on the given port.
+func (s *Service) Args(port int) []string {
+	args := []string{fmt.Sprintf("--port=%d", port)}
+
+	if s.LogPath != "" {
+		args = append(args, "--log-path="+s.LogPath)
+	}
+
+	if s.LogLevel != "" {
+		args = append(args, "--log-level="+string(s.LogLevel))
+	}
+
+	if s.AppendLog {
+		args = append(args, "--append-log")
+	}
+
+	if s.ReadableTimestamp {
+		args = append(args, "--readable-timestamp")
+	}
+
+	if len(s.AllowedIPs) > 0 {
+		args = append(args, "--allowed-ips="+strings.Join(s.AllowedIPs, ","))
+	}
+
+	if len(s.AllowedOrigins) > 0 {
+		args = append(args, "--allowed-origins="+strings.Join(s.AllowedOrigins, ","))
+	}
+
+	return append(args, s.ExtraArgs...)
+}
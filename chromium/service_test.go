@@ -0,0 +1,46 @@
+package chromium_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/chromium"
+)
+
+func TestServiceArgs(t *testing.T) {
+	t.Parallel()
+
+	svc := chromium.NewService("/usr/bin/chromedriver")
+	assert.Equal(t, []string{"--port=9515"}, svc.Args(9515))
+
+	svc.LogPath = "chromedriver.log"
+	svc.LogLevel = chromium.LogLevelDebug
+	svc.AppendLog = true
+	svc.ReadableTimestamp = true
+	svc.AllowedIPs = []string{"10.0.0.1", "10.0.0.2"}
+	svc.AllowedOrigins = []string{"http://a.test", "http://b.test"}
+	svc.ExtraArgs = []string{"--verbose"}
+
+	assert.Equal(t, []string{
+		"--port=9515",
+		"--log-path=chromedriver.log",
+		"--log-level=DEBUG",
+		"--append-log",
+		"--readable-timestamp",
+		"--allowed-ips=10.0.0.1,10.0.0.2",
+		"--allowed-origins=http://a.test,http://b.test",
+		"--verbose",
+	}, svc.Args(9515))
+}
+
+func TestNewServiceKeepsOptions(t *testing.T) {
+	t.Parallel()
+
+	backing := make([]selenium.ServiceOption, 2)
+	backing[0] = selenium.WithPort(9515)
+
+	chromium.NewService("/usr/bin/chromedriver", backing[:1]...)
+	assert.Nil(t, backing[1])
+}
@@ -1,17 +1,31 @@
 package selenium
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 )
 
+// ResolutionStrategy selects how a DriverFinder resolves driver and browser paths
+type ResolutionStrategy int
+
+const (
+	// ResolveAuto looks for a matching local driver first and falls back to Selenium Manager
+	ResolveAuto ResolutionStrategy = iota
+	// ResolveLocal only uses environment variables, PATH and well-known locations
+	ResolveLocal
+	// ResolveManager only uses Selenium Manager
+	ResolveManager
+)
+
 // DriverFinder is responsible for obtaining the correct driver and associated browser
 type DriverFinder struct {
-	service *Service
-	options *BaseOptions
-	paths   map[string]string
-	manager *SeleniumManager
+	service  *Service
+	options  *BaseOptions
+	paths    map[string]string
+	manager  *SeleniumManager
+	strategy ResolutionStrategy
 }
 
 // NewDriverFinder creates a new DriverFinder instance
@@ -23,13 +37,24 @@ func NewDriverFinder(service *Service, options *BaseOptions) *DriverFinder {
 			"driver_path":  "",
 			"browser_path": "",
 		},
-		manager: NewSeleniumManager(),
+		manager:  NewSeleniumManager(),
+		strategy: ResolveAuto,
 	}
 }
 
+// SetResolutionStrategy sets how the driver and browser paths are resolved
+func (d *DriverFinder) SetResolutionStrategy(strategy ResolutionStrategy) {
+	d.strategy = strategy
+}
+
+// SetSeleniumManager sets the Selenium Manager used to resolve the paths
+func (d *DriverFinder) SetSeleniumManager(manager *SeleniumManager) {
+	d.manager = manager
+}
+
 // GetBrowserPath returns the path to the browser binary
-func (d *DriverFinder) GetBrowserPath() (string, error) {
-	paths, err := d.getBinaryPaths()
+func (d *DriverFinder) GetBrowserPath(ctx context.Context) (string, error) {
+	paths, err := d.getBinaryPaths(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -37,8 +62,8 @@ func (d *DriverFinder) GetBrowserPath() (string, error) {
 }
 
 // GetDriverPath returns the path to the driver binary
-func (d *DriverFinder) GetDriverPath() (string, error) {
-	paths, err := d.getBinaryPaths()
+func (d *DriverFinder) GetDriverPath(ctx context.Context) (string, error) {
+	paths, err := d.getBinaryPaths(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -48,7 +73,7 @@ func (d *DriverFinder) GetDriverPath() (string, error) {
 var ErrBrowserCapabilityNotFound = errors.New("browserName capability not found")
 
 // getBinaryPaths returns the paths to both the driver and browser binaries
-func (d *DriverFinder) getBinaryPaths() (map[string]string, error) {
+func (d *DriverFinder) getBinaryPaths(ctx context.Context) (map[string]string, error) {
 	if d.paths["driver_path"] != "" {
 		return d.paths, nil
 	}
@@ -67,32 +92,65 @@ func (d *DriverFinder) getBinaryPaths() (map[string]string, error) {
 		return d.paths, nil
 	}
 
+	var localErr error
+
+	if d.strategy != ResolveManager {
+		result, err := FindLocalDriver(ctx, browser, d.browserLocation())
+		if err == nil {
+			return d.setPaths(result)
+		}
+
+		if d.strategy == ResolveLocal {
+			return nil, err
+		}
+
+		localErr = err
+	}
+
 	// Use Selenium Manager to find paths
 	args := d.toArgs()
 	output, err := d.manager.BinaryPaths(args)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", err, args)
+		return nil, errors.Join(localErr, fmt.Errorf("%w: %s", err, args))
 	}
 
+	return d.setPaths(output)
+}
+
+// setPaths validates and stores the resolved paths
+func (d *DriverFinder) setPaths(result *ManagerResult) (map[string]string, error) {
 	// Validate driver path
-	if driverPath, ok := output["driver_path"]; ok && driverPath != "" {
-		if _, err := os.Stat(driverPath); err != nil {
-			return nil, fmt.Errorf("%w: %s", err, driverPath)
+	if result.DriverPath != "" {
+		if _, err := os.Stat(result.DriverPath); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, result.DriverPath)
 		}
-		d.paths["driver_path"] = driverPath
+		d.paths["driver_path"] = result.DriverPath
 	}
 
 	// Validate browser path if provided
-	if browserPath, ok := output["browser_path"]; ok && browserPath != "" {
-		if _, err := os.Stat(browserPath); err != nil {
-			return nil, fmt.Errorf("%w: %s", err, browserPath)
+	if result.BrowserPath != "" {
+		if _, err := os.Stat(result.BrowserPath); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, result.BrowserPath)
 		}
-		d.paths["browser_path"] = browserPath
+		d.paths["browser_path"] = result.BrowserPath
 	}
 
 	return d.paths, nil
 }
 
+// browserLocation returns the browser binary set in the browser options, if any
+func (d *DriverFinder) browserLocation() string {
+	for _, browserOptions := range d.options.Capabilities.BrowserOptions {
+		if opts, ok := browserOptions.(map[string]interface{}); ok {
+			if binaryLocation, ok := opts["binary"].(string); ok && binaryLocation != "" {
+				return binaryLocation
+			}
+		}
+	}
+
+	return ""
+}
+
 // toArgs converts options to command line arguments for Selenium Manager
 func (d *DriverFinder) toArgs() []string {
 	args := []string{"--browser"}
@@ -106,11 +164,9 @@ func (d *DriverFinder) toArgs() []string {
 		args = append(args, "--browser-version", version)
 	}
 
-	// Check if options has binary location
-	if caps := d.options.ToCapabilities(); caps != nil {
-		if binaryLocation, ok := caps["binary"].(string); ok && binaryLocation != "" {
-			args = append(args, "--browser-path", binaryLocation)
-		}
+	// Check if the browser options have a binary location
+	if binaryLocation := d.browserLocation(); binaryLocation != "" {
+		args = append(args, "--browser-path", binaryLocation)
 	}
 
 	// Handle proxy settings
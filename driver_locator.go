@@ -0,0 +1,204 @@
+package selenium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// versionTimeout bounds how long a --version probe may take
+const versionTimeout = 10 * time.Second
+
+var (
+	// ErrUnsupportedBrowser is returned when no local lookup rules exist for a browser
+	ErrUnsupportedBrowser = errors.New("unsupported browser")
+	// ErrDriverNotFound is returned when no executable driver is found locally
+	ErrDriverNotFound = errors.New("driver not found")
+	// ErrDriverVersionMismatch is returned when the driver and browser major versions differ
+	ErrDriverVersionMismatch = errors.New("driver version does not match browser version")
+)
+
+// versionPattern matches a dotted version number such as 120.0.6099.109
+var versionPattern = regexp.MustCompile(`\d+(?:\.\d+)+`)
+
+// driverSpec describes where to find a driver and its browser
+type driverSpec struct {
+	// driver is the file name of the driver binary
+	driver string
+	// envKey is the environment variable that may hold the driver path
+	envKey string
+	// browsers lists browser binary names looked up in PATH
+	browsers []string
+	// browserPaths lists well-known browser install locations
+	browserPaths []string
+	// matchMajor requires the driver and browser major versions to be equal
+	matchMajor bool
+}
+
+// driverSpecs lists the local lookup rules per browser
+var driverSpecs = map[BrowserType]driverSpec{
+	Chrome: {
+		driver:   "chromedriver",
+		envKey:   "SE_CHROMEDRIVER",
+		browsers: []string{"google-chrome", "google-chrome-stable", "chromium", "chromium-browser"},
+		browserPaths: []string{
+			"/opt/google/chrome/chrome",
+			"/usr/lib/chromium/chromium",
+			"/snap/bin/chromium",
+			"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+		},
+		matchMajor: true,
+	},
+	"MicrosoftEdge": {
+		driver:   "msedgedriver",
+		envKey:   "SE_EDGEDRIVER",
+		browsers: []string{"microsoft-edge", "microsoft-edge-stable"},
+		browserPaths: []string{
+			"/opt/microsoft/msedge/msedge",
+			"/Applications/Microsoft Edge.app/Contents/MacOS/Microsoft Edge",
+		},
+		matchMajor: true,
+	},
+	Firefox: {
+		driver:   "geckodriver",
+		envKey:   "SE_GECKODRIVER",
+		browsers: []string{"firefox", "firefox-esr"},
+		browserPaths: []string{
+			"/usr/lib/firefox/firefox",
+			"/opt/firefox/firefox",
+			"/snap/bin/firefox",
+			"/Applications/Firefox.app/Contents/MacOS/firefox",
+		},
+		// geckodriver is versioned independently of Firefox
+		matchMajor: false,
+	},
+	Safari: {
+		driver:       "safaridriver",
+		envKey:       "SE_SAFARIDRIVER",
+		browsers:     nil,
+		browserPaths: []string{"/Applications/Safari.app/Contents/MacOS/Safari"},
+		matchMajor:   false,
+	},
+}
+
+// driverDirs lists well-known driver install locations searched after PATH
+var driverDirs = []string{"/usr/local/bin", "/usr/bin", "/usr/lib/chromium", "/snap/bin", "/opt/homebrew/bin"}
+
+// FindLocalDriver resolves the driver and browser without Selenium Manager
+//
+// The driver is looked up in its environment variable (e.g. SE_CHROMEDRIVER),
+// in PATH and in well-known install locations. The browser is taken from
+// browserPath when set, otherwise looked up in PATH and well-known locations.
+// For Chromium based browsers the driver and browser major versions must match.
+func FindLocalDriver(ctx context.Context, browser BrowserType, browserPath string) (*ManagerResult, error) {
+	spec, ok := driverSpecs[normalizeBrowser(browser)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedBrowser, browser)
+	}
+
+	driverPath := findDriver(spec)
+	if driverPath == "" {
+		return nil, fmt.Errorf("%w: %s", ErrDriverNotFound, spec.driver)
+	}
+
+	if browserPath == "" {
+		browserPath = findBrowser(spec)
+	}
+
+	result := &ManagerResult{
+		DriverPath:  driverPath,
+		BrowserPath: browserPath,
+	}
+
+	result.DriverVersion = binaryVersion(ctx, driverPath)
+	if browserPath != "" {
+		result.BrowserVersion = binaryVersion(ctx, browserPath)
+	}
+
+	if spec.matchMajor && result.DriverVersion != "" && result.BrowserVersion != "" &&
+		majorVersion(result.DriverVersion) != majorVersion(result.BrowserVersion) {
+		return nil, fmt.Errorf("%w: %s %s (%s) requires browser %s, found %s (%s)",
+			ErrDriverVersionMismatch, spec.driver, result.DriverVersion, driverPath,
+			majorVersion(result.DriverVersion), result.BrowserVersion, browserPath)
+	}
+
+	return result, nil
+}
+
+// normalizeBrowser maps browser name aliases to the keys of driverSpecs
+func normalizeBrowser(browser BrowserType) BrowserType {
+	switch strings.ToLower(string(browser)) {
+	case "edge", "microsoftedge", "msedge", "webview2":
+		return "MicrosoftEdge"
+	case "chromium":
+		return Chrome
+	default:
+		return BrowserType(strings.ToLower(string(browser)))
+	}
+}
+
+// findDriver returns the first executable driver found, or an empty string
+func findDriver(spec driverSpec) string {
+	if envPath := os.Getenv(spec.envKey); envPath != "" && isExecutable(envPath) {
+		return envPath
+	}
+
+	if path, err := exec.LookPath(spec.driver); err == nil && isExecutable(path) {
+		return path
+	}
+
+	for _, dir := range driverDirs {
+		if path := filepath.Join(dir, spec.driver); isExecutable(path) {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// findBrowser returns the first executable browser found, or an empty string
+func findBrowser(spec driverSpec) string {
+	for _, name := range spec.browsers {
+		if path, err := exec.LookPath(name); err == nil && isExecutable(path) {
+			return path
+		}
+	}
+
+	for _, path := range spec.browserPaths {
+		if isExecutable(path) {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// binaryVersion runs `path --version` and returns the reported version, or an empty string
+func binaryVersion(ctx context.Context, path string) string {
+	ctx, cancel := context.WithTimeout(ctx, versionTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, path, "--version").Output()
+	if err != nil {
+		return ""
+	}
+
+	return parseVersion(string(output))
+}
+
+// parseVersion extracts the first dotted version number from --version output
+func parseVersion(output string) string {
+	return versionPattern.FindString(output)
+}
+
+// majorVersion returns the major component of a dotted version number
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
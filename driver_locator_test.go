@@ -0,0 +1,67 @@
+package selenium_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+)
+
+func writeVersionScript(t *testing.T, dir, name, output string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho '"+output+"'\n"), 0o700))
+
+	return path
+}
+
+func TestFindLocalDriver(t *testing.T) {
+	dir := t.TempDir()
+	driver := writeVersionScript(t, dir, "chromedriver", "ChromeDriver 120.0.6099.109 (3419140ab665596f21b385ce136419fde0924272)")
+	matching := writeVersionScript(t, dir, "chrome", "Google Chrome 120.0.6099.129")
+	newer := writeVersionScript(t, dir, "chrome-beta", "Google Chrome 121.0.6167.57 beta")
+
+	t.Setenv("SE_CHROMEDRIVER", driver)
+
+	result, err := selenium.FindLocalDriver(context.Background(), selenium.Chrome, matching)
+	require.NoError(t, err)
+	assert.Equal(t, driver, result.DriverPath)
+	assert.Equal(t, "120.0.6099.109", result.DriverVersion)
+	assert.Equal(t, "120.0.6099.129", result.BrowserVersion)
+
+	_, err = selenium.FindLocalDriver(context.Background(), selenium.Chrome, newer)
+	require.ErrorIs(t, err, selenium.ErrDriverVersionMismatch)
+
+	_, err = selenium.FindLocalDriver(context.Background(), "netscape", "")
+	require.ErrorIs(t, err, selenium.ErrUnsupportedBrowser)
+}
+
+func TestDriverFinderUsesDriverEnvWithBrowser(t *testing.T) {
+	dir := t.TempDir()
+	driver := writeVersionScript(t, dir, "chromedriver", "ChromeDriver 120.0.6099.109")
+	browser := writeVersionScript(t, dir, "google-chrome", "Google Chrome 120.0.6099.129")
+
+	t.Setenv("SE_CHROMEDRIVER", driver)
+	t.Setenv("PATH", dir)
+
+	opts := selenium.NewCapabilities()
+	opts.Capabilities.BrowserName = selenium.Chrome
+
+	finder := selenium.NewDriverFinder(selenium.NewService(""), opts)
+	finder.SetResolutionStrategy(selenium.ResolveLocal)
+
+	// The driver from the environment is resolved together with its browser
+	driverPath, err := finder.GetDriverPath(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, driver, driverPath)
+
+	browserPath, err := finder.GetBrowserPath(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, browser, browserPath)
+}
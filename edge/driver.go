@@ -0,0 +1,28 @@
+package edge
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium/chromium"
+)
+
+// NewDriver starts msedgedriver and creates a new Edge session.
+//
+// Nil options or service use the defaults. Quit on the returned driver also
+// stops msedgedriver.
+//
+// Example usage:
+//
+//	driver, err := edge.NewDriver(ctx, opts, nil)
+//	defer driver.Quit(ctx)
+func NewDriver(ctx context.Context, opts *Options, svc *Service) (*chromium.Driver, error) {
+	if opts == nil {
+		opts = NewOptions()
+	}
+
+	if svc == nil {
+		svc = NewService("")
+	}
+
+	return chromium.NewDriver(ctx, opts.Options, svc.Service)
+}
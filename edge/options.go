@@ -10,6 +10,10 @@ const (
 	OptionsKey = "ms:edgeOptions"
 	// BrowserName is the name of the Microsoft Edge browser.
 	BrowserName = "MicrosoftEdge"
+	// WebView2BrowserName is the browser name used to automate WebView2 apps.
+	WebView2BrowserName = "webview2"
+	// VendorPrefix is the vendor prefix used by Microsoft Edge.
+	VendorPrefix = "ms"
 )
 
 // Options contains the options for Microsoft Edge browser.
@@ -21,7 +25,7 @@ type Options struct {
 // NewOptions creates a new Edge options instance.
 func NewOptions() *Options {
 	return &Options{
-		Options:    chromium.NewOptions(),
+		Options:    chromium.NewVendorOptions(BrowserName, VendorPrefix, OptionsKey),
 		useWebView: false,
 	}
 }
@@ -29,6 +33,12 @@ func NewOptions() *Options {
 // SetUseWebView sets whether to use WebView2.
 func (o *Options) SetUseWebView(useWebView bool) {
 	o.useWebView = useWebView
+
+	if useWebView {
+		o.SetBrowserName(WebView2BrowserName)
+	} else {
+		o.SetBrowserName(BrowserName)
+	}
 }
 
 // GetUseWebView returns whether WebView2 is being used.
@@ -36,17 +46,6 @@ func (o *Options) GetUseWebView() bool {
 	return o.useWebView
 }
 
-// ToCapabilities converts the options to a capabilities map.
-func (o *Options) ToCapabilities() map[string]interface{} {
-	caps := o.Options.ToCapabilities()
-
-	if o.useWebView {
-		caps["browserName"] = "webview2"
-	}
-
-	return caps
-}
-
 // DefaultCapabilities returns the default capabilities for Edge.
 func (o *Options) DefaultCapabilities() map[string]interface{} {
 	caps := selenium.NewCapabilities()
@@ -0,0 +1,29 @@
+package edge
+
+import (
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/chromium"
+)
+
+// DriverPathEnvKey is the environment variable that may hold the msedgedriver path.
+const DriverPathEnvKey = "SE_EDGEDRIVER"
+
+// Service manages an msedgedriver server process.
+type Service struct {
+	*chromium.Service
+}
+
+// NewService creates a new msedgedriver service.
+//
+// Example usage:
+//
+//	svc := edge.NewService("/usr/bin/msedgedriver")
+//	svc.LogPath = "msedgedriver.log"
+//	svc.LogLevel = chromium.LogLevelDebug
+func NewService(path string, options ...selenium.ServiceOption) *Service {
+	options = append([]selenium.ServiceOption{selenium.WithDriverPathEnvKey(DriverPathEnvKey)}, options...)
+
+	return &Service{
+		Service: chromium.NewService(path, options...),
+	}
+}
@@ -0,0 +1,199 @@
+package firefox
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Kcrong/selenium/remote"
+	"github.com/Kcrong/selenium/remote/command"
+)
+
+// Context represents the browsing context commands are sent to.
+type Context string
+
+const (
+	// ContextContent targets web content.
+	ContextContent Context = "content"
+	// ContextChrome targets the privileged browser chrome.
+	ContextChrome Context = "chrome"
+)
+
+// Firefox specific commands
+const (
+	GetContext         command.Command = "getContext"
+	SetContext         command.Command = "setContext"
+	InstallAddon       command.Command = "installAddon"
+	UninstallAddon     command.Command = "uninstallAddon"
+	FullPageScreenshot command.Command = "fullPageScreenshot"
+)
+
+// EndpointMap maps Firefox specific commands to their HTTP method and path
+var EndpointMap = command.EndPointMapType{
+	GetContext:         {Method: http.MethodGet, Path: "/session/$sessionId/moz/context"},
+	SetContext:         {Method: http.MethodPost, Path: "/session/$sessionId/moz/context"},
+	InstallAddon:       {Method: http.MethodPost, Path: "/session/$sessionId/moz/addon/install"},
+	UninstallAddon:     {Method: http.MethodPost, Path: "/session/$sessionId/moz/addon/uninstall"},
+	FullPageScreenshot: {Method: http.MethodGet, Path: "/session/$sessionId/moz/screenshot/full"},
+}
+
+var (
+	// ErrFailedToInstallAddon is returned when an add-on cannot be installed.
+	ErrFailedToInstallAddon = errors.New("failed to install add-on")
+	// ErrFailedToGetContext is returned when the current context cannot be read.
+	ErrFailedToGetContext = errors.New("failed to get context")
+	// ErrFailedToGetScreenshot is returned when a full page screenshot cannot be taken.
+	ErrFailedToGetScreenshot = errors.New("failed to get full page screenshot")
+)
+
+// Driver extends remote.WebDriver with geckodriver specific commands.
+type Driver struct {
+	*remote.WebDriver
+}
+
+// NewDriverFromRemote wraps an existing session and registers the Firefox commands on its connection.
+func NewDriverFromRemote(driver *remote.WebDriver) *Driver {
+	conn := driver.Connection()
+	for cmd, endpoint := range EndpointMap {
+		conn.AddCommand(cmd, endpoint.Method, endpoint.Path)
+	}
+
+	return &Driver{
+		WebDriver: driver,
+	}
+}
+
+// InstallAddon installs an add-on from an XPI file or an unpacked add-on directory.
+//
+// Temporary add-ons are removed when the browser restarts and do not need to be signed.
+// It returns the ID of the installed add-on.
+func (d *Driver) InstallAddon(ctx context.Context, path string, temporary bool) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToInstallAddon, err)
+	}
+
+	var data []byte
+	if info.IsDir() {
+		data, err = zipDir(path)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToInstallAddon, err)
+	}
+
+	return d.InstallEncodedAddon(ctx, base64.StdEncoding.EncodeToString(data), temporary)
+}
+
+// InstallEncodedAddon installs an add-on from a base64 encoded XPI archive.
+func (d *Driver) InstallEncodedAddon(ctx context.Context, encoded string, temporary bool) (string, error) {
+	response, err := d.Execute(ctx, InstallAddon, map[string]interface{}{
+		"addon":     encoded,
+		"temporary": temporary,
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToInstallAddon, err)
+	}
+
+	if id, ok := response["value"].(string); ok {
+		return id, nil
+	}
+
+	return "", fmt.Errorf("%w: %v", ErrFailedToInstallAddon, response)
+}
+
+// UninstallAddon uninstalls the add-on with the given ID.
+func (d *Driver) UninstallAddon(ctx context.Context, id string) error {
+	_, err := d.Execute(ctx, UninstallAddon, map[string]interface{}{
+		"id": id,
+	})
+
+	return err
+}
+
+// GetContext returns the context commands are currently sent to.
+func (d *Driver) GetContext(ctx context.Context) (Context, error) {
+	response, err := d.Execute(ctx, GetContext, nil)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToGetContext, err)
+	}
+
+	if value, ok := response["value"].(string); ok {
+		return Context(value), nil
+	}
+
+	return "", fmt.Errorf("%w: %v", ErrFailedToGetContext, response)
+}
+
+// SetContext sets the context subsequent commands are sent to.
+func (d *Driver) SetContext(ctx context.Context, c Context) error {
+	_, err := d.Execute(ctx, SetContext, map[string]interface{}{
+		"context": string(c),
+	})
+
+	return err
+}
+
+// WithContext runs fn in the given context and restores the previous context afterwards.
+//
+// Example usage:
+//
+//	err := driver.WithContext(ctx, firefox.ContextChrome, func() error {
+//		_, err := driver.ExecuteScript(ctx, "return Services.appinfo.version", nil)
+//		return err
+//	})
+func (d *Driver) WithContext(ctx context.Context, c Context, fn func() error) error {
+	previous, err := d.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := d.SetContext(ctx, c); err != nil {
+		return err
+	}
+
+	fnErr := fn()
+
+	if err := d.SetContext(ctx, previous); err != nil {
+		return errors.Join(fnErr, fmt.Errorf("failed to restore context %s: %w", previous, err))
+	}
+
+	return fnErr
+}
+
+// FullPageScreenshot takes a screenshot of the entire page, not only the viewport.
+func (d *Driver) FullPageScreenshot(ctx context.Context) ([]byte, error) {
+	response, err := d.Execute(ctx, FullPageScreenshot, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetScreenshot, err)
+	}
+
+	if screenshot, ok := response["value"].(string); ok {
+		return base64.StdEncoding.DecodeString(screenshot)
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrFailedToGetScreenshot, response)
+}
+
+// zipDir archives the contents of a directory into an in-memory zip file.
+func zipDir(dir string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+	if err := archive.AddFS(os.DirFS(dir)); err != nil {
+		return nil, err
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,119 @@
+package firefox_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/firefox"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote"
+)
+
+func newDriver(t *testing.T) (*firefox.Driver, *remotetest.Server) {
+	t.Helper()
+
+	server := remotetest.NewServer(t)
+
+	driver, err := remote.New(context.Background(), server.Conn(t), selenium.RawConvertible{"browserName": "firefox"})
+	require.NoError(t, err)
+
+	return firefox.NewDriverFromRemote(driver), server
+}
+
+func TestInstallAddon(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	driver, server := newDriver(t)
+	server.Handle("POST /session/{id}/moz/addon/install", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, "addon@example.com")
+	})
+
+	xpi := filepath.Join(t.TempDir(), "addon.xpi")
+	require.NoError(t, os.WriteFile(xpi, []byte("xpi"), 0o600))
+
+	id, err := driver.InstallAddon(ctx, xpi, true)
+	require.NoError(t, err)
+	assert.Equal(t, "addon@example.com", id)
+
+	// Unpacked add-ons are zipped before they are sent
+	unpacked := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(unpacked, "manifest.json"), []byte(`{}`), 0o600))
+
+	_, err = driver.InstallAddon(ctx, unpacked, false)
+	require.NoError(t, err)
+
+	_, err = driver.InstallAddon(ctx, filepath.Join(t.TempDir(), "missing.xpi"), false)
+	require.ErrorIs(t, err, firefox.ErrFailedToInstallAddon)
+
+	requests := server.Requests(http.MethodPost, "/session/s1/moz/addon/install")
+	require.Len(t, requests, 2)
+	assert.Equal(t, map[string]interface{}{
+		"addon": base64.StdEncoding.EncodeToString([]byte("xpi")), "temporary": true,
+	}, requests[0].Body)
+	assert.Equal(t, false, requests[1].Body["temporary"])
+
+	archive, err := base64.StdEncoding.DecodeString(requests[1].Body["addon"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, "PK", string(archive[:2]))
+
+	require.NoError(t, driver.UninstallAddon(ctx, "addon@example.com"))
+
+	requests = server.Requests(http.MethodPost, "/session/s1/moz/addon/uninstall")
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]interface{}{"id": "addon@example.com"}, requests[0].Body)
+}
+
+func TestWithContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	driver, server := newDriver(t)
+	server.Handle("GET /session/{id}/moz/context", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, "content")
+	})
+
+	current, err := driver.GetContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, firefox.ContextContent, current)
+
+	ran := false
+	require.NoError(t, driver.WithContext(ctx, firefox.ContextChrome, func() error {
+		ran = true
+
+		return nil
+	}))
+	assert.True(t, ran)
+
+	// The previous context is restored
+	requests := server.Requests(http.MethodPost, "/session/s1/moz/context")
+	require.Len(t, requests, 2)
+	assert.Equal(t, map[string]interface{}{"context": "chrome"}, requests[0].Body)
+	assert.Equal(t, map[string]interface{}{"context": "content"}, requests[1].Body)
+}
+
+func TestFullPageScreenshot(t *testing.T) {
+	t.Parallel()
+
+	driver, server := newDriver(t)
+	server.Handle("GET /session/{id}/moz/screenshot/full", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, base64.StdEncoding.EncodeToString([]byte("png")))
+	})
+
+	screenshot, err := driver.FullPageScreenshot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png"), screenshot)
+
+	server.Kill("s1")
+
+	_, err = driver.FullPageScreenshot(context.Background())
+	require.ErrorIs(t, err, firefox.ErrFailedToGetScreenshot)
+}
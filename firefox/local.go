@@ -0,0 +1,75 @@
+package firefox
+
+import (
+	"context"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/remote"
+)
+
+// NewDriver starts geckodriver and creates a new Firefox session.
+//
+// Nil options or service use the defaults. The driver path is taken from the
+// service when set, otherwise it is resolved with a DriverFinder. Quit on the
+// returned driver also stops geckodriver.
+//
+// Example usage:
+//
+//	driver, err := firefox.NewDriver(ctx, opts, nil)
+//	defer driver.Quit(ctx)
+func NewDriver(ctx context.Context, opts *Options, svc *Service) (*Driver, error) {
+	if opts == nil {
+		opts = NewOptions()
+	}
+
+	if svc == nil {
+		svc = NewService("")
+	}
+
+	resolved, err := resolvePaths(ctx, opts, svc.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := remote.NewLocal(ctx, svc.Service, resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDriverFromRemote(driver), nil
+}
+
+// resolvePaths sets the driver path of the service and returns a copy of
+// opts with the browser binary location resolved if it is unset.
+//
+// The caller's options are left untouched so that they can be reused.
+func resolvePaths(ctx context.Context, opts *Options, svc *selenium.Service) (*Options, error) {
+	base := selenium.NewCapabilities()
+	base.Capabilities.BrowserName = BrowserName
+
+	if opts.binaryLocation != "" {
+		base.SetBrowserOptions(OptionsKey, map[string]interface{}{"binary": opts.binaryLocation})
+	}
+
+	finder := selenium.NewDriverFinder(svc, base)
+
+	driverPath, err := finder.GetDriverPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.Path = driverPath
+
+	resolved := *opts
+
+	if resolved.binaryLocation == "" {
+		browserPath, err := finder.GetBrowserPath(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved.binaryLocation = browserPath
+	}
+
+	return &resolved, nil
+}
@@ -0,0 +1,38 @@
+package firefox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePathsCopiesOptions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake binaries are shell scripts")
+	}
+
+	dir := t.TempDir()
+	for name, output := range map[string]string{"geckodriver": "geckodriver 0.34.0", "firefox": "Mozilla Firefox 121.0"} {
+		script := []byte("#!/bin/sh\necho '" + output + "'\n")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), script, 0o700))
+	}
+
+	t.Setenv("PATH", dir)
+	t.Setenv("SE_GECKODRIVER", "")
+
+	opts := NewOptions()
+	svc := NewService("")
+
+	resolved, err := resolvePaths(context.Background(), opts, svc.Service)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "geckodriver"), svc.Path)
+	assert.Equal(t, filepath.Join(dir, "firefox"), resolved.GetBinaryLocation())
+
+	// The caller's options are not modified
+	assert.Empty(t, opts.GetBinaryLocation())
+}
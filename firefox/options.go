@@ -36,6 +36,7 @@ type Options struct {
 	profile        string
 	log            *Log
 	arguments      []string
+	enableBiDi     bool
 }
 
 // NewOptions creates a new Firefox options instance.
@@ -46,6 +47,7 @@ func NewOptions() *Options {
 		profile:        "",
 		log:            &Log{Level: ""},
 		arguments:      make([]string, 0),
+		enableBiDi:     false,
 	}
 }
 
@@ -74,6 +76,18 @@ func (o *Options) SetProfile(profile string) {
 	o.profile = profile
 }
 
+// SetFirefoxProfile encodes the given profile and sets it as the Firefox profile to use.
+func (o *Options) SetFirefoxProfile(profile *Profile) error {
+	encoded, err := profile.Encoded()
+	if err != nil {
+		return err
+	}
+
+	o.profile = encoded
+
+	return nil
+}
+
 // GetProfile returns the Firefox profile being used.
 func (o *Options) GetProfile() string {
 	return o.profile
@@ -84,6 +98,18 @@ func (o *Options) AddArgument(arg string) {
 	o.arguments = append(o.arguments, arg)
 }
 
+// SetEnableBiDi requests a WebDriver BiDi WebSocket URL for the session.
+//
+// The URL is returned in the session capabilities and used by bidi.ConnectFromCapabilities.
+func (o *Options) SetEnableBiDi(enable bool) {
+	o.enableBiDi = enable
+}
+
+// GetEnableBiDi reports whether a WebDriver BiDi WebSocket URL is requested.
+func (o *Options) GetEnableBiDi() bool {
+	return o.enableBiDi
+}
+
 // SetLogLevel sets the logging level.
 func (o *Options) SetLogLevel(level string) {
 	o.log.Level = level
@@ -93,6 +119,7 @@ func (o *Options) SetLogLevel(level string) {
 func (o *Options) ToCapabilities() map[string]interface{} {
 	caps := selenium.NewCapabilities()
 	caps.Capabilities.BrowserName = BrowserName
+	caps.Capabilities.EnableBiDi = o.enableBiDi
 
 	opts := make(map[string]interface{})
 
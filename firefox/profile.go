@@ -0,0 +1,349 @@
+package firefox
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// userPrefsFile is the name of the file Firefox reads user preferences from.
+	userPrefsFile = "user.js"
+	// extensionsDir is the directory extensions are installed into.
+	extensionsDir = "extensions"
+	// manifestFile is the name of the WebExtension manifest.
+	manifestFile = "manifest.json"
+)
+
+// certificateFiles lists the NSS databases and overrides that make up the certificate store.
+// The first entry, the certificate database, is required.
+var certificateFiles = []string{"cert9.db", "key4.db", "pkcs11.txt", "cert_override.txt"}
+
+var (
+	// ErrExtensionID is returned when an extension ID cannot be determined from its manifest.
+	ErrExtensionID = errors.New("cannot determine extension id")
+	// ErrNoCertificates is returned when a directory contains no certificate database.
+	ErrNoCertificates = errors.New("no certificate databases found")
+)
+
+// userPrefPattern matches a single user_pref line in user.js.
+var userPrefPattern = regexp.MustCompile(`^\s*user_pref\(\s*"([^"]+)"\s*,\s*(.+?)\s*\)\s*;`)
+
+// Profile is a Firefox profile directory that can be sent to a remote geckodriver.
+//
+// Example usage:
+//
+//	profile, err := firefox.NewProfile()
+//	profile.SetPreference("browser.download.dir", "/downloads")
+//	err = profile.AddExtension("ublock.xpi")
+//	err = opts.SetFirefoxProfile(profile)
+type Profile struct {
+	preferences map[string]interface{}
+	path        string
+}
+
+// NewProfile creates a new, empty profile in a temporary directory.
+func NewProfile() (*Profile, error) {
+	dir, err := os.MkdirTemp("", "firefox-profile-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create profile directory: %w", err)
+	}
+
+	return &Profile{
+		preferences: make(map[string]interface{}),
+		path:        dir,
+	}, nil
+}
+
+// NewProfileFromDir creates a profile from a copy of an existing profile directory.
+//
+// Preferences already present in the directory's user.js are preserved.
+func NewProfileFromDir(dir string) (*Profile, error) {
+	profile, err := NewProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := copyDir(dir, profile.path); err != nil {
+		_ = profile.Clean()
+
+		return nil, fmt.Errorf("failed to copy profile %s: %w", dir, err)
+	}
+
+	if err := profile.readUserPrefs(); err != nil {
+		_ = profile.Clean()
+
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// Path returns the profile directory.
+func (p *Profile) Path() string {
+	return p.path
+}
+
+// SetPreference sets a user preference written to user.js.
+func (p *Profile) SetPreference(name string, value interface{}) {
+	p.preferences[name] = value
+}
+
+// GetPreferences returns the user preferences of the profile.
+func (p *Profile) GetPreferences() map[string]interface{} {
+	return p.preferences
+}
+
+// AddExtension installs an extension from an XPI file or an unpacked extension directory.
+func (p *Profile) AddExtension(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat extension: %w", err)
+	}
+
+	id, err := extensionID(path, info.IsDir())
+	if err != nil {
+		return err
+	}
+
+	dest := filepath.Join(p.path, extensionsDir)
+	if err := os.MkdirAll(dest, 0o755); err != nil {
+		return fmt.Errorf("failed to create extensions directory: %w", err)
+	}
+
+	if info.IsDir() {
+		return copyDir(path, filepath.Join(dest, id))
+	}
+
+	return copyFile(path, filepath.Join(dest, id+".xpi"))
+}
+
+// AddCertificates copies the certificate databases from another profile directory.
+//
+// The directory must contain at least cert9.db; key4.db, pkcs11.txt and
+// cert_override.txt are copied when present.
+func (p *Profile) AddCertificates(dir string) error {
+	if _, err := os.Stat(filepath.Join(dir, certificateFiles[0])); errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrNoCertificates, dir)
+	}
+
+	for _, name := range certificateFiles {
+		src := filepath.Join(dir, name)
+		if _, err := os.Stat(src); errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err := copyFile(src, filepath.Join(p.path, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Encoded writes user.js and returns the profile as a base64 encoded zip archive.
+func (p *Profile) Encoded() (string, error) {
+	if err := p.writeUserPrefs(); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+	if err := archive.AddFS(os.DirFS(p.path)); err != nil {
+		return "", fmt.Errorf("failed to zip profile: %w", err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return "", fmt.Errorf("failed to zip profile: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Clean removes the profile directory.
+func (p *Profile) Clean() error {
+	return os.RemoveAll(p.path)
+}
+
+// readUserPrefs loads the preferences from an existing user.js.
+func (p *Profile) readUserPrefs() error {
+	file, err := os.Open(filepath.Join(p.path, userPrefsFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", userPrefsFile, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		match := userPrefPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal([]byte(match[2]), &value); err != nil {
+			// Keep values we cannot decode verbatim.
+			value = json.RawMessage(match[2])
+		}
+
+		p.preferences[match[1]] = value
+	}
+
+	return scanner.Err()
+}
+
+// writeUserPrefs writes the preferences to user.js, sorted by name.
+func (p *Profile) writeUserPrefs() error {
+	names := make([]string, 0, len(p.preferences))
+	for name := range p.preferences {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var sb strings.Builder
+
+	for _, name := range names {
+		value, err := json.Marshal(p.preferences[name])
+		if err != nil {
+			return fmt.Errorf("failed to encode preference %s: %w", name, err)
+		}
+
+		fmt.Fprintf(&sb, "user_pref(%q, %s);\n", name, value)
+	}
+
+	if err := os.WriteFile(filepath.Join(p.path, userPrefsFile), []byte(sb.String()), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", userPrefsFile, err)
+	}
+
+	return nil
+}
+
+// extensionManifest is the subset of manifest.json that identifies an extension.
+type extensionManifest struct {
+	BrowserSpecificSettings struct {
+		Gecko struct {
+			ID string `json:"id"`
+		} `json:"gecko"`
+	} `json:"browser_specific_settings"`
+	Applications struct {
+		Gecko struct {
+			ID string `json:"id"`
+		} `json:"gecko"`
+	} `json:"applications"`
+}
+
+// extensionID reads the gecko extension ID from an XPI file or unpacked extension.
+func extensionID(path string, isDir bool) (string, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if isDir {
+		data, err = os.ReadFile(filepath.Join(path, manifestFile))
+	} else {
+		data, err = readZipFile(path, manifestFile)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExtensionID, err)
+	}
+
+	var manifest extensionManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExtensionID, err)
+	}
+
+	if id := manifest.BrowserSpecificSettings.Gecko.ID; id != "" {
+		return id, nil
+	}
+
+	if id := manifest.Applications.Gecko.ID; id != "" {
+		return id, nil
+	}
+
+	return "", fmt.Errorf("%w: %s has no gecko id", ErrExtensionID, path)
+}
+
+// readZipFile reads a single file from a zip archive.
+func readZipFile(archivePath, name string) ([]byte, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	file, err := archive.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+// copyDir recursively copies the contents of src into dest.
+func copyDir(src, dest string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dest, rel)
+
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+
+		// Firefox lock files only matter to a running instance.
+		if entry.Name() == "parent.lock" || entry.Name() == "lock" || entry.Name() == ".parentlock" {
+			return nil
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+// copyFile copies a single regular file.
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dest, err)
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+
+		return fmt.Errorf("failed to copy %s: %w", src, err)
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,102 @@
+package firefox_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/firefox"
+)
+
+func unzipProfile(t *testing.T, encoded string) map[string]string {
+	t.Helper()
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+
+	for _, file := range archive.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		files[file.Name] = string(content)
+	}
+
+	return files
+}
+
+func TestProfileEncoded(t *testing.T) {
+	t.Parallel()
+
+	src := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(src, "user.js"),
+		[]byte("user_pref(\"browser.startup.page\", 0);\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "cert9.db"), []byte("certs"), 0o600))
+
+	extension := filepath.Join(t.TempDir(), "ext")
+	require.NoError(t, os.Mkdir(extension, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(extension, "manifest.json"),
+		[]byte(`{"browser_specific_settings":{"gecko":{"id":"test@example.com"}}}`), 0o600))
+
+	profile, err := firefox.NewProfileFromDir(src)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = profile.Clean() })
+
+	profile.SetPreference("browser.download.dir", "/downloads")
+	require.NoError(t, profile.AddExtension(extension))
+	require.NoError(t, profile.AddCertificates(src))
+
+	encoded, err := profile.Encoded()
+	require.NoError(t, err)
+
+	files := unzipProfile(t, encoded)
+	assert.Equal(t, "user_pref(\"browser.download.dir\", \"/downloads\");\n"+
+		"user_pref(\"browser.startup.page\", 0);\n", files["user.js"])
+	assert.Equal(t, "certs", files["cert9.db"])
+	assert.Contains(t, files, "extensions/test@example.com/manifest.json")
+}
+
+func TestProfileAddExtensionWithoutID(t *testing.T) {
+	t.Parallel()
+
+	extension := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(extension, "manifest.json"), []byte(`{}`), 0o600))
+
+	profile, err := firefox.NewProfile()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = profile.Clean() })
+
+	require.ErrorIs(t, profile.AddExtension(extension), firefox.ErrExtensionID)
+}
+
+func TestProfileAddCertificatesRequiresCertDB(t *testing.T) {
+	t.Parallel()
+
+	src := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(src, "key4.db"), []byte("keys"), 0o600))
+
+	profile, err := firefox.NewProfile()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = profile.Clean() })
+
+	require.ErrorIs(t, profile.AddCertificates(src), firefox.ErrNoCertificates)
+	assert.NoFileExists(t, filepath.Join(profile.Path(), "key4.db"))
+}
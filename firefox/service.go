@@ -0,0 +1,107 @@
+package firefox
+
+import (
+	"strconv"
+
+	"github.com/Kcrong/selenium"
+)
+
+// DriverPathEnvKey is the environment variable that may hold the geckodriver path.
+const DriverPathEnvKey = "SE_GECKODRIVER"
+
+// ServiceLogLevel is the verbosity of geckodriver logs.
+type ServiceLogLevel string
+
+const (
+	// ServiceLogLevelFatal logs fatal errors only.
+	ServiceLogLevelFatal ServiceLogLevel = "fatal"
+	// ServiceLogLevelError logs errors and above.
+	ServiceLogLevelError ServiceLogLevel = "error"
+	// ServiceLogLevelWarn logs warnings and above.
+	ServiceLogLevelWarn ServiceLogLevel = "warn"
+	// ServiceLogLevelInfo logs informational messages and above.
+	ServiceLogLevelInfo ServiceLogLevel = "info"
+	// ServiceLogLevelConfig logs configuration messages and above.
+	ServiceLogLevelConfig ServiceLogLevel = "config"
+	// ServiceLogLevelDebug logs debug messages and above.
+	ServiceLogLevelDebug ServiceLogLevel = "debug"
+	// ServiceLogLevelTrace logs everything.
+	ServiceLogLevelTrace ServiceLogLevel = "trace"
+)
+
+// Service manages a geckodriver server process.
+//
+// geckodriver writes its log to stdout; use selenium.WithLogOutput to capture it.
+type Service struct {
+	*selenium.Service
+	// LogLevel is the verbosity of the driver log.
+	LogLevel ServiceLogLevel
+	// Host is the address geckodriver listens on.
+	Host string
+	// ProfileRoot is the directory temporary profiles are created in.
+	ProfileRoot string
+	// AllowedHosts lists the Host header values accepted by geckodriver.
+	AllowedHosts []string
+	// AllowedOrigins lists the request origins allowed to connect.
+	AllowedOrigins []string
+	// ExtraArgs are additional command line arguments passed verbatim.
+	ExtraArgs []string
+	// MarionettePort is the port used to connect to Firefox; 0 lets geckodriver pick one.
+	MarionettePort int
+	// WebSocketPort is the port of the WebDriver BiDi websocket; 0 lets geckodriver pick one.
+	WebSocketPort int
+	// ConnectExisting connects to an already running Firefox on MarionettePort.
+	ConnectExisting bool
+}
+
+var _ selenium.ArgsProvider = (*Service)(nil)
+
+// NewService creates a new geckodriver service.
+func NewService(path string, options ...selenium.ServiceOption) *Service {
+	//nolint:exhaustruct // Optional settings are configured by the user.
+	s := &Service{}
+	options = append([]selenium.ServiceOption{selenium.WithDriverPathEnvKey(DriverPathEnvKey)}, options...)
+	s.Service = selenium.NewService(path, append(options, selenium.WithArgsProvider(s))...)
+
+	return s
+}
+
+// Args returns the command line arguments to start the driver on the given port.
+func (s *Service) Args(port int) []string {
+	args := []string{"--port", strconv.Itoa(port)}
+
+	if s.Host != "" {
+		args = append(args, "--host", s.Host)
+	}
+
+	if s.LogLevel != "" {
+		args = append(args, "--log", string(s.LogLevel))
+	}
+
+	if s.MarionettePort != 0 {
+		args = append(args, "--marionette-port", strconv.Itoa(s.MarionettePort))
+	}
+
+	if s.ConnectExisting {
+		args = append(args, "--connect-existing")
+	}
+
+	if s.WebSocketPort != 0 {
+		args = append(args, "--websocket-port", strconv.Itoa(s.WebSocketPort))
+	}
+
+	if s.ProfileRoot != "" {
+		args = append(args, "--profile-root", s.ProfileRoot)
+	}
+
+	// Each host and origin is a separate argument
+	if len(s.AllowedHosts) > 0 {
+		args = append(append(args, "--allow-hosts"), s.AllowedHosts...)
+	}
+
+	if len(s.AllowedOrigins) > 0 {
+		args = append(append(args, "--allow-origins"), s.AllowedOrigins...)
+	}
+
+	return append(args, s.ExtraArgs...)
+}
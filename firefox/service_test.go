@@ -0,0 +1,39 @@
+package firefox_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Kcrong/selenium/firefox"
+)
+
+func TestServiceArgs(t *testing.T) {
+	t.Parallel()
+
+	svc := firefox.NewService("/usr/bin/geckodriver")
+	assert.Equal(t, []string{"--port", "4444"}, svc.Args(4444))
+
+	svc.Host = "127.0.0.1"
+	svc.LogLevel = firefox.ServiceLogLevelTrace
+	svc.MarionettePort = 2828
+	svc.ConnectExisting = true
+	svc.WebSocketPort = 9222
+	svc.ProfileRoot = "/tmp/profiles"
+	svc.AllowedHosts = []string{"a.test", "b.test"}
+	svc.AllowedOrigins = []string{"http://a.test", "http://b.test"}
+	svc.ExtraArgs = []string{"--jsdebugger"}
+
+	assert.Equal(t, []string{
+		"--port", "4444",
+		"--host", "127.0.0.1",
+		"--log", "trace",
+		"--marionette-port", "2828",
+		"--connect-existing",
+		"--websocket-port", "9222",
+		"--profile-root", "/tmp/profiles",
+		"--allow-hosts", "a.test", "b.test",
+		"--allow-origins", "http://a.test", "http://b.test",
+		"--jsdebugger",
+	}, svc.Args(4444))
+}
@@ -0,0 +1,277 @@
+// Package grid inspects a Selenium Grid through its HTTP endpoints.
+package grid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/remote/command"
+	"github.com/Kcrong/selenium/remote/connection"
+)
+
+// DefaultPollInterval is the default interval between capacity checks in WaitForCapacity.
+const DefaultPollInterval = time.Second
+
+// RegistrationSecretHeader carries the Grid registration secret.
+//
+// Clearing the session queue requires it when the Grid was started with a
+// registration secret; set it through connection.ClientConfig.ExtraHeaders.
+const RegistrationSecretHeader = "X-REGISTRATION-SECRET"
+
+// Grid specific commands.
+const (
+	GetStatus  command.Command = "gridStatus"
+	GetQueue   command.Command = "gridGetQueue"
+	ClearQueue command.Command = "gridClearQueue"
+)
+
+// EndpointMap maps Grid commands to their HTTP method and path.
+var EndpointMap = command.EndPointMapType{
+	GetStatus:  {Method: http.MethodGet, Path: "/status"},
+	GetQueue:   {Method: http.MethodGet, Path: "/se/grid/newsessionqueue/queue"},
+	ClearQueue: {Method: http.MethodDelete, Path: "/se/grid/newsessionqueue/queue"},
+}
+
+var (
+	// ErrInvalidResponse is returned when the Grid response cannot be decoded.
+	ErrInvalidResponse = errors.New("invalid grid response")
+	// ErrNoCapacity is returned when no free slot matched the capabilities before ctx was done.
+	ErrNoCapacity = errors.New("no grid capacity")
+)
+
+// Availability is the availability of a node.
+type Availability string
+
+// Node availabilities.
+const (
+	AvailabilityUp       Availability = "UP"
+	AvailabilityDraining Availability = "DRAINING"
+	AvailabilityDown     Availability = "DOWN"
+)
+
+// Status is the state of the Grid as reported by /status.
+type Status struct {
+	Message string `json:"message"`
+	Nodes   []Node `json:"nodes"`
+	Ready   bool   `json:"ready"`
+}
+
+// OSInfo describes the operating system of a node.
+type OSInfo struct {
+	Arch    string `json:"arch"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Node is a machine registered with the Grid.
+type Node struct {
+	ID           string       `json:"id"`
+	URI          string       `json:"uri"`
+	Availability Availability `json:"availability"`
+	Version      string       `json:"version"`
+	OSInfo       OSInfo       `json:"osInfo"`
+	Slots        []Slot       `json:"slots"`
+	MaxSessions  int          `json:"maxSessions"`
+}
+
+// SlotID identifies a slot on a node.
+type SlotID struct {
+	HostID string `json:"hostId"`
+	ID     string `json:"id"`
+}
+
+// SlotSession is the session running in a slot.
+type SlotSession struct {
+	Capabilities map[string]interface{} `json:"capabilities"`
+	Stereotype   map[string]interface{} `json:"stereotype"`
+	SessionID    string                 `json:"sessionId"`
+	Start        string                 `json:"start"`
+	URI          string                 `json:"uri"`
+}
+
+// Slot can run a single session with capabilities matching its stereotype.
+type Slot struct {
+	Stereotype  map[string]interface{} `json:"stereotype"`
+	Session     *SlotSession           `json:"session"`
+	ID          SlotID                 `json:"id"`
+	LastStarted string                 `json:"lastStarted"`
+}
+
+// QueuedRequest is a new session request waiting for a free slot.
+type QueuedRequest struct {
+	RequestID    string                   `json:"requestId"`
+	Capabilities []map[string]interface{} `json:"capabilities"`
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithPollInterval sets the interval between capacity checks in WaitForCapacity.
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *Client) {
+		c.pollInterval = interval
+	}
+}
+
+// Client queries a Selenium Grid.
+type Client struct {
+	conn         *connection.RemoteConnection
+	pollInterval time.Duration
+}
+
+// NewClient creates a Grid client and registers the Grid commands on the connection.
+func NewClient(conn *connection.RemoteConnection, opts ...Option) *Client {
+	for cmd, endpoint := range EndpointMap {
+		conn.AddCommand(cmd, endpoint.Method, endpoint.Path)
+	}
+
+	c := &Client{
+		conn:         conn,
+		pollInterval: DefaultPollInterval,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Status returns the state of the Grid and its nodes.
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	response, err := c.conn.Execute(ctx, GetStatus, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grid status: %w", err)
+	}
+
+	var status Status
+	if err := decodeValue(response, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// Nodes returns the nodes registered with the Grid.
+func (c *Client) Nodes(ctx context.Context) ([]Node, error) {
+	status, err := c.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return status.Nodes, nil
+}
+
+// Queue returns the new session requests waiting for a free slot.
+func (c *Client) Queue(ctx context.Context) ([]QueuedRequest, error) {
+	response, err := c.conn.Execute(ctx, GetQueue, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session queue: %w", err)
+	}
+
+	var queue []QueuedRequest
+	if err := decodeValue(response, &queue); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+// ClearQueue cancels every queued new session request and returns how many were cancelled.
+//
+// The Grid does not support cancelling a single request.
+func (c *Client) ClearQueue(ctx context.Context) (int, error) {
+	response, err := c.conn.Execute(ctx, ClearQueue, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to clear session queue: %w", err)
+	}
+
+	var cleared int
+	if err := decodeValue(response, &cleared); err != nil {
+		return 0, err
+	}
+
+	return cleared, nil
+}
+
+// WaitForCapacity polls the Grid until a node has a free slot matching caps.
+//
+// It returns the matching node, or ErrNoCapacity joined with the ctx error
+// when ctx is done first.
+func (c *Client) WaitForCapacity(ctx context.Context, caps selenium.Convertible) (*Node, error) {
+	requested := caps.ToCapabilities()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := c.Status(ctx)
+		if err == nil && status.Ready {
+			for i := range status.Nodes {
+				if status.Nodes[i].HasCapacity(requested) {
+					return &status.Nodes[i], nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Join(ErrNoCapacity, ctx.Err(), err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// HasCapacity reports whether the node is up and has a free slot matching caps.
+func (n *Node) HasCapacity(caps map[string]interface{}) bool {
+	if n.Availability != AvailabilityUp {
+		return false
+	}
+
+	busy := 0
+	free := false
+
+	for _, slot := range n.Slots {
+		if slot.Session != nil {
+			busy++
+		} else if slot.Matches(caps) {
+			free = true
+		}
+	}
+
+	return free && (n.MaxSessions == 0 || busy < n.MaxSessions)
+}
+
+// Matches reports whether the slot stereotype satisfies caps.
+//
+// Like the Grid default slot matcher, only browserName, browserVersion and
+// platformName are compared, and only when they are set in caps.
+func (s *Slot) Matches(caps map[string]interface{}) bool {
+	for _, key := range []string{"browserName", "browserVersion", "platformName"} {
+		want, _ := caps[key].(string)
+		if want == "" || strings.EqualFold(want, "any") {
+			continue
+		}
+
+		have, _ := s.Stereotype[key].(string)
+		if !strings.EqualFold(want, have) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decodeValue decodes the W3C "value" member of a response into out.
+func decodeValue(response map[string]interface{}, out interface{}) error {
+	if err := connection.DecodeValue(response, out); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	return nil
+}
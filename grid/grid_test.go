@@ -0,0 +1,127 @@
+package grid_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/grid"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote/connection"
+)
+
+// statusJSON is a status with one node, with a busy chrome slot and a free firefox slot.
+const statusJSON = `{"ready": true, "message": "Selenium Grid ready.", "nodes": [{
+	"id": "node-1", "uri": "http://10.0.0.2:5555", "availability": "UP", "maxSessions": 2, "version": "4.27.0",
+	"osInfo": {"arch": "amd64", "name": "Linux", "version": "6.1"},
+	"slots": [
+		{"id": {"hostId": "node-1", "id": "slot-1"}, "lastStarted": "2024-01-01T00:00:00Z",
+		 "stereotype": {"browserName": "chrome", "platformName": "linux"},
+		 "session": {"sessionId": "s1", "capabilities": {"browserName": "chrome"}, "start": "2024-01-01T00:00:00Z",
+		             "stereotype": {"browserName": "chrome"}, "uri": "http://10.0.0.2:5555"}},
+		{"id": {"hostId": "node-1", "id": "slot-2"}, "lastStarted": "1970-01-01T00:00:00Z",
+		 "stereotype": {"browserName": "firefox", "platformName": "linux"}, "session": null}
+	]}]}`
+
+func newFakeGrid(t *testing.T) (*connection.RemoteConnection, *atomic.Int32) {
+	t.Helper()
+
+	queued := &atomic.Int32{}
+	queued.Store(1)
+
+	server := remotetest.NewServer(t)
+	server.Handle("GET /status", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, json.RawMessage(statusJSON))
+	})
+	server.Handle("GET /se/grid/newsessionqueue/queue", func(w http.ResponseWriter, _ *http.Request) {
+		queue := []map[string]interface{}{}
+		if queued.Load() > 0 {
+			queue = append(queue, map[string]interface{}{
+				"requestId":    "r1",
+				"capabilities": []map[string]interface{}{{"browserName": "chrome"}},
+			})
+		}
+
+		remotetest.Reply(w, queue)
+	})
+	server.Handle("DELETE /se/grid/newsessionqueue/queue", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(grid.RegistrationSecretHeader) != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		remotetest.Reply(w, queued.Swap(0))
+	})
+
+	config := connection.NewClientConfig(server.URL)
+	config.ExtraHeaders = map[string]string{grid.RegistrationSecretHeader: "secret"}
+
+	return server.ConnWithConfig(t, config), queued
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	conn, _ := newFakeGrid(t)
+	client := grid.NewClient(conn)
+
+	status, err := client.Status(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.Ready)
+	require.Len(t, status.Nodes, 1)
+
+	node := status.Nodes[0]
+	assert.Equal(t, grid.AvailabilityUp, node.Availability)
+	assert.Equal(t, "Linux", node.OSInfo.Name)
+	require.Len(t, node.Slots, 2)
+	assert.Equal(t, "s1", node.Slots[0].Session.SessionID)
+	assert.Nil(t, node.Slots[1].Session)
+	assert.Equal(t, "firefox", node.Slots[1].Stereotype["browserName"])
+}
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn, _ := newFakeGrid(t)
+	client := grid.NewClient(conn)
+
+	queue, err := client.Queue(ctx)
+	require.NoError(t, err)
+	require.Len(t, queue, 1)
+	assert.Equal(t, "r1", queue[0].RequestID)
+
+	cleared, err := client.ClearQueue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cleared)
+
+	queue, err = client.Queue(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+}
+
+func TestWaitForCapacity(t *testing.T) {
+	t.Parallel()
+
+	conn, _ := newFakeGrid(t)
+	client := grid.NewClient(conn, grid.WithPollInterval(10*time.Millisecond))
+
+	node, err := client.WaitForCapacity(context.Background(), selenium.RawConvertible{"browserName": "firefox"})
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", node.ID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WaitForCapacity(ctx, selenium.RawConvertible{"browserName": "chrome"})
+	require.ErrorIs(t, err, grid.ErrNoCapacity)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
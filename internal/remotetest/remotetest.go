@@ -0,0 +1,222 @@
+// Package remotetest provides an in-memory WebDriver remote end for tests.
+package remotetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/remote/connection"
+)
+
+// Request is a command received by the remote end.
+type Request struct {
+	// Body is the decoded JSON body, nil for requests without one.
+	Body   map[string]interface{}
+	Method string
+	Path   string
+}
+
+// Server is a WebDriver remote end serving any number of sessions.
+//
+// New sessions get the IDs s1, s2, ... and report the alwaysMatch
+// capabilities they were requested with. Session commands without a handler
+// succeed with a null value, except window/handles, url and timeouts, which
+// report a single window on about:blank with the default timeouts. Commands
+// of deleted or killed sessions fail with 404.
+type Server struct {
+	*httptest.Server
+	mux      *http.ServeMux
+	handlers *http.ServeMux
+	sessions map[string]map[string]interface{}
+	requests []Request
+	next     int
+	mu       sync.Mutex
+}
+
+// NewServer starts a remote end that is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		Server:   nil,
+		mux:      http.NewServeMux(),
+		handlers: http.NewServeMux(),
+		sessions: make(map[string]map[string]interface{}),
+		requests: nil,
+		next:     0,
+		mu:       sync.Mutex{},
+	}
+
+	s.mux.HandleFunc("POST /session", s.newSession)
+	s.mux.HandleFunc("GET /session/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		caps := s.sessions[r.PathValue("id")]
+		s.mu.Unlock()
+
+		Reply(w, caps)
+	})
+	s.mux.HandleFunc("DELETE /session/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.Kill(r.PathValue("id"))
+		Reply(w, nil)
+	})
+	s.mux.HandleFunc("/session/{id}/{rest...}", func(w http.ResponseWriter, r *http.Request) {
+		switch r.PathValue("rest") {
+		case "window/handles":
+			Reply(w, []string{"w1"})
+		case "url":
+			Reply(w, "about:blank")
+		case "timeouts":
+			Reply(w, map[string]interface{}{"implicit": 0, "pageLoad": 300000, "script": 30000})
+		default:
+			Reply(w, nil)
+		}
+	})
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Conn creates a connection to the remote end.
+func (s *Server) Conn(t testing.TB) *connection.RemoteConnection {
+	t.Helper()
+
+	return s.ConnWithConfig(t, connection.NewClientConfig(s.URL))
+}
+
+// ConnWithConfig creates a connection to the remote end with the given config.
+//
+// The remote server address of config is set to the server URL.
+func (s *Server) ConnWithConfig(t testing.TB, config *connection.ClientConfig) *connection.RemoteConnection {
+	t.Helper()
+
+	config.RemoteServerAddr = s.URL
+
+	conn, err := connection.New(config)
+	require.NoError(t, err)
+
+	return conn
+}
+
+// Handle sets the handler for a http.ServeMux pattern, such as "GET /status".
+//
+// Handlers take precedence over the built-in session handlers, so
+// "POST /session" replaces session creation.
+func (s *Server) Handle(pattern string, handler http.HandlerFunc) {
+	s.handlers.HandleFunc(pattern, handler)
+}
+
+// Kill ends a session as if its browser crashed.
+func (s *Server) Kill(sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, sessionID)
+}
+
+// Live reports whether a session exists.
+func (s *Server) Live(sessionID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.sessions[sessionID]
+
+	return ok
+}
+
+// Requests returns every request received with the given method and path.
+func (s *Server) Requests(method, path string) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []Request
+
+	for _, r := range s.requests {
+		if r.Method == method && r.Path == path {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+// Reply writes a successful response with the given value.
+func Reply(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"value": value})
+}
+
+// serve records the request and rejects commands of unknown sessions.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	data, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	var body map[string]interface{}
+	_ = json.Unmarshal(data, &body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Body: body, Method: r.Method, Path: r.URL.Path})
+	s.mu.Unlock()
+
+	if id, ok := sessionID(r.URL.Path); ok && !s.Live(id) {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"value": map[string]interface{}{"error": "invalid session id", "message": id},
+		})
+
+		return
+	}
+
+	if handler, pattern := s.handlers.Handler(r); pattern != "" {
+		handler.ServeHTTP(w, r)
+
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// newSession creates a session with the requested alwaysMatch capabilities.
+func (s *Server) newSession(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Capabilities struct {
+			AlwaysMatch map[string]interface{} `json:"alwaysMatch"`
+		} `json:"capabilities"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&request)
+
+	caps := request.Capabilities.AlwaysMatch
+	if caps == nil {
+		caps = make(map[string]interface{})
+	}
+
+	s.mu.Lock()
+	s.next++
+	id := "s" + strconv.Itoa(s.next)
+	s.sessions[id] = caps
+	s.mu.Unlock()
+
+	Reply(w, map[string]interface{}{"sessionId": id, "capabilities": caps})
+}
+
+// sessionID returns the session ID of a session command path.
+func sessionID(path string) (string, bool) {
+	rest, ok := strings.CutPrefix(path, "/session/")
+	if !ok {
+		return "", false
+	}
+
+	id, _, _ := strings.Cut(rest, "/")
+
+	return id, id != ""
+}
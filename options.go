@@ -74,6 +74,7 @@ type Capabilities struct {
 	SetWindowRect             bool                     `json:"setWindowRect"`
 	IsDownloadsEnabled        bool                     `json:"se:downloadsEnabled"`
 	IsJavaScriptEnabled       bool                     `json:"javascriptEnabled"`
+	EnableBiDi                bool                     `json:"-"`
 }
 
 // BaseOptions represents the base options for all browser drivers.
@@ -91,27 +92,66 @@ func NewCapabilities() *BaseOptions {
 	}
 }
 
+// SetBrowserOptions sets the vendor specific options under the given capability key.
 func (o *BaseOptions) SetBrowserOptions(browserOptionsKey string, value map[string]interface{}) {
+	if o.Capabilities.BrowserOptions == nil {
+		o.Capabilities.BrowserOptions = make(map[string]interface{})
+	}
+
 	o.Capabilities.BrowserOptions[browserOptionsKey] = value
 }
 
 // ToCapabilities returns the capabilities as a map.
+//
+// Unset capabilities are omitted; drivers reject empty values such as an
+// empty pageLoadStrategy as invalid arguments. EnableBiDi is sent as
+// webSocketUrl: true, while BidiWebSocketURL only holds the URL the remote
+// end reports and is never sent.
+//
+//nolint:cyclop // Each capability is checked independently.
 func (o *BaseOptions) ToCapabilities() map[string]interface{} {
 	caps := make(map[string]interface{})
 
-	caps["browserName"] = o.Capabilities.BrowserName
-	caps["browserVersion"] = o.Capabilities.BrowserVersion
-	caps["platformName"] = o.Capabilities.PlatformName
-	caps["platform"] = o.Capabilities.Platform
-	caps["acceptInsecureCerts"] = o.Capabilities.AcceptInsecureCerts
-	caps["pageLoadStrategy"] = o.Capabilities.PageLoadStrategy
-	caps["strictFileInteractability"] = o.Capabilities.StrictFileInteractAbility
-	caps["proxy"] = o.Capabilities.Proxy.ToCapabilities()
-	caps["setWindowRect"] = o.Capabilities.SetWindowRect
-	caps["timeouts"] = o.Capabilities.Timeouts.ToCapabilities()
-	caps["unhandledPromptBehavior"] = o.Capabilities.UnhandledPromptBehavior
-	caps["webSocketUrl"] = o.Capabilities.BidiWebSocketURL
-	caps["se:downloadsEnabled"] = o.Capabilities.IsDownloadsEnabled
+	setString := func(key, value string) {
+		if value != "" {
+			caps[key] = value
+		}
+	}
+
+	setBool := func(key string, value bool) {
+		if value {
+			caps[key] = true
+		}
+	}
+
+	setString("browserVersion", o.Capabilities.BrowserVersion)
+	setString("platformName", o.Capabilities.PlatformName)
+	setString("platform", o.Capabilities.Platform)
+	setBool("acceptInsecureCerts", o.Capabilities.AcceptInsecureCerts)
+	setBool("strictFileInteractability", o.Capabilities.StrictFileInteractAbility)
+	setBool("setWindowRect", o.Capabilities.SetWindowRect)
+	setBool("se:downloadsEnabled", o.Capabilities.IsDownloadsEnabled)
+	setBool("webSocketUrl", o.Capabilities.EnableBiDi)
+
+	if o.Capabilities.BrowserName != "" {
+		caps["browserName"] = o.Capabilities.BrowserName
+	}
+
+	if o.Capabilities.PageLoadStrategy != "" {
+		caps["pageLoadStrategy"] = o.Capabilities.PageLoadStrategy
+	}
+
+	if o.Capabilities.UnhandledPromptBehavior != "" {
+		caps["unhandledPromptBehavior"] = o.Capabilities.UnhandledPromptBehavior
+	}
+
+	if o.Capabilities.Proxy != nil {
+		caps["proxy"] = o.Capabilities.Proxy.ToCapabilities()
+	}
+
+	if timeouts := o.Capabilities.Timeouts.ToCapabilities(); len(timeouts) > 0 {
+		caps["timeouts"] = timeouts
+	}
 
 	for k, v := range o.Capabilities.BrowserOptions {
 		caps[k] = v
@@ -92,3 +92,25 @@ func TestMarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseOptionsToCapabilities(t *testing.T) {
+	t.Parallel()
+
+	// Unset capabilities are omitted instead of being sent as empty values
+	assert.Equal(t, map[string]interface{}{}, selenium.NewCapabilities().ToCapabilities())
+
+	opts := selenium.NewCapabilities()
+	opts.Capabilities.BrowserName = selenium.Chrome
+	opts.Capabilities.PageLoadStrategy = selenium.Eager
+	opts.Capabilities.AcceptInsecureCerts = true
+	opts.Capabilities.EnableBiDi = true
+	opts.SetBrowserOptions("goog:chromeOptions", map[string]interface{}{"args": []string{"--headless=new"}})
+
+	assert.Equal(t, map[string]interface{}{
+		"browserName":         selenium.Chrome,
+		"pageLoadStrategy":    selenium.Eager,
+		"acceptInsecureCerts": true,
+		"webSocketUrl":        true,
+		"goog:chromeOptions":  map[string]interface{}{"args": []string{"--headless=new"}},
+	}, opts.ToCapabilities())
+}
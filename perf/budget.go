@@ -0,0 +1,141 @@
+package perf
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrBudgetExceeded is returned when metrics exceed a budget.
+var ErrBudgetExceeded = errors.New("performance budget exceeded")
+
+// Budget is a set of thresholds; zero thresholds are not checked.
+type Budget struct {
+	// Runtime limits CDP runtime metrics by name, such as JSHeapUsedSize.
+	Runtime                map[string]float64
+	LargestContentfulPaint time.Duration
+	FirstContentfulPaint   time.Duration
+	InteractionToNextPaint time.Duration
+	TimeToFirstByte        time.Duration
+	// Load limits the time until the load event ends.
+	Load                  time.Duration
+	CumulativeLayoutShift float64
+	// TransferSize limits the bytes transferred for the document and its resources.
+	TransferSize int64
+	// Requests limits the number of resources.
+	Requests int
+}
+
+// Violation is a metric over its threshold or a metric the page never produced.
+type Violation struct {
+	Metric string
+	// Actual and Limit are in milliseconds for durations.
+	Actual float64
+	Limit  float64
+	// Missing is set when the page never produced the metric; Actual is then zero.
+	Missing bool
+}
+
+// String describes the violation, such as "LCP 3100 > 2500" or "LCP missing, limit 2500".
+func (v Violation) String() string {
+	if v.Missing {
+		return fmt.Sprintf("%s missing, limit %g", v.Metric, v.Limit)
+	}
+
+	return fmt.Sprintf("%s %g > %g", v.Metric, v.Actual, v.Limit)
+}
+
+// BudgetError lists the violations of a budget.
+type BudgetError struct {
+	Violations []Violation
+}
+
+// Error lists the violations after ErrBudgetExceeded.
+func (e *BudgetError) Error() string {
+	violations := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		violations[i] = v.String()
+	}
+
+	return fmt.Sprintf("%v: %s", ErrBudgetExceeded, strings.Join(violations, ", "))
+}
+
+// Unwrap returns ErrBudgetExceeded, so errors.Is matches every BudgetError.
+func (e *BudgetError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// Check returns a *BudgetError when the metrics exceed the budget.
+//
+// A limited metric the page never produced is a violation too: LCP and FCP
+// before the page paints, TTFB and Load without a navigation entry or before
+// the load event ends, and runtime metrics missing from the metrics. INP is
+// zero, and passes, until the user interacts with the page.
+func (b Budget) Check(metrics *PageMetrics) error {
+	var violations []Violation
+
+	check := func(metric string, actual, limit float64) {
+		if limit > 0 && actual > limit {
+			violations = append(violations, Violation{Metric: metric, Actual: actual, Limit: limit, Missing: false})
+		}
+	}
+
+	missing := func(metric string, limit float64) {
+		if limit > 0 {
+			violations = append(violations, Violation{Metric: metric, Actual: 0, Limit: limit, Missing: true})
+		}
+	}
+
+	// checkProduced checks a duration that is zero until the page produces it
+	checkProduced := func(metric string, actual, limit time.Duration) {
+		if actual == 0 {
+			missing(metric, milliseconds(limit))
+
+			return
+		}
+
+		check(metric, milliseconds(actual), milliseconds(limit))
+	}
+
+	var load time.Duration
+	if metrics.Navigation != nil {
+		load = metrics.Navigation.LoadEventEnd
+	}
+
+	checkProduced("LCP", metrics.LargestContentfulPaint, b.LargestContentfulPaint)
+	checkProduced("FCP", metrics.FirstContentfulPaint, b.FirstContentfulPaint)
+	check("INP", milliseconds(metrics.InteractionToNextPaint), milliseconds(b.InteractionToNextPaint))
+	checkProduced("TTFB", metrics.TimeToFirstByte(), b.TimeToFirstByte)
+	checkProduced("Load", load, b.Load)
+	check("CLS", metrics.CumulativeLayoutShift, b.CumulativeLayoutShift)
+	check("TransferSize", float64(metrics.TransferSize()), float64(b.TransferSize))
+	check("Requests", float64(len(metrics.Resources)), float64(b.Requests))
+
+	names := make([]string, 0, len(b.Runtime))
+	for name := range b.Runtime {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if actual, ok := metrics.Runtime[name]; ok {
+			check(name, actual, b.Runtime[name])
+		} else {
+			missing(name, b.Runtime[name])
+		}
+	}
+
+	if len(violations) > 0 {
+		return &BudgetError{Violations: violations}
+	}
+
+	return nil
+}
+
+// milliseconds converts a duration to fractional milliseconds.
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,392 @@
+// Package perf collects page performance metrics and Web Vitals.
+//
+// Timings come from the Navigation, Resource and Paint Timing APIs. LCP, CLS
+// and INP are observed with a PerformanceObserver injected into the page, so
+// call Install right after navigating to capture every interaction. Chromium
+// runtime metrics are read with the CDP Performance.getMetrics command when
+// a CDP transport is available.
+package perf
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/bidi/cdp/performance"
+)
+
+// ErrInvalidResponse is returned when the page returns metrics of an unexpected shape.
+var ErrInvalidResponse = errors.New("invalid metrics response")
+
+// ScriptExecutor runs JavaScript in the page, such as a selenium.WebDriver.
+type ScriptExecutor interface {
+	ExecuteScript(ctx context.Context, script string, args []interface{}) (interface{}, error)
+}
+
+// cdpCommandExecutor runs CDP commands over WebDriver HTTP, such as a *chromium.Driver.
+type cdpCommandExecutor interface {
+	ExecuteCDPCommand(ctx context.Context, cmd string, params map[string]interface{}) (map[string]interface{}, error)
+}
+
+// observerScript installs the Web Vitals observers once per document and
+// returns the collected metrics.
+//
+// Buffered observers also report entries from before they were installed;
+// takeRecords flushes pending entries so the script can return synchronously.
+// CLS is the largest session window of layout shifts without recent input,
+// and INP the 98th percentile of the longest event of each interaction.
+const observerScript = `
+const s = window.__seleniumPerf || (() => {
+	const s = {lcp: 0, cls: 0, window: 0, windowStart: 0, windowLast: 0, interactions: {}, observers: []};
+	const interaction = e => {
+		if (e.interactionId) {
+			s.interactions[e.interactionId] = Math.max(s.interactions[e.interactionId] || 0, e.duration);
+		}
+	};
+	const handlers = {
+		'largest-contentful-paint': e => { s.lcp = e.renderTime || e.loadTime || e.startTime; },
+		'layout-shift': e => {
+			if (e.hadRecentInput) {
+				return;
+			}
+			if (s.window && e.startTime - s.windowLast < 1000 && e.startTime - s.windowStart < 5000) {
+				s.window += e.value;
+			} else {
+				s.window = e.value;
+				s.windowStart = e.startTime;
+			}
+			s.windowLast = e.startTime;
+			s.cls = Math.max(s.cls, s.window);
+		},
+		'event': interaction,
+		'first-input': interaction,
+	};
+	for (const type of Object.keys(handlers)) {
+		try {
+			const observer = new PerformanceObserver(list => list.getEntries().forEach(handlers[type]));
+			observer.observe(type === 'event' ? {type, buffered: true, durationThreshold: 16} : {type, buffered: true});
+			s.observers.push([observer, handlers[type]]);
+		} catch (e) {
+			// The entry type is not supported by this browser
+		}
+	}
+	window.__seleniumPerf = s;
+	return s;
+})();
+for (const [observer, handler] of s.observers) {
+	observer.takeRecords().forEach(handler);
+}
+const durations = Object.values(s.interactions).sort((a, b) => b - a);
+const navigation = performance.getEntriesByType('navigation')[0];
+return {
+	url: location.href,
+	navigation: navigation ? navigation.toJSON() : null,
+	resources: performance.getEntriesByType('resource').map(e => e.toJSON()),
+	paint: performance.getEntriesByType('paint').map(e => ({name: e.name, startTime: e.startTime})),
+	lcp: s.lcp,
+	cls: s.cls,
+	inp: durations.length ? durations[Math.min(durations.length - 1, Math.floor(durations.length / 50))] : 0,
+};
+`
+
+// NavigationTiming is the timing of the document request, relative to the start of the navigation.
+type NavigationTiming struct {
+	// Type is navigate, reload, back_forward or prerender.
+	Type                     string
+	DomainLookupStart        time.Duration
+	DomainLookupEnd          time.Duration
+	ConnectStart             time.Duration
+	SecureConnectionStart    time.Duration
+	ConnectEnd               time.Duration
+	RequestStart             time.Duration
+	ResponseStart            time.Duration
+	ResponseEnd              time.Duration
+	DOMInteractive           time.Duration
+	DOMContentLoadedEventEnd time.Duration
+	LoadEventEnd             time.Duration
+	// TransferSize is the size of the response including headers, zero when cached.
+	TransferSize int64
+}
+
+// ResourceTiming is the timing of a subresource request.
+type ResourceTiming struct {
+	// Name is the URL of the resource.
+	Name string
+	// InitiatorType is the element or API that requested it, such as img, script or fetch.
+	InitiatorType   string
+	StartTime       time.Duration
+	Duration        time.Duration
+	TransferSize    int64
+	EncodedBodySize int64
+	DecodedBodySize int64
+}
+
+// PageMetrics are the performance metrics of the current document.
+type PageMetrics struct {
+	// Navigation is nil for documents without a navigation entry, such as about:blank.
+	Navigation *NavigationTiming
+	// Runtime are the CDP Performance.getMetrics values, such as JSHeapUsedSize
+	// and ScriptDuration; nil when no CDP transport is available.
+	Runtime   map[string]float64
+	URL       string
+	Resources []ResourceTiming
+	// FirstPaint and FirstContentfulPaint are zero until the page paints.
+	FirstPaint           time.Duration
+	FirstContentfulPaint time.Duration
+	// LargestContentfulPaint is the render time of the largest image or text block.
+	LargestContentfulPaint time.Duration
+	// InteractionToNextPaint is zero until the user interacts with the page.
+	InteractionToNextPaint time.Duration
+	// CumulativeLayoutShift is the largest burst of unexpected layout shifts.
+	CumulativeLayoutShift float64
+}
+
+// TimeToFirstByte returns the time until the first byte of the document was received.
+func (m *PageMetrics) TimeToFirstByte() time.Duration {
+	if m.Navigation == nil {
+		return 0
+	}
+
+	return m.Navigation.ResponseStart
+}
+
+// TransferSize returns the bytes transferred for the document and its resources.
+func (m *PageMetrics) TransferSize() int64 {
+	var size int64
+	if m.Navigation != nil {
+		size = m.Navigation.TransferSize
+	}
+
+	for _, resource := range m.Resources {
+		size += resource.TransferSize
+	}
+
+	return size
+}
+
+// Option configures a Collector.
+type Option func(*Collector)
+
+// WithCDP reads runtime metrics over a CDP transport, such as a session from chromium.Driver.CDPSession.
+func WithCDP(session bidi.Transport) Option {
+	return func(c *Collector) {
+		c.cdp = session
+	}
+}
+
+// Collector collects the metrics of the page open in a driver.
+type Collector struct {
+	driver ScriptExecutor
+	cdp    bidi.Transport
+}
+
+// NewCollector creates a collector.
+//
+// Runtime metrics are read over the CDP transport given with WithCDP or, for
+// drivers with an ExecuteCDPCommand method such as *chromium.Driver, over
+// WebDriver HTTP.
+func NewCollector(driver ScriptExecutor, opts ...Option) *Collector {
+	c := &Collector{
+		driver: driver,
+		cdp:    nil,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Install injects the Web Vitals observers into the current document.
+//
+// Observers are lost on navigation. Collect installs them too, but only
+// interactions after Install are measured for INP.
+func (c *Collector) Install(ctx context.Context) error {
+	if _, err := c.driver.ExecuteScript(ctx, observerScript, nil); err != nil {
+		return fmt.Errorf("failed to install performance observers: %w", err)
+	}
+
+	return nil
+}
+
+// Collect returns the metrics of the current document.
+func (c *Collector) Collect(ctx context.Context) (*PageMetrics, error) {
+	value, err := c.driver.ExecuteScript(ctx, observerScript, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect performance metrics: %w", err)
+	}
+
+	metrics, err := decodeMetrics(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if metrics.Runtime, err = c.runtimeMetrics(ctx); err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// runtimeMetrics reads Performance.getMetrics, or returns nil without a CDP transport.
+func (c *Collector) runtimeMetrics(ctx context.Context) (map[string]float64, error) {
+	var values []performance.Metric
+
+	if c.cdp != nil {
+		if err := performance.Enable(ctx, c.cdp, performance.EnableParams{}); err != nil {
+			return nil, fmt.Errorf("failed to enable CDP performance metrics: %w", err)
+		}
+
+		result, err := performance.GetMetrics(ctx, c.cdp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CDP performance metrics: %w", err)
+		}
+
+		values = result.Metrics
+	} else if driver, ok := c.driver.(cdpCommandExecutor); ok {
+		if _, err := driver.ExecuteCDPCommand(ctx, "Performance.enable", nil); err != nil {
+			return nil, fmt.Errorf("failed to enable CDP performance metrics: %w", err)
+		}
+
+		response, err := driver.ExecuteCDPCommand(ctx, "Performance.getMetrics", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CDP performance metrics: %w", err)
+		}
+
+		var result performance.GetMetricsResult
+		if err := remarshal(response, &result); err != nil {
+			return nil, err
+		}
+
+		values = result.Metrics
+	} else {
+		return nil, nil
+	}
+
+	runtime := make(map[string]float64, len(values))
+	for _, metric := range values {
+		runtime[metric.Name] = metric.Value
+	}
+
+	return runtime, nil
+}
+
+// wireMetrics is the value returned by observerScript; times are in milliseconds.
+type wireMetrics struct {
+	Navigation *struct {
+		Type                     string  `json:"type"`
+		DomainLookupStart        float64 `json:"domainLookupStart"`
+		DomainLookupEnd          float64 `json:"domainLookupEnd"`
+		ConnectStart             float64 `json:"connectStart"`
+		SecureConnectionStart    float64 `json:"secureConnectionStart"`
+		ConnectEnd               float64 `json:"connectEnd"`
+		RequestStart             float64 `json:"requestStart"`
+		ResponseStart            float64 `json:"responseStart"`
+		ResponseEnd              float64 `json:"responseEnd"`
+		DOMInteractive           float64 `json:"domInteractive"`
+		DOMContentLoadedEventEnd float64 `json:"domContentLoadedEventEnd"`
+		LoadEventEnd             float64 `json:"loadEventEnd"`
+		TransferSize             int64   `json:"transferSize"`
+	} `json:"navigation"`
+	URL       string `json:"url"`
+	Resources []struct {
+		Name            string  `json:"name"`
+		InitiatorType   string  `json:"initiatorType"`
+		StartTime       float64 `json:"startTime"`
+		Duration        float64 `json:"duration"`
+		TransferSize    int64   `json:"transferSize"`
+		EncodedBodySize int64   `json:"encodedBodySize"`
+		DecodedBodySize int64   `json:"decodedBodySize"`
+	} `json:"resources"`
+	Paint []struct {
+		Name      string  `json:"name"`
+		StartTime float64 `json:"startTime"`
+	} `json:"paint"`
+	LCP float64 `json:"lcp"`
+	CLS float64 `json:"cls"`
+	INP float64 `json:"inp"`
+}
+
+// decodeMetrics converts the script result.
+func decodeMetrics(value interface{}) (*PageMetrics, error) {
+	var wire wireMetrics
+	if err := remarshal(value, &wire); err != nil {
+		return nil, err
+	}
+
+	metrics := &PageMetrics{
+		Navigation:             nil,
+		Runtime:                nil,
+		URL:                    wire.URL,
+		Resources:              make([]ResourceTiming, len(wire.Resources)),
+		FirstPaint:             0,
+		FirstContentfulPaint:   0,
+		LargestContentfulPaint: ms(wire.LCP),
+		InteractionToNextPaint: ms(wire.INP),
+		CumulativeLayoutShift:  wire.CLS,
+	}
+
+	if n := wire.Navigation; n != nil {
+		metrics.Navigation = &NavigationTiming{
+			Type:                     n.Type,
+			DomainLookupStart:        ms(n.DomainLookupStart),
+			DomainLookupEnd:          ms(n.DomainLookupEnd),
+			ConnectStart:             ms(n.ConnectStart),
+			SecureConnectionStart:    ms(n.SecureConnectionStart),
+			ConnectEnd:               ms(n.ConnectEnd),
+			RequestStart:             ms(n.RequestStart),
+			ResponseStart:            ms(n.ResponseStart),
+			ResponseEnd:              ms(n.ResponseEnd),
+			DOMInteractive:           ms(n.DOMInteractive),
+			DOMContentLoadedEventEnd: ms(n.DOMContentLoadedEventEnd),
+			LoadEventEnd:             ms(n.LoadEventEnd),
+			TransferSize:             n.TransferSize,
+		}
+	}
+
+	for i, r := range wire.Resources {
+		metrics.Resources[i] = ResourceTiming{
+			Name:            r.Name,
+			InitiatorType:   r.InitiatorType,
+			StartTime:       ms(r.StartTime),
+			Duration:        ms(r.Duration),
+			TransferSize:    r.TransferSize,
+			EncodedBodySize: r.EncodedBodySize,
+			DecodedBodySize: r.DecodedBodySize,
+		}
+	}
+
+	for _, paint := range wire.Paint {
+		switch paint.Name {
+		case "first-paint":
+			metrics.FirstPaint = ms(paint.StartTime)
+		case "first-contentful-paint":
+			metrics.FirstContentfulPaint = ms(paint.StartTime)
+		}
+	}
+
+	return metrics, nil
+}
+
+// remarshal decodes a generic JSON value into out.
+func remarshal(value, out interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	return nil
+}
+
+// ms converts fractional milliseconds.
+func ms(value float64) time.Duration {
+	return time.Duration(value * float64(time.Millisecond))
+}
@@ -0,0 +1,187 @@
+package perf_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium/bidi"
+	"github.com/Kcrong/selenium/perf"
+)
+
+// page is the value observerScript returns, as decoded by a WebDriver client.
+const page = `{
+	"url": "https://example.com/",
+	"navigation": {
+		"type": "navigate", "requestStart": 10.5, "responseStart": 120.25, "responseEnd": 130,
+		"domInteractive": 400, "loadEventEnd": 900, "transferSize": 2000
+	},
+	"resources": [
+		{"name": "https://example.com/app.js", "initiatorType": "script", "startTime": 150, "duration": 80, "transferSize": 30000},
+		{"name": "https://example.com/hero.jpg", "initiatorType": "img", "startTime": 160, "duration": 300, "transferSize": 120000}
+	],
+	"paint": [{"name": "first-paint", "startTime": 450}, {"name": "first-contentful-paint", "startTime": 500}],
+	"lcp": 1800.5,
+	"cls": 0.12,
+	"inp": 96
+}`
+
+// driver returns page from ExecuteScript.
+type driver struct {
+	scripts int
+}
+
+func (d *driver) ExecuteScript(context.Context, string, []interface{}) (interface{}, error) {
+	d.scripts++
+
+	var value interface{}
+	err := json.Unmarshal([]byte(page), &value)
+
+	return value, err
+}
+
+// cdpDriver also executes CDP commands over WebDriver, like *chromium.Driver.
+type cdpDriver struct {
+	driver
+	commands []string
+}
+
+func (d *cdpDriver) ExecuteCDPCommand(
+	_ context.Context, cmd string, _ map[string]interface{},
+) (map[string]interface{}, error) {
+	d.commands = append(d.commands, cmd)
+	if cmd != "Performance.getMetrics" {
+		return map[string]interface{}{}, nil
+	}
+
+	return map[string]interface{}{
+		"metrics": []interface{}{
+			map[string]interface{}{"name": "JSHeapUsedSize", "value": 4e6},
+			map[string]interface{}{"name": "Nodes", "value": 250.0},
+		},
+	}, nil
+}
+
+// transport answers CDP commands like a page session.
+type transport struct {
+	methods []string
+}
+
+func (t *transport) Execute(_ context.Context, method string, _ interface{}) (json.RawMessage, error) {
+	t.methods = append(t.methods, method)
+	if method != "Performance.getMetrics" {
+		return json.RawMessage(`{}`), nil
+	}
+
+	return json.RawMessage(`{"metrics": [{"name": "ScriptDuration", "value": 0.25}]}`), nil
+}
+
+func (t *transport) Protocol() bidi.Protocol {
+	return bidi.ProtocolCDP
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	d := &driver{}
+	collector := perf.NewCollector(d)
+	require.NoError(t, collector.Install(context.Background()))
+
+	metrics, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, d.scripts)
+
+	assert.Equal(t, "https://example.com/", metrics.URL)
+	require.NotNil(t, metrics.Navigation)
+	assert.Equal(t, "navigate", metrics.Navigation.Type)
+	assert.Equal(t, 10500*time.Microsecond, metrics.Navigation.RequestStart)
+	assert.Equal(t, 120250*time.Microsecond, metrics.TimeToFirstByte())
+	assert.Equal(t, 900*time.Millisecond, metrics.Navigation.LoadEventEnd)
+
+	require.Len(t, metrics.Resources, 2)
+	assert.Equal(t, "img", metrics.Resources[1].InitiatorType)
+	assert.Equal(t, 300*time.Millisecond, metrics.Resources[1].Duration)
+	assert.Equal(t, int64(152000), metrics.TransferSize())
+
+	assert.Equal(t, 450*time.Millisecond, metrics.FirstPaint)
+	assert.Equal(t, 500*time.Millisecond, metrics.FirstContentfulPaint)
+	assert.Equal(t, 1800500*time.Microsecond, metrics.LargestContentfulPaint)
+	assert.Equal(t, 96*time.Millisecond, metrics.InteractionToNextPaint)
+	assert.InDelta(t, 0.12, metrics.CumulativeLayoutShift, 1e-9)
+	assert.Nil(t, metrics.Runtime)
+}
+
+func TestCollectRuntime(t *testing.T) {
+	t.Parallel()
+
+	d := &cdpDriver{}
+	metrics, err := perf.NewCollector(d).Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Performance.enable", "Performance.getMetrics"}, d.commands)
+	assert.Equal(t, map[string]float64{"JSHeapUsedSize": 4e6, "Nodes": 250}, metrics.Runtime)
+
+	// A CDP transport takes precedence over WebDriver HTTP
+	session := &transport{}
+	d = &cdpDriver{}
+	metrics, err = perf.NewCollector(d, perf.WithCDP(session)).Collect(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, d.commands)
+	assert.Equal(t, []string{"Performance.enable", "Performance.getMetrics"}, session.methods)
+	assert.Equal(t, map[string]float64{"ScriptDuration": 0.25}, metrics.Runtime)
+}
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	metrics, err := perf.NewCollector(&cdpDriver{}).Collect(context.Background())
+	require.NoError(t, err)
+
+	passing := perf.Budget{
+		LargestContentfulPaint: 2500 * time.Millisecond,
+		CumulativeLayoutShift:  0.25,
+		Load:                   time.Second,
+		Runtime:                map[string]float64{"JSHeapUsedSize": 1e7},
+	}
+	require.NoError(t, passing.Check(metrics))
+
+	failing := perf.Budget{
+		LargestContentfulPaint: 2500 * time.Millisecond,
+		TimeToFirstByte:        100 * time.Millisecond,
+		CumulativeLayoutShift:  0.1,
+		Requests:               1,
+		Runtime:                map[string]float64{"Nodes": 200},
+	}
+	err = failing.Check(metrics)
+	require.ErrorIs(t, err, perf.ErrBudgetExceeded)
+
+	var budgetErr *perf.BudgetError
+	require.ErrorAs(t, err, &budgetErr)
+	assert.Equal(t, []perf.Violation{
+		{Metric: "TTFB", Actual: 120.25, Limit: 100},
+		{Metric: "CLS", Actual: 0.12, Limit: 0.1},
+		{Metric: "Requests", Actual: 2, Limit: 1},
+		{Metric: "Nodes", Actual: 250, Limit: 200},
+	}, budgetErr.Violations)
+	assert.Equal(t,
+		"performance budget exceeded: TTFB 120.25 > 100, CLS 0.12 > 0.1, Requests 2 > 1, Nodes 250 > 200",
+		err.Error())
+
+	// Metrics the page never produced fail their limits instead of counting as zero
+	blank := &perf.PageMetrics{URL: "about:blank"}
+	err = passing.Check(blank)
+	require.ErrorAs(t, err, &budgetErr)
+	assert.Equal(t, []perf.Violation{
+		{Metric: "LCP", Limit: 2500, Missing: true},
+		{Metric: "Load", Limit: 1000, Missing: true},
+		{Metric: "JSHeapUsedSize", Limit: 1e7, Missing: true},
+	}, budgetErr.Violations)
+	assert.Equal(t,
+		"performance budget exceeded: LCP missing, limit 2500, Load missing, limit 1000, JSHeapUsedSize missing, limit 1e+07",
+		err.Error())
+
+	require.NoError(t, perf.Budget{InteractionToNextPaint: 200 * time.Millisecond}.Check(blank))
+}
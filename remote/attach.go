@@ -0,0 +1,91 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/remote/command"
+	"github.com/Kcrong/selenium/remote/connection"
+)
+
+// ErrSessionNotAlive is returned when attaching to a session that no longer exists.
+var ErrSessionNotAlive = errors.New("session is not alive")
+
+// Attach creates a WebDriver for an existing session without creating a new one.
+//
+// The session is validated with a cheap W3C command. Capabilities are fetched
+// when the remote end supports GET /session/{id}; otherwise GetCapabilities
+// returns an empty capability set.
+//
+// Example usage:
+//
+//	driver, err := remote.Attach(ctx, conn, sessionID)
+//	defer driver.Detach()
+func Attach(ctx context.Context, conn *connection.RemoteConnection, sessionID string) (*WebDriver, error) {
+	if conn == nil {
+		return nil, errors.New("connection cannot be nil")
+	}
+
+	if sessionID == "" {
+		return nil, ErrFailedToGetSessionID
+	}
+
+	//nolint:exhaustruct // Capabilities are fetched below.
+	driver := &WebDriver{
+		conn:         conn,
+		sessionID:    sessionID,
+		capabilities: selenium.RawConvertible{},
+	}
+
+	// Every W3C remote end implements Get Timeouts for a live session
+	if _, err := driver.Execute(ctx, command.GetTimeouts, nil); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrSessionNotAlive, sessionID, err)
+	}
+
+	if caps, err := driver.fetchCapabilities(ctx); err == nil {
+		driver.mu.Lock()
+		driver.capabilities = caps
+		driver.mu.Unlock()
+	}
+
+	return driver, nil
+}
+
+// fetchCapabilities reads the capabilities of the current session from the remote end.
+func (d *WebDriver) fetchCapabilities(ctx context.Context) (selenium.RawConvertible, error) {
+	response, err := d.Execute(ctx, command.GetSessionCapabilities, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetCapabilities, err)
+	}
+
+	value, ok := response["value"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrFailedToGetCapabilities, response)
+	}
+
+	// Some remote ends wrap the capabilities like the New Session response
+	if caps, ok := value["capabilities"].(map[string]interface{}); ok {
+		return caps, nil
+	}
+
+	return value, nil
+}
+
+// Detach forgets the current session without deleting it and returns its ID.
+//
+// The session keeps running on the remote end and can be attached to again.
+// A driver service owned by the driver is left running; use Service to stop it.
+func (d *WebDriver) Detach() string {
+	d.lifecycleMu.Lock()
+	defer d.lifecycleMu.Unlock()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	sessionID := d.sessionID
+	d.sessionID = ""
+
+	return sessionID
+}
@@ -0,0 +1,33 @@
+package remote_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote"
+)
+
+func TestAttach(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := remotetest.NewServer(t).Conn(t)
+
+	driver, err := remote.New(ctx, conn, selenium.RawConvertible{"browserName": "chrome"})
+	require.NoError(t, err)
+	assert.Equal(t, "s1", driver.Detach())
+	assert.Empty(t, driver.GetSessionID())
+
+	attached, err := remote.Attach(ctx, conn, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, "s1", attached.GetSessionID())
+	assert.Equal(t, "chrome", attached.GetCapabilities().ToCapabilities()["browserName"])
+
+	_, err = remote.Attach(ctx, conn, "gone")
+	require.ErrorIs(t, err, remote.ErrSessionNotAlive)
+}
@@ -6,9 +6,10 @@ type Command string
 
 // Session commands
 const (
-	NewSession    Command = "newSession"
-	DeleteSession Command = "deleteSession"
-	Quit          Command = "quit"
+	NewSession             Command = "newSession"
+	DeleteSession          Command = "deleteSession"
+	Quit                   Command = "quit"
+	GetSessionCapabilities Command = "getSessionCapabilities"
 )
 
 // Navigation commands
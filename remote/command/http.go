@@ -14,9 +14,10 @@ type EndPointMapType map[Command]Endpoint
 
 // EndpointMap maps commands to their HTTP method and path
 var EndpointMap = EndPointMapType{
-	NewSession:    {http.MethodPost, "/session"},
-	DeleteSession: {http.MethodDelete, "/session/$sessionId"},
-	Quit:          {http.MethodDelete, "/session/$sessionId"},
+	NewSession:             {http.MethodPost, "/session"},
+	DeleteSession:          {http.MethodDelete, "/session/$sessionId"},
+	Quit:                   {http.MethodDelete, "/session/$sessionId"},
+	GetSessionCapabilities: {http.MethodGet, "/session/$sessionId"},
 
 	GetCurrentURL: {http.MethodGet, "/session/$sessionId/url"},
 	Get:           {http.MethodPost, "/session/$sessionId/url"},
@@ -14,6 +14,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Kcrong/selenium/remote/command"
@@ -62,6 +63,9 @@ func NewClientConfig(remoteServerAddr string) *ClientConfig {
 }
 
 // RemoteConnection represents a connection to a remote WebDriver server
+//
+// A RemoteConnection is safe for concurrent use; commands may be added while
+// other goroutines execute commands.
 type RemoteConnection struct {
 	client       *http.Client
 	config       *ClientConfig
@@ -69,6 +73,7 @@ type RemoteConnection struct {
 	proxyURL     *url.URL
 	proxyAuth    string
 	extraHeaders map[string]string
+	mu           sync.RWMutex
 }
 
 // New creates a new RemoteConnection
@@ -122,6 +127,9 @@ func New(config *ClientConfig) (*RemoteConnection, error) {
 
 // AddCommand adds a new command to the command map
 func (rc *RemoteConnection) AddCommand(cmd command.Command, method, path string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
 	rc.commandMap[cmd] = command.Endpoint{
 		Method: method,
 		Path:   path,
@@ -130,6 +138,9 @@ func (rc *RemoteConnection) AddCommand(cmd command.Command, method, path string)
 
 // GetEndpoint returns the command endpoint for the given command.
 func (rc *RemoteConnection) GetEndpoint(cmd command.Command) (command.Endpoint, bool) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
 	info, ok := rc.commandMap[cmd]
 	return info, ok
 }
@@ -137,6 +148,23 @@ func (rc *RemoteConnection) GetEndpoint(cmd command.Command) (command.Endpoint,
 // Execute executes a command with the given parameters
 func (rc *RemoteConnection) Execute(
 	ctx context.Context, cmd command.Command, params map[string]interface{},
+) (map[string]interface{}, error) {
+	return rc.execute(ctx, cmd, "", params)
+}
+
+// ExecuteSession executes a command in the given session
+//
+// The session ID only replaces $sessionId in the endpoint path; it is not
+// sent in the request body. A "sessionId" in params takes precedence.
+func (rc *RemoteConnection) ExecuteSession(
+	ctx context.Context, sessionID string, cmd command.Command, params map[string]interface{},
+) (map[string]interface{}, error) {
+	return rc.execute(ctx, cmd, sessionID, params)
+}
+
+// execute sends a command, substituting params and then sessionID into the endpoint path
+func (rc *RemoteConnection) execute(
+	ctx context.Context, cmd command.Command, sessionID string, params map[string]interface{},
 ) (map[string]interface{}, error) {
 	cmdInfo, ok := rc.GetEndpoint(cmd)
 	if !ok {
@@ -148,6 +176,10 @@ func (rc *RemoteConnection) Execute(
 		path = strings.ReplaceAll(path, fmt.Sprintf("$%s", k), fmt.Sprint(v))
 	}
 
+	if sessionID != "" {
+		path = strings.ReplaceAll(path, "$sessionId", sessionID)
+	}
+
 	uri := fmt.Sprintf("%s%s", rc.config.RemoteServerAddr, path)
 
 	var body io.Reader
@@ -188,6 +220,16 @@ func (rc *RemoteConnection) Execute(
 	return result, nil
 }
 
+// DecodeValue decodes the W3C "value" member of a response into out
+func DecodeValue(response map[string]interface{}, out interface{}) error {
+	data, err := json.Marshal(response["value"])
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, out)
+}
+
 // Close closes the connection
 func (rc *RemoteConnection) Close() error {
 	rc.client.CloseIdleConnections()
@@ -0,0 +1,67 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/remote/connection"
+)
+
+// ErrFailedToStartService is returned when the local driver service cannot be started.
+var ErrFailedToStartService = errors.New("failed to start driver service")
+
+// NewLocal starts the given driver service and creates a new session on it.
+//
+// The returned driver owns the service: Quit deletes the session, stops the
+// service and closes idle connections. If the session cannot be created the
+// service is stopped before returning.
+func NewLocal(ctx context.Context, service *selenium.Service, caps selenium.Convertible) (*WebDriver, error) {
+	if service == nil {
+		return nil, errors.New("service cannot be nil")
+	}
+
+	if !service.IsRunning() {
+		if err := service.Start(ctx); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrFailedToStartService, err)
+		}
+	}
+
+	conn, err := connection.New(connection.NewClientConfig(service.URL()))
+	if err != nil {
+		return nil, errors.Join(err, service.Stop(context.WithoutCancel(ctx)))
+	}
+
+	driver, err := New(ctx, conn, caps)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, errors.Join(err, service.Stop(context.WithoutCancel(ctx)))
+	}
+
+	driver.mu.Lock()
+	driver.service = service
+	driver.mu.Unlock()
+
+	return driver, nil
+}
+
+// Service returns the local driver service owned by the driver, or nil for remote sessions.
+func (d *WebDriver) Service() *selenium.Service {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.service
+}
+
+// stopService stops an owned driver service and closes idle connections.
+func stopService(ctx context.Context, service *selenium.Service, conn *connection.RemoteConnection) error {
+	var err error
+
+	if stopErr := service.Stop(ctx); stopErr != nil {
+		err = fmt.Errorf("failed to stop service: %w", stopErr)
+	}
+
+	return errors.Join(err, conn.Close())
+}
@@ -0,0 +1,107 @@
+package remote_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote"
+)
+
+// fakeDriverEnv holds the remote end the fake driver forwards commands to.
+const fakeDriverEnv = "SELENIUM_FAKE_DRIVER_URL"
+
+func TestMain(m *testing.M) {
+	if target := os.Getenv(fakeDriverEnv); target != "" {
+		runFakeDriver(target)
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+// runFakeDriver serves /status on the --port argument and forwards every other request to target.
+func runFakeDriver(target string) {
+	var port string
+
+	for _, arg := range os.Args[1:] {
+		if value, ok := strings.CutPrefix(arg, "--port="); ok {
+			port = value
+		}
+	}
+
+	remoteEnd, err := url.Parse(target)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"value":{"ready":true,"message":"ready"}}`)
+	})
+	mux.Handle("/", httputil.NewSingleHostReverseProxy(remoteEnd))
+
+	//nolint:gosec // Test helper without timeouts.
+	_ = http.ListenAndServe("localhost:"+port, mux)
+}
+
+func TestNewLocalConcurrentQuit(t *testing.T) {
+	t.Parallel()
+
+	server := remotetest.NewServer(t)
+	server.Handle("GET /session/{id}/screenshot", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, base64.StdEncoding.EncodeToString([]byte("png")))
+	})
+
+	executable, err := os.Executable()
+	require.NoError(t, err)
+
+	service := selenium.NewService(executable,
+		selenium.WithEnv(map[string]string{fakeDriverEnv: server.URL}),
+		selenium.WithShutdownTimeout(time.Second),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	driver, err := remote.NewLocal(ctx, service, selenium.RawConvertible{"browserName": "chrome"})
+	require.NoError(t, err)
+	require.True(t, service.IsRunning())
+
+	// Commands racing with Quit either succeed or fail, but never race on the session or service
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 10 {
+				_, _ = driver.Screenshot(ctx)
+				_, _ = driver.ExecuteScript(ctx, "return 1", []interface{}{})
+				_ = service.IsRunning()
+			}
+		}()
+	}
+
+	require.NoError(t, driver.Quit(ctx))
+	wg.Wait()
+
+	assert.False(t, service.IsRunning())
+	assert.Empty(t, driver.GetSessionID())
+	assert.False(t, server.Live("s1"))
+}
@@ -0,0 +1,484 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/remote/connection"
+)
+
+const (
+	// DefaultPoolSize is the default maximum number of sessions per capability set.
+	DefaultPoolSize = 4
+	// DefaultHealthCheckInterval is the default interval between idle session health checks.
+	DefaultHealthCheckInterval = 30 * time.Second
+)
+
+var (
+	// ErrPoolClosed is returned when acquiring a session from a closed pool.
+	ErrPoolClosed = errors.New("session pool is closed")
+	// ErrNotPooled is returned when releasing a driver that was not acquired from the pool.
+	ErrNotPooled = errors.New("driver was not acquired from this pool")
+)
+
+// ResetFunc restores a session to a clean state before it is reused.
+type ResetFunc func(ctx context.Context, driver *WebDriver) error
+
+// PoolConfig configures a SessionPool.
+type PoolConfig struct {
+	// Reset restores a released session; defaults to ResetSession.
+	Reset ResetFunc
+	// MaxSessions is the maximum number of sessions per capability set.
+	MaxSessions int
+	// IdleTimeout quits sessions that have been idle for longer; zero keeps them forever.
+	IdleTimeout time.Duration
+	// HealthCheckInterval is the interval between idle session health checks;
+	// a negative value disables health checks.
+	HealthCheckInterval time.Duration
+}
+
+// PoolMetrics reports the state and activity of a SessionPool.
+type PoolMetrics struct {
+	// Created is the number of sessions created.
+	Created int
+	// Reused is the number of acquisitions served by an idle session.
+	Reused int
+	// Evicted is the number of sessions quit because they were broken, could not be reset or expired.
+	Evicted int
+	// Idle is the number of sessions waiting to be acquired.
+	Idle int
+	// InUse is the number of acquired sessions.
+	InUse int
+	// Waits is the number of acquisitions that had to wait for a free slot.
+	Waits int
+}
+
+// idleSession is a session waiting in a pool bucket.
+type idleSession struct {
+	since  time.Time
+	driver *WebDriver
+}
+
+// poolBucket holds the sessions of one capability set.
+type poolBucket struct {
+	caps selenium.Convertible
+	// slots holds a token per acquired session and bounds the bucket size.
+	slots chan struct{}
+	idle  []idleSession
+}
+
+// SessionPool keeps sessions alive between tests and hands them out per capability set.
+//
+// A SessionPool is safe for concurrent use.
+//
+// Example usage:
+//
+//	pool := remote.NewSessionPool(conn, remote.PoolConfig{MaxSessions: 8})
+//	defer pool.Close(ctx)
+//
+//	driver, err := pool.Acquire(ctx, opts)
+//	defer pool.Release(ctx, driver)
+type SessionPool struct {
+	conn     *connection.RemoteConnection
+	buckets  map[string]*poolBucket
+	inUse    map[*WebDriver]*poolBucket
+	stop     chan struct{}
+	config   PoolConfig
+	metrics  PoolMetrics
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	isClosed bool
+}
+
+// NewSessionPool creates a session pool on the given connection.
+func NewSessionPool(conn *connection.RemoteConnection, config PoolConfig) *SessionPool {
+	if config.MaxSessions <= 0 {
+		config.MaxSessions = DefaultPoolSize
+	}
+
+	if config.HealthCheckInterval == 0 {
+		config.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+
+	if config.Reset == nil {
+		config.Reset = ResetSession
+	}
+
+	//nolint:exhaustruct // Metrics and synchronization start at their zero values.
+	p := &SessionPool{
+		conn:    conn,
+		config:  config,
+		buckets: make(map[string]*poolBucket),
+		inUse:   make(map[*WebDriver]*poolBucket),
+		stop:    make(chan struct{}),
+	}
+
+	if config.HealthCheckInterval > 0 {
+		p.wg.Add(1)
+
+		go p.healthCheckLoop()
+	}
+
+	return p
+}
+
+// Acquire returns a session with the given capabilities.
+//
+// An idle session is reused when available, otherwise a new session is
+// created. When MaxSessions sessions are in use Acquire waits until one is
+// released or ctx is done.
+func (p *SessionPool) Acquire(ctx context.Context, caps selenium.Convertible) (*WebDriver, error) {
+	bucket, err := p.bucket(caps)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.acquireSlot(ctx, bucket); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	if n := len(bucket.idle); n > 0 {
+		driver := bucket.idle[n-1].driver
+		bucket.idle = bucket.idle[:n-1]
+		p.inUse[driver] = bucket
+		p.metrics.Reused++
+		p.mu.Unlock()
+
+		return driver, nil
+	}
+	p.mu.Unlock()
+
+	driver, err := New(ctx, p.conn, bucket.caps)
+	if err != nil {
+		<-bucket.slots
+
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.inUse[driver] = bucket
+	p.metrics.Created++
+	p.mu.Unlock()
+
+	return driver, nil
+}
+
+// Release resets the session and returns it to the pool.
+//
+// Sessions that cannot be reset are evicted. After Close, released sessions are quit.
+func (p *SessionPool) Release(ctx context.Context, driver *WebDriver) error {
+	bucket, err := p.take(driver)
+	if err != nil {
+		return err
+	}
+
+	defer func() { <-bucket.slots }()
+
+	if p.closed() {
+		return driver.Quit(ctx)
+	}
+
+	if err := p.config.Reset(ctx, driver); err != nil {
+		return errors.Join(fmt.Errorf("failed to reset session: %w", err), p.evict(ctx, driver))
+	}
+
+	return p.putIdle(ctx, bucket, idleSession{since: time.Now(), driver: driver})
+}
+
+// Discard quits an acquired session instead of returning it to the pool.
+func (p *SessionPool) Discard(ctx context.Context, driver *WebDriver) error {
+	bucket, err := p.take(driver)
+	if err != nil {
+		return err
+	}
+
+	defer func() { <-bucket.slots }()
+
+	return p.evict(ctx, driver)
+}
+
+// Metrics returns a snapshot of the pool metrics.
+func (p *SessionPool) Metrics() PoolMetrics {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	metrics := p.metrics
+	metrics.InUse = len(p.inUse)
+
+	for _, bucket := range p.buckets {
+		metrics.Idle += len(bucket.idle)
+	}
+
+	return metrics
+}
+
+// Close stops health checks and quits all idle sessions.
+//
+// Sessions still in use or being health checked are quit when they are
+// released or their check completes.
+func (p *SessionPool) Close(ctx context.Context) error {
+	p.mu.Lock()
+	if p.isClosed {
+		p.mu.Unlock()
+
+		return nil
+	}
+
+	p.isClosed = true
+	close(p.stop)
+
+	var idle []*WebDriver
+
+	for _, bucket := range p.buckets {
+		for _, session := range bucket.idle {
+			idle = append(idle, session.driver)
+		}
+
+		bucket.idle = nil
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+
+	var errs []error
+	for _, driver := range idle {
+		errs = append(errs, driver.Quit(ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+// bucket returns the bucket for the given capabilities, creating it if needed.
+func (p *SessionPool) bucket(caps selenium.Convertible) (*poolBucket, error) {
+	data, err := json.Marshal(selenium.Marshal(caps))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToConvertCapabilities, err)
+	}
+
+	key := string(data)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.isClosed {
+		return nil, ErrPoolClosed
+	}
+
+	bucket, ok := p.buckets[key]
+	if !ok {
+		bucket = &poolBucket{
+			caps:  caps,
+			slots: make(chan struct{}, p.config.MaxSessions),
+			idle:  nil,
+		}
+		p.buckets[key] = bucket
+	}
+
+	return bucket, nil
+}
+
+// acquireSlot waits for a free slot in the bucket.
+func (p *SessionPool) acquireSlot(ctx context.Context, bucket *poolBucket) error {
+	select {
+	case bucket.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	p.mu.Lock()
+	p.metrics.Waits++
+	p.mu.Unlock()
+
+	select {
+	case bucket.slots <- struct{}{}:
+		return nil
+	case <-p.stop:
+		return ErrPoolClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// take removes an acquired driver from the in-use set and returns its bucket.
+func (p *SessionPool) take(driver *WebDriver) (*poolBucket, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bucket, ok := p.inUse[driver]
+	if !ok {
+		return nil, ErrNotPooled
+	}
+
+	delete(p.inUse, driver)
+
+	return bucket, nil
+}
+
+// closed reports whether the pool has been closed.
+func (p *SessionPool) closed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.isClosed
+}
+
+// putIdle returns a session to the idle list of its bucket, or quits it once the pool is closed.
+func (p *SessionPool) putIdle(ctx context.Context, bucket *poolBucket, session idleSession) error {
+	p.mu.Lock()
+	if p.isClosed {
+		p.mu.Unlock()
+
+		return session.driver.Quit(ctx)
+	}
+
+	bucket.idle = append(bucket.idle, session)
+	p.mu.Unlock()
+
+	return nil
+}
+
+// evict quits a session that is no longer usable.
+func (p *SessionPool) evict(ctx context.Context, driver *WebDriver) error {
+	p.mu.Lock()
+	p.metrics.Evicted++
+	p.mu.Unlock()
+
+	return driver.Quit(ctx)
+}
+
+// healthCheckLoop periodically checks idle sessions until the pool is closed.
+func (p *SessionPool) healthCheckLoop() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.CheckIdle(context.Background())
+		}
+	}
+}
+
+// CheckIdle evicts idle sessions that expired or no longer respond.
+//
+// It runs periodically in the background and can be called directly; Close
+// waits for running checks. After Close it does nothing.
+func (p *SessionPool) CheckIdle(ctx context.Context) {
+	p.mu.Lock()
+	if p.isClosed {
+		p.mu.Unlock()
+
+		return
+	}
+
+	p.wg.Add(1)
+	defer p.wg.Done()
+
+	buckets := make([]*poolBucket, 0, len(p.buckets))
+	for _, bucket := range p.buckets {
+		buckets = append(buckets, bucket)
+	}
+	p.mu.Unlock()
+
+	for _, bucket := range buckets {
+		p.checkBucket(ctx, bucket)
+	}
+}
+
+// checkBucket checks the idle sessions of a bucket one at a time.
+//
+// A session being checked holds a slot, so it never counts twice against MaxSessions.
+func (p *SessionPool) checkBucket(ctx context.Context, bucket *poolBucket) {
+	p.mu.Lock()
+	pending := len(bucket.idle)
+	p.mu.Unlock()
+
+	for range pending {
+		select {
+		case bucket.slots <- struct{}{}:
+		default:
+			// Every slot is in use, so there is nothing idle to check
+			return
+		}
+
+		p.mu.Lock()
+		if len(bucket.idle) == 0 {
+			p.mu.Unlock()
+			<-bucket.slots
+
+			return
+		}
+
+		// The oldest session is at the front
+		session := bucket.idle[0]
+		bucket.idle = bucket.idle[1:]
+		p.mu.Unlock()
+
+		expired := p.config.IdleTimeout > 0 && time.Since(session.since) > p.config.IdleTimeout
+		if expired || !isAlive(ctx, session.driver) {
+			_ = p.evict(ctx, session.driver)
+		} else {
+			_ = p.putIdle(ctx, bucket, session)
+		}
+
+		<-bucket.slots
+	}
+}
+
+// isAlive reports whether the session still responds.
+func isAlive(ctx context.Context, driver *WebDriver) bool {
+	_, err := driver.GetCurrentURL(ctx)
+
+	return err == nil
+}
+
+// ResetSession restores a session to a clean state.
+//
+// It clears the storage and cookies of the current page, closes every window
+// but the first and navigates to about:blank.
+func ResetSession(ctx context.Context, driver *WebDriver) error {
+	const clearStorage = "try { window.localStorage.clear(); window.sessionStorage.clear(); } catch (e) {}"
+
+	if _, err := driver.ExecuteScript(ctx, clearStorage, []interface{}{}); err != nil {
+		return fmt.Errorf("failed to clear storage: %w", err)
+	}
+
+	if err := driver.DeleteAllCookies(ctx); err != nil {
+		return fmt.Errorf("failed to delete cookies: %w", err)
+	}
+
+	handles, err := driver.GetWindowHandles(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(handles) == 0 {
+		return fmt.Errorf("%w: no open windows", ErrFailedToGetWindowHandles)
+	}
+
+	for _, handle := range handles[1:] {
+		if err := driver.SwitchToWindow(ctx, handle); err != nil {
+			return err
+		}
+
+		if err := driver.Close(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := driver.SwitchToWindow(ctx, handles[0]); err != nil {
+		return err
+	}
+
+	return driver.Get(ctx, "about:blank")
+}
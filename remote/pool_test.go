@@ -0,0 +1,105 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote"
+)
+
+func TestSessionPool(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := remotetest.NewServer(t)
+	conn := server.Conn(t)
+	pool := remote.NewSessionPool(conn, remote.PoolConfig{MaxSessions: 1, HealthCheckInterval: -1})
+	caps := selenium.RawConvertible{"browserName": "chrome"}
+
+	first, err := pool.Acquire(ctx, caps)
+	require.NoError(t, err)
+
+	// The only slot is taken, so a second acquisition waits until ctx is done
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	_, err = pool.Acquire(waitCtx, caps)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, pool.Release(ctx, first))
+	require.ErrorIs(t, pool.Release(ctx, first), remote.ErrNotPooled)
+
+	second, err := pool.Acquire(ctx, caps)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	require.NoError(t, pool.Release(ctx, second))
+
+	// A session that stopped responding is evicted by the health check
+	server.Kill(second.GetSessionID())
+	pool.CheckIdle(ctx)
+
+	third, err := pool.Acquire(ctx, caps)
+	require.NoError(t, err)
+	assert.NotSame(t, second, third)
+
+	assert.Equal(t, remote.PoolMetrics{Created: 2, Reused: 1, Evicted: 1, Idle: 0, InUse: 1, Waits: 1}, pool.Metrics())
+
+	require.NoError(t, pool.Close(ctx))
+	require.NoError(t, pool.Release(ctx, third))
+	assert.Empty(t, third.GetSessionID())
+
+	_, err = pool.Acquire(ctx, caps)
+	require.ErrorIs(t, err, remote.ErrPoolClosed)
+}
+
+func TestSessionPoolCloseDuringHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := remotetest.NewServer(t)
+	pool := remote.NewSessionPool(server.Conn(t), remote.PoolConfig{HealthCheckInterval: -1})
+	caps := selenium.RawConvertible{"browserName": "chrome"}
+
+	driver, err := pool.Acquire(ctx, caps)
+	require.NoError(t, err)
+	sessionID := driver.GetSessionID()
+	require.NoError(t, pool.Release(ctx, driver))
+
+	checking := make(chan struct{})
+	unblock := make(chan struct{})
+	server.Handle("GET /session/{id}/url", func(w http.ResponseWriter, _ *http.Request) {
+		close(checking)
+		<-unblock
+		remotetest.Reply(w, "about:blank")
+	})
+
+	go pool.CheckIdle(ctx)
+	<-checking
+
+	// Close runs while the health check holds the only idle session
+	closed := make(chan error, 1)
+	go func() { closed <- pool.Close(ctx) }()
+
+	require.Eventually(t, func() bool {
+		_, err := pool.Acquire(ctx, caps)
+
+		return errors.Is(err, remote.ErrPoolClosed)
+	}, time.Second, time.Millisecond)
+
+	close(unblock)
+	require.NoError(t, <-closed)
+
+	// The healthy session is quit instead of being returned to the closed pool
+	assert.False(t, server.Live(sessionID))
+	assert.Equal(t, 0, pool.Metrics().Idle)
+
+	pool.CheckIdle(ctx)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/Kcrong/selenium"
 	"github.com/Kcrong/selenium/remote/command"
@@ -36,10 +37,21 @@ type Session struct {
 }
 
 // WebDriver implements the WebDriver interface.
+//
+// A WebDriver is safe for concurrent use by multiple goroutines. Commands are
+// sent as they are issued and the remote end decides how to order them.
+// Session lifecycle operations (NewSession, DeleteSession and Quit) are
+// serialized with each other; commands issued while the session is being
+// deleted fail with "no active session" once the deletion has completed.
 type WebDriver struct {
 	capabilities selenium.Convertible
 	conn         *connection.RemoteConnection
+	service      *selenium.Service
 	sessionID    string
+	// mu guards capabilities, service and sessionID.
+	mu sync.RWMutex
+	// lifecycleMu serializes session creation and deletion.
+	lifecycleMu sync.Mutex
 }
 
 func (d *WebDriver) SetWindowRect(ctx context.Context, x, y, width, height int) error {
@@ -83,7 +95,7 @@ func (d *WebDriver) FindElement(ctx context.Context, by *selenium.By, value stri
 
 	if element, ok := response["value"].(map[string]interface{}); ok {
 		if elementID, ok := element["ELEMENT"].(string); ok {
-			return webelement.NewElement(elementID, d.sessionID, d.conn), nil
+			return webelement.NewElement(elementID, d.GetSessionID(), d.conn), nil
 		}
 	}
 
@@ -104,7 +116,7 @@ func (d *WebDriver) FindElements(ctx context.Context, by *selenium.By, value str
 		for i, element := range elements {
 			if elementMap, ok := element.(map[string]interface{}); ok {
 				if elementID, ok := elementMap["ELEMENT"].(string); ok {
-					result[i] = webelement.NewElement(elementID, d.sessionID, d.conn)
+					result[i] = webelement.NewElement(elementID, d.GetSessionID(), d.conn)
 
 					continue
 				}
@@ -127,7 +139,7 @@ func (d *WebDriver) GetActiveElement(ctx context.Context) (selenium.WebElement,
 
 	if element, ok := response["value"].(map[string]interface{}); ok {
 		if elementID, ok := element["ELEMENT"].(string); ok {
-			return webelement.NewElement(elementID, d.sessionID, d.conn), nil
+			return webelement.NewElement(elementID, d.GetSessionID(), d.conn), nil
 		}
 	}
 
@@ -186,18 +198,25 @@ func (d *WebDriver) DismissAlert(ctx context.Context) error {
 var _ selenium.WebDriver = (*WebDriver)(nil)
 
 // newSession creates a new browser session.
-func (d *WebDriver) newSession(ctx context.Context) (*Session, error) {
+func (d *WebDriver) newSession(ctx context.Context, caps selenium.Convertible) (*Session, error) {
 	params := map[string]interface{}{
 		"capabilities": map[string]interface{}{
-			"alwaysMatch": d.capabilities,
+			"alwaysMatch": selenium.Marshal(caps),
 		},
 	}
 
-	response, err := d.conn.Execute(ctx, "newSession", params)
+	response, err := d.conn.Execute(ctx, command.NewSession, params)
 	if err != nil {
 		return nil, err
 	}
 
+	// W3C remote ends wrap the session in "value"
+	if value, ok := response["value"].(map[string]interface{}); ok {
+		if _, ok := value["sessionId"]; ok {
+			response = value
+		}
+	}
+
 	sessionID, ok := response["sessionId"].(string)
 	if !ok {
 		return nil, ErrFailedToGetSessionID
@@ -214,69 +233,122 @@ func (d *WebDriver) newSession(ctx context.Context) (*Session, error) {
 	}, nil
 }
 
+// NewSession creates a new session with the desired capabilities.
 func (d *WebDriver) NewSession(ctx context.Context, capabilities selenium.Convertible) error {
-	if d.sessionID != "" {
+	d.lifecycleMu.Lock()
+	defer d.lifecycleMu.Unlock()
+
+	if d.GetSessionID() != "" {
 		return errors.New("session already exists")
 	}
 
-	d.capabilities = capabilities
-
-	session, err := d.newSession(ctx)
+	session, err := d.newSession(ctx, capabilities)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrFailedToCreateSession, err)
 	}
 
-	d.sessionID = session.SessionID
-	d.capabilities = session.Capabilities
+	d.setSession(session)
 
 	return nil
 }
 
+// setSession stores the ID and capabilities of the given session.
+func (d *WebDriver) setSession(session *Session) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sessionID = session.SessionID
+	d.capabilities = session.Capabilities
+}
+
 // Execute executes a WebDriver command.
+//
+// The current session ID is substituted into the endpoint path, not sent in params.
 func (d *WebDriver) Execute(ctx context.Context, cmd command.Command, params map[string]interface{}) (map[string]interface{}, error) {
-	if d.sessionID == "" {
+	sessionID := d.GetSessionID()
+	if sessionID == "" {
 		return nil, errors.New("no active session")
 	}
 
-	return d.conn.Execute(ctx, cmd, params)
+	return d.conn.ExecuteSession(ctx, sessionID, cmd, params)
+}
+
+// Connection returns the connection to the remote end.
+//
+// Browser specific drivers use it to register vendor commands with AddCommand.
+func (d *WebDriver) Connection() *connection.RemoteConnection {
+	return d.conn
 }
 
 // DeleteSession deletes the current session.
 func (d *WebDriver) DeleteSession(ctx context.Context) error {
-	if d.sessionID == "" {
+	d.lifecycleMu.Lock()
+	defer d.lifecycleMu.Unlock()
+
+	return d.deleteSession(ctx)
+}
+
+// deleteSession deletes the current session; the caller must hold lifecycleMu.
+func (d *WebDriver) deleteSession(ctx context.Context) error {
+	sessionID := d.GetSessionID()
+	if sessionID == "" {
 		return nil
 	}
 
-	_, err := d.Execute(ctx, "deleteSession", map[string]interface{}{
-		"sessionId": d.sessionID,
+	_, err := d.Execute(ctx, command.DeleteSession, map[string]interface{}{
+		"sessionId": sessionID,
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	d.mu.Lock()
+	d.sessionID = ""
+	d.mu.Unlock()
+
+	return nil
 }
 
 // Quit closes the browser and ends the session.
 func (d *WebDriver) Quit(ctx context.Context) error {
-	if d.sessionID == "" {
+	d.lifecycleMu.Lock()
+	defer d.lifecycleMu.Unlock()
+
+	if d.GetSessionID() == "" {
 		return nil
 	}
 
-	err := d.DeleteSession(ctx)
+	err := d.deleteSession(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete session: %w", err)
+		err = fmt.Errorf("failed to delete session: %w", err)
 	}
 
+	d.mu.Lock()
 	d.sessionID = ""
+	service := d.service
+	d.service = nil
+	d.mu.Unlock()
 
-	return nil
+	if service != nil {
+		return errors.Join(err, stopService(ctx, service, d.conn))
+	}
+
+	return err
 }
 
 // GetCapabilities returns the current session's capabilities.
 func (d *WebDriver) GetCapabilities() selenium.Convertible {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
 	return d.capabilities
 }
 
 // GetSessionID returns the current session ID.
 func (d *WebDriver) GetSessionID() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
 	return d.sessionID
 }
 
@@ -584,18 +656,17 @@ func New(ctx context.Context, conn *connection.RemoteConnection, caps selenium.C
 		return nil, errors.New("connection cannot be nil")
 	}
 
+	//nolint:exhaustruct // The session is set below.
 	driver := &WebDriver{
-		conn:         conn,
-		capabilities: caps,
+		conn: conn,
 	}
 
-	session, err := driver.newSession(ctx)
+	session, err := driver.newSession(ctx, caps)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateSession, err)
 	}
 
-	driver.sessionID = session.SessionID
-	driver.capabilities = session.Capabilities
+	driver.setSession(session)
 
 	return driver, nil
 }
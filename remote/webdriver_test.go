@@ -0,0 +1,118 @@
+package remote_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+	"github.com/Kcrong/selenium/chromium"
+	"github.com/Kcrong/selenium/firefox"
+	"github.com/Kcrong/selenium/internal/remotetest"
+	"github.com/Kcrong/selenium/remote"
+)
+
+func TestExecuteSessionIDInPathOnly(t *testing.T) {
+	t.Parallel()
+
+	server := remotetest.NewServer(t)
+	server.Handle("POST /session/{id}/execute/sync", func(w http.ResponseWriter, _ *http.Request) {
+		remotetest.Reply(w, 2)
+	})
+
+	driver, err := remote.New(context.Background(), server.Conn(t), selenium.RawConvertible{"browserName": "chrome"})
+	require.NoError(t, err)
+
+	value, err := driver.ExecuteScript(context.Background(), "return 1 + 1", []interface{}{})
+	require.NoError(t, err)
+	assert.InDelta(t, 2, value, 0)
+
+	requests := server.Requests(http.MethodPost, "/session/s1/execute/sync")
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]interface{}{"script": "return 1 + 1", "args": []interface{}{}}, requests[0].Body)
+}
+
+func TestNewSession(t *testing.T) {
+	t.Parallel()
+
+	opts := chromium.NewOptions()
+	opts.AddArgument("--headless=new")
+
+	// W3C remote ends wrap the new session in "value"
+	server := remotetest.NewServer(t)
+	driver, err := remote.New(context.Background(), server.Conn(t), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "s1", driver.GetSessionID())
+
+	requests := server.Requests(http.MethodPost, "/session")
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]interface{}{
+		"alwaysMatch": map[string]interface{}{
+			"browserName":        "chrome",
+			"goog:chromeOptions": map[string]interface{}{"args": []interface{}{"--headless=new"}},
+		},
+	}, pick(requests[0].Body["capabilities"], "browserName", "goog:chromeOptions"))
+
+	// Legacy remote ends return the session at the top level
+	legacy := remotetest.NewServer(t)
+	legacy.Handle("POST /session", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"sessionId": "legacy", "capabilities": map[string]interface{}{"browserName": "chrome"}, "value": nil,
+		})
+	})
+
+	driver, err = remote.New(context.Background(), legacy.Conn(t), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", driver.GetSessionID())
+}
+
+func TestNewSessionOmitsUnsetCapabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		options  selenium.Convertible
+		expected map[string]interface{}
+		name     string
+	}{
+		{
+			name:     "Chrome",
+			options:  chromium.NewOptions(),
+			expected: map[string]interface{}{"browserName": "chrome", "goog:chromeOptions": map[string]interface{}{}},
+		},
+		{
+			name:     "Firefox",
+			options:  firefox.NewOptions(),
+			expected: map[string]interface{}{"browserName": "firefox"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := remotetest.NewServer(t)
+			_, err := remote.New(context.Background(), server.Conn(t), tt.options)
+			require.NoError(t, err)
+
+			requests := server.Requests(http.MethodPost, "/session")
+			require.Len(t, requests, 1)
+			assert.Equal(t, map[string]interface{}{"alwaysMatch": tt.expected}, requests[0].Body["capabilities"])
+		})
+	}
+}
+
+// pick returns the alwaysMatch capabilities of a new session request limited to keys.
+func pick(capabilities interface{}, keys ...string) map[string]interface{} {
+	alwaysMatch, _ := capabilities.(map[string]interface{})["alwaysMatch"].(map[string]interface{})
+
+	picked := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		picked[key] = alwaysMatch[key]
+	}
+
+	return map[string]interface{}{"alwaysMatch": picked}
+}
@@ -11,6 +11,8 @@ import (
 )
 
 // webElement represents a remote DOM element.
+//
+// A webElement is immutable and safe for concurrent use.
 type webElement struct {
 	id      string
 	conn    *connection.RemoteConnection
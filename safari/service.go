@@ -0,0 +1,42 @@
+package safari
+
+import (
+	"strconv"
+
+	"github.com/Kcrong/selenium"
+)
+
+// DriverPathEnvKey is the environment variable that may hold the safaridriver path.
+const DriverPathEnvKey = "SE_SAFARIDRIVER"
+
+// Service manages a safaridriver server process.
+type Service struct {
+	*selenium.Service
+	// ExtraArgs are additional command line arguments passed verbatim.
+	ExtraArgs []string
+	// Diagnose enables safaridriver logging to ~/Library/Logs/com.apple.WebDriver.
+	Diagnose bool
+}
+
+var _ selenium.ArgsProvider = (*Service)(nil)
+
+// NewService creates a new safaridriver service.
+func NewService(path string, options ...selenium.ServiceOption) *Service {
+	//nolint:exhaustruct // Optional settings are configured by the user.
+	s := &Service{}
+	options = append([]selenium.ServiceOption{selenium.WithDriverPathEnvKey(DriverPathEnvKey)}, options...)
+	s.Service = selenium.NewService(path, append(options, selenium.WithArgsProvider(s))...)
+
+	return s
+}
+
+// Args returns the command line arguments to start the driver on the given port.
+func (s *Service) Args(port int) []string {
+	args := []string{"--port", strconv.Itoa(port)}
+
+	if s.Diagnose {
+		args = append(args, "--diagnose")
+	}
+
+	return append(args, s.ExtraArgs...)
+}
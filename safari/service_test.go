@@ -0,0 +1,20 @@
+package safari_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Kcrong/selenium/safari"
+)
+
+func TestServiceArgs(t *testing.T) {
+	t.Parallel()
+
+	svc := safari.NewService("/usr/bin/safaridriver")
+	assert.Equal(t, []string{"--port", "4444"}, svc.Args(4444))
+
+	svc.Diagnose = true
+	svc.ExtraArgs = []string{"--enable"}
+	assert.Equal(t, []string{"--port", "4444", "--diagnose", "--enable"}, svc.Args(4444))
+}
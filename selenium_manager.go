@@ -7,11 +7,20 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 )
 
 // SeleniumManager is a wrapper for getting information from the Selenium Manager binaries
 type SeleniumManager struct {
-	binaryPath string
+	// mu guards binaryPath, which is resolved lazily by concurrent BinaryPaths calls
+	mu                   sync.Mutex
+	binaryPath           string
+	cachePath            string
+	offline              bool
+	forceBrowserDownload bool
+	skipDriverInPath     bool
+	debug                bool
 }
 
 // LogEntry represents a log entry from the Selenium Manager
@@ -20,42 +29,158 @@ type LogEntry struct {
 	Message string `json:"message"`
 }
 
+// ManagerResult represents the assets resolved by the Selenium Manager
+type ManagerResult struct {
+	// DriverPath is the path to the driver binary
+	DriverPath string `json:"driver_path"`
+	// BrowserPath is the path to the browser binary, if known
+	BrowserPath string `json:"browser_path"`
+	// DriverVersion is the version of the driver, if reported
+	DriverVersion string `json:"driver_version,omitempty"`
+	// BrowserVersion is the version of the browser, if reported
+	BrowserVersion string `json:"browser_version,omitempty"`
+	// Message is the message reported with the result
+	Message string `json:"message,omitempty"`
+	// Code is the exit code reported with the result
+	Code int `json:"code"`
+}
+
 // ManagerOutput represents the output from the Selenium Manager
 type ManagerOutput struct {
-	Logs   []LogEntry        `json:"logs"`
-	Result map[string]string `json:"result"`
+	Logs   []LogEntry    `json:"logs"`
+	Result ManagerResult `json:"result"`
+}
+
+// ManagerOption is a function that configures a SeleniumManager
+type ManagerOption func(*SeleniumManager)
+
+// WithManagerBinaryPath sets the path to the Selenium Manager binary
+func WithManagerBinaryPath(path string) ManagerOption {
+	return func(sm *SeleniumManager) {
+		sm.binaryPath = path
+	}
+}
+
+// WithManagerCachePath sets the directory Selenium Manager stores drivers and browsers in
+func WithManagerCachePath(path string) ManagerOption {
+	return func(sm *SeleniumManager) {
+		sm.cachePath = path
+	}
+}
+
+// WithManagerOffline prevents Selenium Manager from using the network
+func WithManagerOffline(offline bool) ManagerOption {
+	return func(sm *SeleniumManager) {
+		sm.offline = offline
+	}
+}
+
+// WithManagerForceBrowserDownload makes Selenium Manager download the browser even if one is installed
+func WithManagerForceBrowserDownload(force bool) ManagerOption {
+	return func(sm *SeleniumManager) {
+		sm.forceBrowserDownload = force
+	}
+}
+
+// WithManagerSkipDriverInPath makes Selenium Manager ignore drivers found in PATH
+func WithManagerSkipDriverInPath(skip bool) ManagerOption {
+	return func(sm *SeleniumManager) {
+		sm.skipDriverInPath = skip
+	}
+}
+
+// WithManagerDebug enables debug logging of Selenium Manager
+func WithManagerDebug(debug bool) ManagerOption {
+	return func(sm *SeleniumManager) {
+		sm.debug = debug
+	}
+}
+
+// managerCache caches resolved paths in-process, keyed by the Selenium Manager binary and arguments
+var managerCache sync.Map
+
+// ClearManagerCache removes all cached Selenium Manager results
+func ClearManagerCache() {
+	managerCache.Clear()
 }
 
 // NewSeleniumManager creates a new SeleniumManager instance
-func NewSeleniumManager() *SeleniumManager {
-	return &SeleniumManager{}
+func NewSeleniumManager(options ...ManagerOption) *SeleniumManager {
+	sm := &SeleniumManager{}
+
+	for _, option := range options {
+		option(sm)
+	}
+
+	return sm
 }
 
 // BinaryPaths determines the locations of the requested assets
-func (sm *SeleniumManager) BinaryPaths(args []string) (map[string]string, error) {
+//
+// Results are cached per binary and set of arguments, so resolving the same
+// browser and version again does not run Selenium Manager.
+func (sm *SeleniumManager) BinaryPaths(args []string) (*ManagerResult, error) {
 	binary, err := sm.getBinary()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get selenium manager binary: %v", err)
 	}
 
-	// Prepare command arguments
-	cmdArgs := append([]string{binary}, args...)
+	cmdArgs := append(append([]string{}, args...), sm.flags()...)
+	key := strings.Join(append([]string{binary}, cmdArgs...), "\x00")
+
+	if cached, ok := managerCache.Load(key); ok {
+		result := *cached.(*ManagerResult)
+		return &result, nil
+	}
+
 	cmdArgs = append(cmdArgs,
 		"--language-binding", "go",
 		"--output", "json",
 	)
 
 	// Run the command
-	result, err := sm.run(cmdArgs)
+	result, err := sm.run(binary, cmdArgs)
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	managerCache.Store(key, result)
+
+	cached := *result
+	return &cached, nil
+}
+
+// flags returns the command line flags for the configured options
+func (sm *SeleniumManager) flags() []string {
+	var flags []string
+
+	if sm.cachePath != "" {
+		flags = append(flags, "--cache-path", sm.cachePath)
+	}
+	if sm.offline {
+		flags = append(flags, "--offline")
+	}
+	if sm.forceBrowserDownload {
+		flags = append(flags, "--force-browser-download")
+	}
+	if sm.skipDriverInPath {
+		flags = append(flags, "--skip-driver-in-path")
+	}
+	if sm.debug {
+		flags = append(flags, "--debug")
+	}
+
+	return flags
 }
 
 // getBinary determines the path of the correct Selenium Manager binary
+//
+// It looks at SE_MANAGER_PATH, next to the executable, in PATH and in the
+// user cache directory ($XDG_CACHE_HOME/selenium/selenium-manager), in that order.
 func (sm *SeleniumManager) getBinary() (string, error) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
 	if sm.binaryPath != "" {
 		return sm.binaryPath, nil
 	}
@@ -74,19 +199,29 @@ func (sm *SeleniumManager) getBinary() (string, error) {
 		return "", fmt.Errorf("unsupported platform/architecture combination: %s/%s", runtime.GOOS, runtime.GOARCH)
 	}
 
+	candidates := make([]string, 0, 3)
+
 	// Try to find the binary in the same directory as the current file
-	exePath, err := os.Executable()
-	if err != nil {
-		return "", fmt.Errorf("failed to get executable path: %v", err)
+	if exePath, err := os.Executable(); err == nil {
+		candidates = append(candidates, filepath.Join(filepath.Dir(exePath), "selenium-manager", binaryName))
+	}
+
+	if pathBinary, err := exec.LookPath(binaryName); err == nil {
+		candidates = append(candidates, pathBinary)
 	}
 
-	binaryPath := filepath.Join(filepath.Dir(exePath), "selenium-manager", binaryName)
-	if _, err := os.Stat(binaryPath); err == nil {
-		sm.binaryPath = binaryPath
-		return binaryPath, nil
+	if cacheDir, err := os.UserCacheDir(); err == nil {
+		candidates = append(candidates, filepath.Join(cacheDir, "selenium", "selenium-manager", binaryName))
 	}
 
-	return "", fmt.Errorf("unable to obtain working Selenium Manager binary at: %s", binaryPath)
+	for _, candidate := range candidates {
+		if isExecutable(candidate) {
+			sm.binaryPath = candidate
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to obtain working Selenium Manager binary, searched: %s", strings.Join(candidates, ", "))
 }
 
 // getBinaryName returns the binary name based on the current platform
@@ -105,29 +240,30 @@ func (sm *SeleniumManager) getBinaryName() string {
 }
 
 // run executes the Selenium Manager Binary
-func (sm *SeleniumManager) run(args []string) (map[string]string, error) {
-	cmd := exec.Command(args[0], args[1:]...)
+func (sm *SeleniumManager) run(binary string, args []string) (*ManagerResult, error) {
+	cmd := exec.Command(binary, args...)
 
-	// Execute command and capture output
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute selenium manager: %v\nOutput: %s", err, string(output))
-	}
+	// Execute command and capture output; stderr only carries diagnostics
+	output, runErr := cmd.Output()
 
 	// Parse JSON output
 	var managerOutput ManagerOutput
 	if err := json.Unmarshal(output, &managerOutput); err != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("failed to execute selenium manager: %v\nOutput: %s", runErr, string(output))
+		}
 		return nil, fmt.Errorf("failed to parse selenium manager output: %v", err)
 	}
 
 	// Process logs
 	sm.processLogs(managerOutput.Logs)
 
-	if cmd.ProcessState.ExitCode() != 0 {
-		return nil, fmt.Errorf("selenium manager exited with code %d", cmd.ProcessState.ExitCode())
+	if runErr != nil || cmd.ProcessState.ExitCode() != 0 {
+		return nil, fmt.Errorf("selenium manager exited with code %d: %s",
+			cmd.ProcessState.ExitCode(), managerOutput.Result.Message)
 	}
 
-	return managerOutput.Result, nil
+	return &managerOutput.Result, nil
 }
 
 // processLogs processes the log entries from the Selenium Manager
@@ -137,7 +273,9 @@ func (sm *SeleniumManager) processLogs(logs []LogEntry) {
 		case "WARN":
 			fmt.Printf("Warning: %s\n", log.Message)
 		case "DEBUG", "INFO":
-			fmt.Printf("Debug: %s\n", log.Message)
+			if sm.debug {
+				fmt.Printf("Debug: %s\n", log.Message)
+			}
 		}
 	}
 }
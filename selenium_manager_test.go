@@ -0,0 +1,113 @@
+package selenium_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+)
+
+// writeManager writes a fake Selenium Manager that reports driverPath and logs its calls.
+func writeManager(t *testing.T, dir, driverPath string) (string, string) {
+	t.Helper()
+
+	calls := filepath.Join(dir, "calls")
+	manager := filepath.Join(dir, "selenium-manager")
+
+	script := "#!/bin/sh\n" +
+		"echo \"$@\" >> " + calls + "\n" +
+		`echo '{"logs":[],"result":{"code":0,"message":"","driver_path":"` + driverPath + `","browser_path":"/bin/ls"}}'` + "\n"
+	require.NoError(t, os.WriteFile(manager, []byte(script), 0o700))
+
+	return manager, calls
+}
+
+func countCalls(t *testing.T, calls string) int {
+	t.Helper()
+
+	data, err := os.ReadFile(calls)
+	require.NoError(t, err)
+
+	return len(strings.Split(strings.TrimSpace(string(data)), "\n"))
+}
+
+func TestSeleniumManagerBinaryPaths(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	manager, calls := writeManager(t, dir, "/bin/sh")
+
+	sm := selenium.NewSeleniumManager(
+		selenium.WithManagerBinaryPath(manager),
+		selenium.WithManagerOffline(true),
+		selenium.WithManagerCachePath(dir),
+	)
+
+	// The browser name is unique to this test so the shared cache starts empty.
+	args := []string{"--browser", "test-manager-browser", "--browser-version", "120"}
+
+	for range 2 {
+		result, err := sm.BinaryPaths(args)
+		require.NoError(t, err)
+		assert.Equal(t, "/bin/sh", result.DriverPath)
+		assert.Equal(t, "/bin/ls", result.BrowserPath)
+	}
+
+	data, err := os.ReadFile(calls)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], "--cache-path "+dir+" --offline")
+	assert.Contains(t, lines[0], "--output json")
+}
+
+func TestSeleniumManagerCacheKeyIncludesBinary(t *testing.T) {
+	t.Parallel()
+
+	first, firstCalls := writeManager(t, t.TempDir(), "/bin/sh")
+	second, secondCalls := writeManager(t, t.TempDir(), "/bin/cat")
+
+	// The browser name is unique to this test so the shared cache starts empty.
+	args := []string{"--browser", "test-manager-binary-key"}
+
+	result, err := selenium.NewSeleniumManager(selenium.WithManagerBinaryPath(first)).BinaryPaths(args)
+	require.NoError(t, err)
+	assert.Equal(t, "/bin/sh", result.DriverPath)
+
+	result, err = selenium.NewSeleniumManager(selenium.WithManagerBinaryPath(second)).BinaryPaths(args)
+	require.NoError(t, err)
+	assert.Equal(t, "/bin/cat", result.DriverPath)
+
+	assert.Equal(t, 1, countCalls(t, firstCalls))
+	assert.Equal(t, 1, countCalls(t, secondCalls))
+}
+
+func TestSeleniumManagerConcurrentBinaryPaths(t *testing.T) {
+	manager, _ := writeManager(t, t.TempDir(), "/bin/sh")
+	t.Setenv("SE_MANAGER_PATH", manager)
+
+	sm := selenium.NewSeleniumManager()
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			result, err := sm.BinaryPaths([]string{"--browser", "test-manager-concurrent", "--browser-version", strconv.Itoa(i)})
+			assert.NoError(t, err)
+			assert.Equal(t, "/bin/sh", result.DriverPath)
+		}()
+	}
+
+	wg.Wait()
+}
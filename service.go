@@ -1,6 +1,9 @@
 package selenium
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -8,11 +11,14 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"syscall"
+	"sync"
 	"time"
 )
 
 // Service represents a driver service that manages a driver server process
+//
+// Start, Stop, Wait and IsRunning are safe for concurrent use; Start and Stop
+// are serialized with each other.
 type Service struct {
 	// Path to the executable
 	Path string
@@ -22,12 +28,56 @@ type Service struct {
 	Env map[string]string
 	// Process handle
 	process *os.Process
+	// Process group or job of the driver and its browsers
+	group *processGroup
 	// Command handle
 	cmd *exec.Cmd
 	// Log output writer
 	logOutput io.Writer
 	// Driver path environment key
 	driverPathEnvKey string
+	// Builds the driver specific command line arguments
+	argsProvider ArgsProvider
+	// Maximum time to wait for the driver to become ready
+	startTimeout time.Duration
+	// Time to wait after SIGTERM before the process group is killed
+	shutdownTimeout time.Duration
+	// Closed once the process has exited and been reaped
+	done chan struct{}
+	// Error returned by waiting on the process
+	waitErr error
+	// mu guards process, group, cmd, done and waitErr
+	mu sync.Mutex
+	// lifecycleMu serializes Start and Stop
+	lifecycleMu sync.Mutex
+}
+
+const (
+	// DefaultStartTimeout is the default time to wait for a driver to become ready
+	DefaultStartTimeout = 30 * time.Second
+	// DefaultShutdownTimeout is the default time to wait for a driver to exit gracefully
+	DefaultShutdownTimeout = 5 * time.Second
+)
+
+// groupPollInterval is how often Stop checks whether the browsers left by the driver have exited.
+const groupPollInterval = 50 * time.Millisecond
+
+var (
+	// ErrServiceAlreadyRunning is returned when starting a service that is already running
+	ErrServiceAlreadyRunning = errors.New("service is already running")
+	// ErrServiceExited is returned when the driver exits before it is ready
+	ErrServiceExited = errors.New("service process unexpectedly exited")
+	// ErrServiceNotReady is returned when the driver does not become ready in time
+	ErrServiceNotReady = errors.New("service did not become ready")
+)
+
+// ArgsProvider builds the command line arguments of a driver server.
+//
+// Driver specific services such as chrome.Service implement it and register
+// themselves with WithArgsProvider.
+type ArgsProvider interface {
+	// Args returns the command line arguments to start the driver on the given port.
+	Args(port int) []string
 }
 
 // ServiceOption is a function that configures a Service
@@ -61,12 +111,36 @@ func WithDriverPathEnvKey(key string) ServiceOption {
 	}
 }
 
+// WithStartTimeout sets the maximum time to wait for the service to become ready
+func WithStartTimeout(timeout time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.startTimeout = timeout
+	}
+}
+
+// WithShutdownTimeout sets the time to wait for a graceful shutdown before killing the service
+func WithShutdownTimeout(timeout time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.shutdownTimeout = timeout
+	}
+}
+
+// WithArgsProvider sets the provider of the driver command line arguments
+func WithArgsProvider(provider ArgsProvider) ServiceOption {
+	return func(s *Service) {
+		s.argsProvider = provider
+	}
+}
+
 // NewService creates a new Service instance
 func NewService(path string, options ...ServiceOption) *Service {
 	s := &Service{
 		Path: path,
 		Port: 0, // Will be assigned a free port
 		Env:  make(map[string]string),
+
+		startTimeout:    DefaultStartTimeout,
+		shutdownTimeout: DefaultShutdownTimeout,
 	}
 
 	// Apply options
@@ -77,12 +151,22 @@ func NewService(path string, options ...ServiceOption) *Service {
 	return s
 }
 
-// Start starts the Service
-func (s *Service) Start() error {
+// Start starts the driver process in its own process group and waits until it reports ready.
+//
+// Readiness is polled through the W3C /status endpoint until ctx is done or
+// the start timeout expires, whichever comes first.
+func (s *Service) Start(ctx context.Context) error {
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+
 	if s.Path == "" {
 		return fmt.Errorf("service path cannot be empty")
 	}
 
+	if s.IsRunning() {
+		return ErrServiceAlreadyRunning
+	}
+
 	// If port is 0, find a free port
 	if s.Port == 0 {
 		port, err := getFreePort()
@@ -95,6 +179,7 @@ func (s *Service) Start() error {
 	// Prepare command
 	args := s.CommandLineArgs()
 	cmd := exec.Command(s.Path, args...)
+	setProcessGroup(cmd)
 
 	// Set up environment
 	if len(s.Env) > 0 {
@@ -119,41 +204,161 @@ func (s *Service) Start() error {
 		return fmt.Errorf("failed to start process: %v", err)
 	}
 
+	group, err := newProcessGroup(cmd.Process)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+
+		return err
+	}
+
+	done := make(chan struct{})
+
+	s.mu.Lock()
 	s.cmd = cmd
 	s.process = cmd.Process
+	s.group = group
+	s.done = done
+	s.waitErr = nil
+	s.mu.Unlock()
 
-	// Wait for the service to be connectable
-	if err := s.waitUntilConnectable(); err != nil {
-		_ = s.Stop()
+	// Reap the process as soon as it exits so it never lingers as a zombie
+	go func() {
+		err := cmd.Wait()
+
+		s.mu.Lock()
+		s.waitErr = err
+		s.mu.Unlock()
+
+		close(done)
+	}()
+
+	// Wait for the service to be ready
+	startCtx, cancel := context.WithTimeout(ctx, s.startTimeout)
+	defer cancel()
+
+	if err := s.waitUntilReady(startCtx, cmd, done); err != nil {
+		stopCtx, stopCancel := context.WithTimeout(context.WithoutCancel(ctx), s.shutdownTimeout)
+		defer stopCancel()
+
+		_ = s.stop(stopCtx)
 		return err
 	}
 
 	return nil
 }
 
-// Stop stops the Service
-func (s *Service) Stop() error {
-	if s.process == nil {
+// Stop stops the driver process and every process in its group.
+//
+// The driver is first asked to shut down, then the process group is sent
+// SIGTERM. If the driver or any browser in its group has not exited after the
+// shutdown timeout or when ctx is done, the whole group is killed with
+// SIGKILL, even if the driver has already been reaped. Stop always waits for
+// the driver to be reaped.
+//
+// On Windows the driver and the browsers it starts run in a job object, which
+// is terminated instead of the process group.
+func (s *Service) Stop(ctx context.Context) error {
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+
+	return s.stop(ctx)
+}
+
+// stop stops the driver process; the caller must hold lifecycleMu
+func (s *Service) stop(ctx context.Context) error {
+	s.mu.Lock()
+	process, group, done := s.process, s.group, s.done
+	s.mu.Unlock()
+
+	if process == nil {
 		return nil
 	}
 
-	// Try to send shutdown command
-	s.sendRemoteShutdownCommand()
+	defer func() {
+		group.release()
+
+		s.mu.Lock()
+		s.process = nil
+		s.cmd = nil
+		s.group = nil
+		s.mu.Unlock()
+	}()
 
-	// Kill the process
-	if err := s.process.Kill(); err != nil {
-		return fmt.Errorf("failed to kill process: %v", err)
+	if s.IsRunning() {
+		// Try to send shutdown command
+		s.sendRemoteShutdownCommand(ctx)
+
+		if err := group.terminate(); err != nil && s.IsRunning() {
+			return fmt.Errorf("failed to terminate process: %v", err)
+		}
 	}
 
-	s.process = nil
-	s.cmd = nil
+	timer := time.NewTimer(s.shutdownTimeout)
+	defer timer.Stop()
+
+	expired := false
+
+	select {
+	case <-done:
+	case <-timer.C:
+		expired = true
+	case <-ctx.Done():
+		expired = true
+	}
+
+	// Browsers that outlive the driver get the rest of the timeout to exit
+	for !expired && group.alive() {
+		select {
+		case <-timer.C:
+			expired = true
+		case <-ctx.Done():
+			expired = true
+		case <-time.After(groupPollInterval):
+		}
+	}
+
+	if expired {
+		if err := group.kill(); err != nil {
+			return fmt.Errorf("failed to kill process: %v", err)
+		}
+	}
+
+	<-done
 	return nil
 }
 
-// CommandLineArgs returns the command line arguments for the service
-// This should be overridden by specific driver services
+// Wait blocks until the driver process exits and returns its exit error.
+func (s *Service) Wait() error {
+	s.mu.Lock()
+	done := s.done
+	s.mu.Unlock()
+
+	if done == nil {
+		return nil
+	}
+
+	<-done
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.waitErr
+}
+
+// CommandLineArgs returns the command line arguments for the service.
+// Without an ArgsProvider only the port is passed as --port=<port>.
 func (s *Service) CommandLineArgs() []string {
-	return []string{}
+	if s.argsProvider != nil {
+		return s.argsProvider.Args(s.Port)
+	}
+
+	return []string{fmt.Sprintf("--port=%d", s.Port)}
+}
+
+// DriverPathEnvKey returns the environment variable that may hold the driver path
+func (s *Service) DriverPathEnvKey() string {
+	return s.driverPathEnvKey
 }
 
 // URL returns the service URL
@@ -163,68 +368,98 @@ func (s *Service) URL() string {
 
 // IsRunning checks if the service is running
 func (s *Service) IsRunning() bool {
-	if s.process == nil {
+	s.mu.Lock()
+	process, done := s.process, s.done
+	s.mu.Unlock()
+
+	if process == nil || done == nil {
 		return false
 	}
 
-	// Check if process is still running
-	if err := s.process.Signal(syscall.Signal(0)); err != nil {
+	select {
+	case <-done:
 		return false
+	default:
+		return true
 	}
-
-	return true
 }
 
-// waitUntilConnectable waits until the service is connectable
-func (s *Service) waitUntilConnectable() error {
-	const maxAttempts = 70
+// waitUntilReady polls the /status endpoint until the driver reports ready
+func (s *Service) waitUntilReady(ctx context.Context, cmd *exec.Cmd, done <-chan struct{}) error {
 	const initialDelay = 10 * time.Millisecond
 	const maxDelay = 500 * time.Millisecond
 
-	for i := 0; i < maxAttempts; i++ {
-		// Check if process is still running
-		if !s.IsRunning() {
-			if s.cmd != nil && s.cmd.ProcessState != nil {
-				return fmt.Errorf("service process exited with status: %v", s.cmd.ProcessState.ExitCode())
-			}
-			return fmt.Errorf("service process unexpectedly exited")
-		}
+	client := &http.Client{Timeout: time.Second}
+	delay := initialDelay
 
-		// Try to connect
-		if isPortConnectable(s.Port) {
+	for {
+		if s.isReady(ctx, client) {
 			return nil
 		}
 
-		// Calculate delay with exponential backoff
-		delay := initialDelay + time.Duration(i)*50*time.Millisecond
-		if delay > maxDelay {
-			delay = maxDelay
+		select {
+		case <-done:
+			if cmd.ProcessState != nil {
+				return fmt.Errorf("%w with status: %v", ErrServiceExited, cmd.ProcessState.ExitCode())
+			}
+			return ErrServiceExited
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrServiceNotReady, ctx.Err())
+		case <-time.After(delay):
 		}
-		time.Sleep(delay)
+
+		delay = min(delay*2, maxDelay)
 	}
+}
 
-	return fmt.Errorf("failed to connect to the service after %d attempts", maxAttempts)
+// isReady reports whether the W3C /status endpoint of the driver returns ready
+func (s *Service) isReady(ctx context.Context, client *http.Client) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL()+"/status", nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+
+	var status struct {
+		Value struct {
+			Ready *bool `json:"ready"`
+		} `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return false
+	}
+
+	// Older drivers do not report readiness; a successful status is enough
+	return status.Value.Ready == nil || *status.Value.Ready
 }
 
 // sendRemoteShutdownCommand attempts to send a shutdown command to the service
-func (s *Service) sendRemoteShutdownCommand() {
+func (s *Service) sendRemoteShutdownCommand(ctx context.Context) {
 	client := &http.Client{
-		Timeout: 5 * time.Second,
+		Timeout: time.Second,
 	}
 
-	// Try to send shutdown command, ignore errors
-	_, _ = client.Get(fmt.Sprintf("%s/shutdown", s.URL()))
-}
-
-// isPortConnectable checks if a port is connectable
-func isPortConnectable(port int) bool {
-	addr := fmt.Sprintf("localhost:%d", port)
-	conn, err := net.DialTimeout("tcp", addr, time.Second)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL()+"/shutdown", nil)
 	if err != nil {
-		return false
+		return
+	}
+
+	// Try to send shutdown command, ignore errors
+	resp, err := client.Do(req)
+	if err == nil {
+		_ = resp.Body.Close()
 	}
-	_ = conn.Close()
-	return true
 }
 
 // getFreePort finds a free port on the system
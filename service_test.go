@@ -0,0 +1,201 @@
+package selenium_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kcrong/selenium"
+)
+
+const (
+	fakeDriverEnv = "SELENIUM_FAKE_DRIVER"
+	// fakeBrowserPIDEnv is the file the fake driver writes the PID of its browser to.
+	fakeBrowserPIDEnv = "SELENIUM_FAKE_BROWSER_PID"
+)
+
+func TestMain(m *testing.M) {
+	switch os.Getenv(fakeDriverEnv) {
+	case "":
+	case "exit":
+		os.Exit(1)
+	case "ignore-term":
+		signal.Ignore(syscall.SIGTERM)
+		time.Sleep(time.Minute)
+		os.Exit(0)
+	case "browser":
+		startFakeBrowser()
+		runFakeDriver()
+
+		return
+	default:
+		runFakeDriver()
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+// startFakeBrowser starts a child process that ignores SIGTERM, like a hung browser.
+func startFakeBrowser() {
+	executable, err := os.Executable()
+	if err != nil {
+		os.Exit(1)
+	}
+
+	browser := exec.Command(executable)
+	browser.Env = append(os.Environ(), fakeDriverEnv+"=ignore-term")
+
+	if err := browser.Start(); err != nil {
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(os.Getenv(fakeBrowserPIDEnv), []byte(strconv.Itoa(browser.Process.Pid)), 0o600); err != nil {
+		os.Exit(1)
+	}
+}
+
+// runFakeDriver serves a minimal W3C /status endpoint on the --port argument.
+func runFakeDriver() {
+	var port string
+
+	for _, arg := range os.Args[1:] {
+		if value, ok := strings.CutPrefix(arg, "--port="); ok {
+			port = value
+		}
+	}
+
+	http.HandleFunc("/status", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"value":{"ready":true,"message":"ready"}}`)
+	})
+
+	//nolint:gosec // Test helper without timeouts.
+	_ = http.ListenAndServe("localhost:"+port, nil)
+}
+
+func TestServiceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	executable, err := os.Executable()
+	require.NoError(t, err)
+
+	service := selenium.NewService(executable,
+		selenium.WithEnv(map[string]string{fakeDriverEnv: "1"}),
+		selenium.WithShutdownTimeout(time.Second),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, service.Start(ctx))
+	assert.True(t, service.IsRunning())
+	require.ErrorIs(t, service.Start(ctx), selenium.ErrServiceAlreadyRunning)
+
+	require.NoError(t, service.Stop(ctx))
+	assert.False(t, service.IsRunning())
+	require.NoError(t, service.Stop(ctx))
+}
+
+func TestServiceStartExited(t *testing.T) {
+	t.Parallel()
+
+	executable, err := os.Executable()
+	require.NoError(t, err)
+
+	// The fake driver exits before it is ready
+	service := selenium.NewService(executable, selenium.WithEnv(map[string]string{fakeDriverEnv: "exit"}))
+
+	err = service.Start(context.Background())
+	require.ErrorIs(t, err, selenium.ErrServiceExited)
+	assert.False(t, service.IsRunning())
+}
+
+func TestServiceStopKillsBrowsersAfterDriverExits(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS != "linux" {
+		t.Skip("reads the process state from /proc")
+	}
+
+	executable, err := os.Executable()
+	require.NoError(t, err)
+
+	pidFile := filepath.Join(t.TempDir(), "browser.pid")
+	service := selenium.NewService(executable,
+		selenium.WithEnv(map[string]string{fakeDriverEnv: "browser", fakeBrowserPIDEnv: pidFile}),
+		selenium.WithShutdownTimeout(500*time.Millisecond),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, service.Start(ctx))
+
+	data, err := os.ReadFile(pidFile)
+	require.NoError(t, err)
+
+	// The driver exits on SIGTERM but the browser ignores it
+	require.NoError(t, service.Stop(ctx))
+	assert.Eventually(t, func() bool {
+		stat, err := os.ReadFile("/proc/" + string(data) + "/stat")
+		if err != nil {
+			return true
+		}
+
+		_, state, _ := strings.Cut(string(stat), ") ")
+
+		return strings.HasPrefix(state, "Z")
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestServiceConcurrentStop(t *testing.T) {
+	t.Parallel()
+
+	executable, err := os.Executable()
+	require.NoError(t, err)
+
+	service := selenium.NewService(executable,
+		selenium.WithEnv(map[string]string{fakeDriverEnv: "1"}),
+		selenium.WithShutdownTimeout(time.Second),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, service.Start(ctx))
+
+	// Stop, Wait and IsRunning may be called from different goroutines
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, service.Stop(ctx))
+		}()
+
+		go func() {
+			defer wg.Done()
+			_ = service.IsRunning()
+			_ = service.Wait()
+		}()
+	}
+
+	wg.Wait()
+	assert.False(t, service.IsRunning())
+}
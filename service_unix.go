@@ -0,0 +1,58 @@
+//go:build unix
+
+package selenium
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// processGroup is the process group led by the driver.
+type processGroup struct {
+	pid int
+}
+
+// setProcessGroup starts the command in a new process group so that the
+// browsers spawned by the driver can be signalled together with it.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// newProcessGroup returns the group the started driver process leads.
+func newProcessGroup(process *os.Process) (*processGroup, error) {
+	return &processGroup{pid: process.Pid}, nil
+}
+
+// terminate asks the process group to exit with SIGTERM.
+func (g *processGroup) terminate() error {
+	return g.signal(syscall.SIGTERM)
+}
+
+// kill kills every process in the group with SIGKILL.
+func (g *processGroup) kill() error {
+	return g.signal(syscall.SIGKILL)
+}
+
+// alive reports whether any process of the group, such as a browser that
+// ignored SIGTERM, is still running.
+//
+// The group ID is not reused while the group has members, so it can still be
+// signalled after the driver has been reaped.
+func (g *processGroup) alive() bool {
+	return !errors.Is(syscall.Kill(-g.pid, 0), syscall.ESRCH)
+}
+
+// release is called once the driver has been reaped; a process group holds no resources.
+func (g *processGroup) release() {}
+
+// signal sends sig to the process group.
+func (g *processGroup) signal(sig syscall.Signal) error {
+	err := syscall.Kill(-g.pid, sig)
+	if errors.Is(err, syscall.ESRCH) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,126 @@
+//go:build windows
+
+package selenium
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	// jobObjectExtendedLimitInformation is the JOBOBJECTINFOCLASS of jobObjectExtendedLimit.
+	jobObjectExtendedLimitInformation = 9
+	// jobObjectLimitKillOnJobClose kills the processes of a job when its last handle is closed.
+	jobObjectLimitKillOnJobClose = 0x2000
+	// processAssignAccess is PROCESS_SET_QUOTA | PROCESS_TERMINATE.
+	processAssignAccess = 0x0100 | 0x0001
+)
+
+var (
+	kernel32                     = syscall.NewLazyDLL("kernel32.dll")
+	procCreateJobObjectW         = kernel32.NewProc("CreateJobObjectW")
+	procSetInformationJobObject  = kernel32.NewProc("SetInformationJobObject")
+	procAssignProcessToJobObject = kernel32.NewProc("AssignProcessToJobObject")
+	procTerminateJobObject       = kernel32.NewProc("TerminateJobObject")
+)
+
+// jobObjectExtendedLimit is JOBOBJECT_EXTENDED_LIMIT_INFORMATION.
+type jobObjectExtendedLimit struct {
+	PerProcessUserTimeLimit int64
+	PerJobUserTimeLimit     int64
+	LimitFlags              uint32
+	MinimumWorkingSetSize   uintptr
+	MaximumWorkingSetSize   uintptr
+	ActiveProcessLimit      uint32
+	Affinity                uintptr
+	PriorityClass           uint32
+	SchedulingClass         uint32
+	IoCounters              [6]uint64
+	ProcessMemoryLimit      uintptr
+	JobMemoryLimit          uintptr
+	PeakProcessMemoryUsed   uintptr
+	PeakJobMemoryUsed       uintptr
+}
+
+// processGroup is a job object holding the driver and the browsers it starts.
+//
+// The job is killed when its handle is closed, so the browsers do not outlive
+// the driver even if this process exits first.
+type processGroup struct {
+	job syscall.Handle
+}
+
+// setProcessGroup starts the command in a new process group.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// newProcessGroup creates a job object and assigns the started driver process to it.
+//
+// Processes the driver starts afterwards belong to the job as well.
+func newProcessGroup(process *os.Process) (*processGroup, error) {
+	job, _, err := procCreateJobObjectW.Call(0, 0)
+	if job == 0 {
+		return nil, fmt.Errorf("failed to create job object: %w", err)
+	}
+
+	group := &processGroup{job: syscall.Handle(job)}
+
+	//nolint:exhaustruct // Only the limit flags are set.
+	limit := jobObjectExtendedLimit{LimitFlags: jobObjectLimitKillOnJobClose}
+	if ok, _, err := procSetInformationJobObject.Call(
+		job, jobObjectExtendedLimitInformation, uintptr(unsafe.Pointer(&limit)), unsafe.Sizeof(limit),
+	); ok == 0 {
+		group.release()
+
+		return nil, fmt.Errorf("failed to configure job object: %w", err)
+	}
+
+	handle, err := syscall.OpenProcess(processAssignAccess, false, uint32(process.Pid))
+	if err != nil {
+		group.release()
+
+		return nil, fmt.Errorf("failed to open driver process: %w", err)
+	}
+	defer syscall.CloseHandle(handle) //nolint:errcheck // The handle is only needed for the assignment.
+
+	if ok, _, err := procAssignProcessToJobObject.Call(job, uintptr(handle)); ok == 0 {
+		group.release()
+
+		return nil, fmt.Errorf("failed to assign driver to job object: %w", err)
+	}
+
+	return group, nil
+}
+
+// terminate stops the job; Windows has no SIGTERM equivalent for console-less processes.
+func (g *processGroup) terminate() error {
+	return g.kill()
+}
+
+// kill terminates every process in the job.
+func (g *processGroup) kill() error {
+	if ok, _, err := procTerminateJobObject.Call(uintptr(g.job), 1); ok == 0 {
+		return fmt.Errorf("failed to terminate job object: %w", err)
+	}
+
+	return nil
+}
+
+// alive reports whether processes remain in the job; terminate already ends all of them.
+func (g *processGroup) alive() bool {
+	return false
+}
+
+// release closes the job once the driver has exited, killing the browsers it left behind.
+//
+// Unlike a process group ID, the job handle cannot be reused while it is open.
+func (g *processGroup) release() {
+	if g.job != 0 {
+		_ = syscall.CloseHandle(g.job)
+		g.job = 0
+	}
+}